@@ -47,6 +47,7 @@ func LMDGhost(
 			}
 			if candidateChildVotes > maxChildVotes {
 				maxChild = children[i]
+				maxChildVotes = candidateChildVotes
 			}
 		}
 		head = maxChild
@@ -67,6 +68,11 @@ func VoteCount(block *pb.BeaconBlock, targets map[[32]byte]*pb.BeaconBlock, beac
 		if err != nil {
 			return 0, err
 		}
+		// The target has no ancestor at the block's slot, so it cannot be
+		// a descendant of the block.
+		if ancestor == nil {
+			continue
+		}
 		ancestorHash, err := hashutil.HashBeaconBlock(ancestor)
 		if err != nil {
 			return 0, err
@@ -91,10 +97,16 @@ func VoteCount(block *pb.BeaconBlock, targets map[[32]byte]*pb.BeaconBlock, beac
 //		def get_ancestor(store: Store, block: BeaconBlock, slot: SlotNumber) ->
 //		BeaconBlock: return block if block.slot ==
 //		slot else get_ancestor(store, store.get_parent(block), slot)
+//
+// A nil block is returned if the chain of the block skipped the slot, or the
+// block itself is older than the slot.
 func BlockAncestor(block *pb.BeaconBlock, slot uint64, beaconDB *db.BeaconDB) (*pb.BeaconBlock, error) {
 	if block.Slot == slot {
 		return block, nil
 	}
+	if block.Slot < slot {
+		return nil, nil
+	}
 	parentHash := bytesutil.ToBytes32(block.ParentRootHash32)
 	parent, err := beaconDB.Block(parentHash)
 	if err != nil {
@@ -248,3 +248,76 @@ func TestVoteCount_IncreaseCountCorrectly(t *testing.T) {
 		t.Errorf("Expected 1 vote, received %d", count)
 	}
 }
+
+func TestVoteCount_TargetOlderThanBlock(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	genesisBlock := b.NewGenesisBlock([]byte{})
+	genesisHash, err := hashutil.HashBeaconBlock(genesisBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	olderBlock := &pb.BeaconBlock{
+		Slot:             2,
+		ParentRootHash32: genesisHash[:],
+	}
+	newerBlock := &pb.BeaconBlock{
+		Slot:             3,
+		ParentRootHash32: genesisHash[:],
+	}
+	if err := beaconDB.SaveBlock(olderBlock); err != nil {
+		t.Fatal(err)
+	}
+
+	voteTargets := make(map[[32]byte]*pb.BeaconBlock)
+	voteTargets[[32]byte{0}] = olderBlock
+	count, err := VoteCount(newerBlock, voteTargets, beaconDB)
+	if err != nil {
+		t.Fatalf("Could not fetch vote count: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected 0 votes for a block newer than the vote target, received %d", count)
+	}
+}
+
+func TestLMDGhost_MostVotedOfManyChildrenWins(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	genesisBlock := b.NewGenesisBlock([]byte{})
+	genesisHash, err := hashutil.HashBeaconBlock(genesisBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	children := make([]*pb.BeaconBlock, 3)
+	for i := range children {
+		children[i] = &pb.BeaconBlock{
+			Slot:             1,
+			ParentRootHash32: genesisHash[:],
+			StateRootHash32:  []byte{byte(i)},
+		}
+		if err := beaconDB.SaveBlock(children[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The third child has more votes than the first, but less than the second.
+	voteTargets := make(map[[32]byte]*pb.BeaconBlock)
+	voteTargets[[32]byte{0}] = children[1]
+	voteTargets[[32]byte{1}] = children[1]
+	voteTargets[[32]byte{2}] = children[1]
+	voteTargets[[32]byte{3}] = children[2]
+	voteTargets[[32]byte{4}] = children[2]
+	head, err := LMDGhost(genesisBlock, voteTargets, children, beaconDB)
+	if err != nil {
+		t.Fatalf("Could not run LMD GHOST: %v", err)
+	}
+	if !proto.Equal(head, children[1]) {
+		t.Errorf("Expected head to equal %v, received %v", children[1], head)
+	}
+}
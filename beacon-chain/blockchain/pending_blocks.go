@@ -0,0 +1,203 @@
+package blockchain
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+var errPendingQueueFull = errors.New("pending block queue is full of blocks with lower slots")
+
+// pendingBlock is a block waiting in the queue along with the time it was queued.
+type pendingBlock struct {
+	block    *pb.BeaconBlock
+	root     [32]byte
+	queuedAt time.Time
+}
+
+// PendingBlockQueue holds the blocks which can not be processed yet, either because
+// their parent has not been received or because their slot has not started. It holds
+// a bounded number of blocks, each for a limited time, and is safe for concurrent use.
+type PendingBlockQueue struct {
+	lock     sync.Mutex
+	maxSize  int
+	expiry   time.Duration
+	now      func() time.Time
+	blocks   map[[32]byte]*pendingBlock
+	children map[[32]byte]map[[32]byte]bool
+}
+
+// NewPendingBlockQueue creates a queue holding at most maxSize blocks, each of
+// which is dropped once it has been queued for longer than expiry.
+func NewPendingBlockQueue(maxSize int, expiry time.Duration) *PendingBlockQueue {
+	return &PendingBlockQueue{
+		maxSize:  maxSize,
+		expiry:   expiry,
+		now:      time.Now,
+		blocks:   make(map[[32]byte]*pendingBlock),
+		children: make(map[[32]byte]map[[32]byte]bool),
+	}
+}
+
+// Insert adds a block to the queue. When the queue is full, the block with the
+// highest slot is dropped to make room, which may be the inserted block itself.
+func (q *PendingBlockQueue) Insert(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return err
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if _, ok := q.blocks[root]; ok {
+		return nil
+	}
+	q.pruneExpired()
+	if len(q.blocks) >= q.maxSize {
+		var highest *pendingBlock
+		for _, pending := range q.blocks {
+			if highest == nil || pending.block.Slot > highest.block.Slot {
+				highest = pending
+			}
+		}
+		if highest == nil || highest.block.Slot <= block.Slot {
+			return errPendingQueueFull
+		}
+		q.remove(highest.root)
+	}
+
+	q.blocks[root] = &pendingBlock{
+		block:    block,
+		root:     root,
+		queuedAt: q.now(),
+	}
+	parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+	if q.children[parentRoot] == nil {
+		q.children[parentRoot] = make(map[[32]byte]bool)
+	}
+	q.children[parentRoot][root] = true
+	return nil
+}
+
+// Has returns whether the block with the given root is queued.
+func (q *PendingBlockQueue) Has(root [32]byte) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	_, ok := q.blocks[root]
+	return ok
+}
+
+// Remove drops the block with the given root from the queue.
+func (q *PendingBlockQueue) Remove(root [32]byte) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.remove(root)
+}
+
+// Len returns the number of queued blocks.
+func (q *PendingBlockQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.blocks)
+}
+
+// BlocksAtSlot returns the queued blocks at the given slot without removing them.
+func (q *PendingBlockQueue) BlocksAtSlot(slot uint64) []*pb.BeaconBlock {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.pruneExpired()
+	var blocks []*pb.BeaconBlock
+	for _, pending := range q.blocks {
+		if pending.block.Slot == slot {
+			blocks = append(blocks, pending.block)
+		}
+	}
+	sortBlocks(blocks)
+	return blocks
+}
+
+// PopChildren removes and returns the queued blocks whose parent is the block
+// with the given root, ordered by slot.
+func (q *PendingBlockQueue) PopChildren(parentRoot [32]byte) []*pb.BeaconBlock {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.pruneExpired()
+	var blocks []*pb.BeaconBlock
+	for root := range q.children[parentRoot] {
+		blocks = append(blocks, q.blocks[root].block)
+		q.remove(root)
+	}
+	sortBlocks(blocks)
+	return blocks
+}
+
+// PopReady removes and returns the queued blocks with a slot up to the given slot
+// whose parent is stored, as reported by hasBlock, ordered by slot. The blocks whose
+// parent is missing stay queued until they expire, and the roots of their missing
+// parents are returned so that they are requested again. Blocks whose parent is
+// queued as well are returned by PopChildren once their parent is processed.
+func (q *PendingBlockQueue) PopReady(slot uint64, hasBlock func([32]byte) bool) ([]*pb.BeaconBlock, [][32]byte) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.pruneExpired()
+	var ready []*pendingBlock
+	var missingParents [][32]byte
+	requested := make(map[[32]byte]bool)
+	for _, pending := range q.blocks {
+		if pending.block.Slot > slot {
+			continue
+		}
+		parentRoot := bytesutil.ToBytes32(pending.block.ParentRootHash32)
+		if _, ok := q.blocks[parentRoot]; ok {
+			continue
+		}
+		if !hasBlock(parentRoot) {
+			if !requested[parentRoot] {
+				requested[parentRoot] = true
+				missingParents = append(missingParents, parentRoot)
+			}
+			continue
+		}
+		ready = append(ready, pending)
+	}
+	blocks := make([]*pb.BeaconBlock, len(ready))
+	for i, pending := range ready {
+		blocks[i] = pending.block
+		q.remove(pending.root)
+	}
+	sortBlocks(blocks)
+	return blocks, missingParents
+}
+
+func (q *PendingBlockQueue) pruneExpired() {
+	now := q.now()
+	for root, pending := range q.blocks {
+		if now.Sub(pending.queuedAt) > q.expiry {
+			q.remove(root)
+		}
+	}
+}
+
+func (q *PendingBlockQueue) remove(root [32]byte) {
+	pending, ok := q.blocks[root]
+	if !ok {
+		return
+	}
+	delete(q.blocks, root)
+	parentRoot := bytesutil.ToBytes32(pending.block.ParentRootHash32)
+	delete(q.children[parentRoot], root)
+	if len(q.children[parentRoot]) == 0 {
+		delete(q.children, parentRoot)
+	}
+}
+
+func sortBlocks(blocks []*pb.BeaconBlock) {
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Slot < blocks[j].Slot
+	})
+}
@@ -0,0 +1,150 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func pendingTestBlock(t *testing.T, slot uint64, parent *pb.BeaconBlock) (*pb.BeaconBlock, [32]byte) {
+	block := &pb.BeaconBlock{Slot: slot, ParentRootHash32: []byte{}}
+	if parent != nil {
+		parentRoot, err := hashutil.HashBeaconBlock(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block.ParentRootHash32 = parentRoot[:]
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block, root
+}
+
+func TestPendingBlockQueue_PopChildren(t *testing.T) {
+	q := NewPendingBlockQueue(10, time.Minute)
+	parent, parentRoot := pendingTestBlock(t, 1, nil)
+	child1, _ := pendingTestBlock(t, 3, parent)
+	child2, _ := pendingTestBlock(t, 2, parent)
+	other, otherRoot := pendingTestBlock(t, 2, nil)
+	for _, block := range []*pb.BeaconBlock{child1, child2, other} {
+		if err := q.Insert(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	children := q.PopChildren(parentRoot)
+	if len(children) != 2 || children[0] != child2 || children[1] != child1 {
+		t.Fatalf("Expected children ordered by slot, received %v", children)
+	}
+	if q.Len() != 1 || !q.Has(otherRoot) {
+		t.Errorf("Expected only the unrelated block to remain queued, have %d blocks", q.Len())
+	}
+	if children := q.PopChildren(parentRoot); len(children) != 0 {
+		t.Errorf("Expected children to be removed, received %v", children)
+	}
+}
+
+func TestPendingBlockQueue_PopReady(t *testing.T) {
+	q := NewPendingBlockQueue(10, time.Minute)
+	stored, storedRoot := pendingTestBlock(t, 1, nil)
+	ready, readyRoot := pendingTestBlock(t, 2, stored)
+	child, childRoot := pendingTestBlock(t, 3, ready)
+	missing, missingRoot := pendingTestBlock(t, 0, nil)
+	orphan, orphanRoot := pendingTestBlock(t, 4, missing)
+	future, futureRoot := pendingTestBlock(t, 10, stored)
+	for _, block := range []*pb.BeaconBlock{ready, child, orphan, future} {
+		if err := q.Insert(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hasBlock := func(root [32]byte) bool { return root == storedRoot }
+
+	blocks, missingParents := q.PopReady(5, hasBlock)
+	if len(blocks) != 1 || blocks[0] != ready {
+		t.Fatalf("Expected only the block with a stored parent, received %v", blocks)
+	}
+	if len(missingParents) != 1 || missingParents[0] != missingRoot {
+		t.Errorf("Expected the missing parent to be requested, received %v", missingParents)
+	}
+	if !q.Has(childRoot) || !q.Has(orphanRoot) || !q.Has(futureRoot) {
+		t.Error("Expected child of queued block, orphan and future block to remain queued")
+	}
+	processed := func(root [32]byte) bool { return root == storedRoot || root == readyRoot }
+	if blocks, _ := q.PopReady(10, processed); len(blocks) != 2 {
+		t.Errorf("Expected child and future block to be ready at slot 10, received %v", blocks)
+	}
+}
+
+func TestPendingBlockQueue_EvictsHighestSlotWhenFull(t *testing.T) {
+	q := NewPendingBlockQueue(2, time.Minute)
+	low, lowRoot := pendingTestBlock(t, 1, nil)
+	high, highRoot := pendingTestBlock(t, 9, nil)
+	mid, midRoot := pendingTestBlock(t, 5, nil)
+	for _, block := range []*pb.BeaconBlock{low, high, mid} {
+		if err := q.Insert(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !q.Has(lowRoot) || !q.Has(midRoot) || q.Has(highRoot) {
+		t.Error("Expected block with the highest slot to be evicted")
+	}
+
+	higher, _ := pendingTestBlock(t, 7, nil)
+	if err := q.Insert(higher); err != errPendingQueueFull {
+		t.Errorf("Expected %v, received %v", errPendingQueueFull, err)
+	}
+	if q.Len() != 2 {
+		t.Errorf("Expected queue to stay at its maximum size, have %d blocks", q.Len())
+	}
+}
+
+func TestPendingBlockQueue_Expiry(t *testing.T) {
+	q := NewPendingBlockQueue(10, time.Minute)
+	now := time.Now()
+	q.now = func() time.Time { return now }
+	block, root := pendingTestBlock(t, 1, nil)
+	if err := q.Insert(block); err != nil {
+		t.Fatal(err)
+	}
+	if blocks := q.BlocksAtSlot(1); len(blocks) != 1 {
+		t.Fatalf("Expected queued block at slot 1, received %v", blocks)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if blocks := q.BlocksAtSlot(1); len(blocks) != 0 {
+		t.Errorf("Expected expired block to be dropped, received %v", blocks)
+	}
+	if q.Has(root) {
+		t.Error("Expected expired block to be removed")
+	}
+}
+
+func TestPendingBlockQueue_OrphanExpiresWhenParentNeverArrives(t *testing.T) {
+	q := NewPendingBlockQueue(10, time.Minute)
+	now := time.Now()
+	q.now = func() time.Time { return now }
+	parent, _ := pendingTestBlock(t, 1, nil)
+	orphan, orphanRoot := pendingTestBlock(t, 2, parent)
+	if err := q.Insert(orphan); err != nil {
+		t.Fatal(err)
+	}
+	hasBlock := func([32]byte) bool { return false }
+
+	for slot := uint64(2); slot < 10; slot++ {
+		now = now.Add(10 * time.Second)
+		blocks, missingParents := q.PopReady(slot, hasBlock)
+		if len(blocks) != 0 {
+			t.Fatalf("Expected orphan to stay queued, received %v", blocks)
+		}
+		if q.Has(orphanRoot) != (len(missingParents) == 1) {
+			t.Fatalf("Expected the parent to be requested only while the orphan is queued, received %v", missingParents)
+		}
+	}
+	if q.Has(orphanRoot) {
+		t.Error("Expected orphan to expire once it was queued for longer than the expiry")
+	}
+}
@@ -7,23 +7,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
 )
 
 var log = logrus.WithField("prefix", "blockchain")
 
+// defaultPendingBlocksSize is the default number of orphan and future blocks the
+// chain service holds until they can be processed.
+const defaultPendingBlocksSize = 1024
+
+// blockRequestsBuf is the number of requests for missing parent blocks waiting to be
+// sent to the sync service, beyond which new requests are dropped.
+const blockRequestsBuf = 100
+
+// defaultPendingBlockExpiry holds queued blocks for two epochs by default.
+func defaultPendingBlockExpiry() time.Duration {
+	return time.Duration(2*params.BeaconConfig().EpochLength*params.BeaconConfig().SlotDuration) * time.Second
+}
+
 // ChainService represents a service that handles the internal
 // logic of managing the full PoS beacon chain.
 type ChainService struct {
@@ -36,8 +53,18 @@ type ChainService struct {
 	genesisTimeChan    chan time.Time
 	canonicalBlockFeed *event.Feed
 	canonicalStateFeed *event.Feed
+	blockRequestFeed   *event.Feed
+	blockRequests      chan *pb.BeaconBlockRequest
+	pendingBlocks      *PendingBlockQueue
 	genesisTime        time.Time
 	enablePOWChain     bool
+	processing         int32
+	processingDone     chan struct{}
+	// The reward report of the last block received is saved once the fork choice
+	// rule makes the block the chain head, as the report of its epoch.
+	enableRewardReports bool
+	rewardReport        *pb.EpochRewardReport
+	rewardReportBlock   [32]byte
 }
 
 // Config options for the service.
@@ -48,23 +75,43 @@ type Config struct {
 	BeaconDB         *db.BeaconDB
 	DevMode          bool
 	EnablePOWChain   bool
+	// PendingBlocksSize bounds the number of orphan and future blocks held until
+	// they can be processed, and PendingBlockExpiry is how long each is held.
+	PendingBlocksSize  int
+	PendingBlockExpiry time.Duration
+	// EnableRewardReports reports the rewards and penalties of every validator at
+	// each epoch transition of the canonical chain.
+	EnableRewardReports bool
 }
 
 // NewChainService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	pendingBlocksSize := cfg.PendingBlocksSize
+	if pendingBlocksSize == 0 {
+		pendingBlocksSize = defaultPendingBlocksSize
+	}
+	pendingBlockExpiry := cfg.PendingBlockExpiry
+	if pendingBlockExpiry == 0 {
+		pendingBlockExpiry = defaultPendingBlockExpiry()
+	}
 	return &ChainService{
-		ctx:                ctx,
-		cancel:             cancel,
-		beaconDB:           cfg.BeaconDB,
-		web3Service:        cfg.Web3Service,
-		incomingBlockChan:  make(chan *pb.BeaconBlock, cfg.IncomingBlockBuf),
-		genesisTimeChan:    make(chan time.Time),
-		incomingBlockFeed:  new(event.Feed),
-		canonicalBlockFeed: new(event.Feed),
-		canonicalStateFeed: new(event.Feed),
-		enablePOWChain:     cfg.EnablePOWChain,
+		ctx:                 ctx,
+		cancel:              cancel,
+		beaconDB:            cfg.BeaconDB,
+		web3Service:         cfg.Web3Service,
+		incomingBlockChan:   make(chan *pb.BeaconBlock, cfg.IncomingBlockBuf),
+		genesisTimeChan:     make(chan time.Time),
+		incomingBlockFeed:   new(event.Feed),
+		canonicalBlockFeed:  new(event.Feed),
+		canonicalStateFeed:  new(event.Feed),
+		blockRequestFeed:    new(event.Feed),
+		blockRequests:       make(chan *pb.BeaconBlockRequest, blockRequestsBuf),
+		pendingBlocks:       NewPendingBlockQueue(pendingBlocksSize, pendingBlockExpiry),
+		enablePOWChain:      cfg.EnablePOWChain,
+		processingDone:      make(chan struct{}),
+		enableRewardReports: cfg.EnableRewardReports,
 	}, nil
 }
 
@@ -78,6 +125,7 @@ func (c *ChainService) Start() {
 	if beaconState != nil {
 		log.Info("Beacon chain data already exists, starting service")
 		c.genesisTime = time.Unix(int64(beaconState.GenesisTime), 0)
+		atomic.StoreInt32(&c.processing, 1)
 		go c.blockProcessing()
 	} else {
 		log.Info("Waiting for ChainStart log from the Validator Deposit Contract to start the beacon chain...")
@@ -92,6 +140,7 @@ func (c *ChainService) Start() {
 			if err := c.initializeBeaconChain(genesisTime, initialDeposits); err != nil {
 				log.Fatalf("Could not initialize beacon chain: %v", err)
 			}
+			atomic.StoreInt32(&c.processing, 1)
 			go c.blockProcessing()
 			subChainStart.Unsubscribe()
 		}()
@@ -123,11 +172,14 @@ func (c *ChainService) initializeBeaconChain(genesisTime time.Time, deposits []*
 	return nil
 }
 
-// Stop the blockchain service's main event loop and associated goroutines.
+// Stop the blockchain service's main event loop and associated goroutines, waiting
+// for the blocks already received to be processed.
 func (c *ChainService) Stop() error {
-	defer c.cancel()
-
 	log.Info("Stopping service")
+	c.cancel()
+	if atomic.LoadInt32(&c.processing) == 1 {
+		<-c.processingDone
+	}
 	return nil
 }
 
@@ -155,6 +207,13 @@ func (c *ChainService) CanonicalStateFeed() *event.Feed {
 	return c.canonicalStateFeed
 }
 
+// BlockRequestFeed returns a feed that is written to with requests for the missing
+// parents of the blocks received by the chain service, which the sync service
+// fetches from the network.
+func (c *ChainService) BlockRequestFeed() *event.Feed {
+	return c.blockRequestFeed
+}
+
 // doesPoWBlockExist checks if the referenced PoW block exists.
 func (c *ChainService) doesPoWBlockExist(hash [32]byte) bool {
 	powBlock, err := c.web3Service.Client().BlockByHash(c.ctx, hash)
@@ -169,39 +228,146 @@ func (c *ChainService) doesPoWBlockExist(hash [32]byte) bool {
 // blockProcessing subscribes to incoming blocks, processes them if possible, and then applies
 // the fork-choice rule to update the beacon chain's head.
 func (c *ChainService) blockProcessing() {
+	defer close(c.processingDone)
+	go c.sendBlockRequests()
 	subBlock := c.incomingBlockFeed.Subscribe(c.incomingBlockChan)
 	defer subBlock.Unsubscribe()
+	slotTicker := slotutil.GetSlotTicker(c.genesisTime, params.BeaconConfig().SlotDuration)
+	defer slotTicker.Done()
 	for {
 		select {
 		case <-c.ctx.Done():
 			log.Debug("Chain service context closed, exiting goroutine")
+			subBlock.Unsubscribe()
+			c.drainIncomingBlocks()
 			return
 
 		// Listen for a newly received incoming block from the feed. Blocks
 		// can be received either from the sync service, the RPC service,
 		// or via p2p.
 		case block := <-c.incomingBlockChan:
-			beaconState, err := c.beaconDB.State()
-			if err != nil {
-				log.Errorf("Unable to retrieve beacon state %v", err)
-				continue
-			}
+			c.processIncomingBlock(block)
 
-			if block.Slot > beaconState.Slot {
-				computedState, err := c.ReceiveBlock(block, beaconState)
-				if err != nil {
-					log.Errorf("Could not process received block: %v", err)
-					continue
-				}
-				if err := c.ApplyForkChoiceRule(block, computedState); err != nil {
-					log.Errorf("Could not update chain head: %v", err)
-					continue
-				}
+		// Queued blocks are retried at the start of their slot, and the parents
+		// which are still missing are requested again.
+		case slot := <-slotTicker.C():
+			ready, missingParents := c.pendingBlocks.PopReady(slot, c.beaconDB.HasBlock)
+			for _, root := range missingParents {
+				c.requestBlock(root)
+			}
+			for _, block := range ready {
+				c.processIncomingBlock(block)
 			}
 		}
 	}
 }
 
+// drainIncomingBlocks processes the blocks that were received before the service
+// was stopped, so that they are not lost with the buffered channel.
+func (c *ChainService) drainIncomingBlocks() {
+	for {
+		select {
+		case block := <-c.incomingBlockChan:
+			c.processIncomingBlock(block)
+		default:
+			return
+		}
+	}
+}
+
+// sendBlockRequests sends the requests for missing parent blocks to the sync service
+// until the service is stopped. The requests are sent from their own goroutine, as
+// the sync service may itself be blocked sending blocks to the chain service.
+func (c *ChainService) sendBlockRequests() {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case req := <-c.blockRequests:
+			c.blockRequestFeed.Send(req)
+		}
+	}
+}
+
+// processIncomingBlock processes a block and applies the fork choice rule, unless
+// the block's parent is missing or its slot has not started, in which case the
+// block is queued until then and its missing parent is requested from the network.
+// Once the block is processed, the queued blocks descending from it are processed
+// as well, one after the other rather than recursively as the chain of queued
+// descendants can be long.
+func (c *ChainService) processIncomingBlock(block *pb.BeaconBlock) {
+	blocks := []*pb.BeaconBlock{block}
+	for len(blocks) > 0 {
+		block := blocks[0]
+		blocks = blocks[1:]
+		if !c.processBlock(block) {
+			continue
+		}
+		blockRoot, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			log.Errorf("Could not hash processed block: %v", err)
+			continue
+		}
+		blocks = append(blocks, c.pendingBlocks.PopChildren(blockRoot)...)
+	}
+}
+
+// processBlock processes a single block as described by processIncomingBlock, and
+// reports whether the block was processed and became the chain head.
+func (c *ChainService) processBlock(block *pb.BeaconBlock) bool {
+	parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+	if !c.beaconDB.HasBlock(parentRoot) {
+		if err := c.pendingBlocks.Insert(block); err != nil {
+			log.Errorf("Could not queue block with missing parent: %v", err)
+			return false
+		}
+		if !c.pendingBlocks.Has(parentRoot) {
+			c.requestBlock(parentRoot)
+		}
+		return false
+	}
+	if !b.IsSlotValid(block.Slot, c.genesisTime) {
+		if err := c.pendingBlocks.Insert(block); err != nil {
+			log.Errorf("Could not queue block of future slot %d: %v", block.Slot, err)
+		}
+		return false
+	}
+
+	beaconState, err := c.beaconDB.State()
+	if err != nil {
+		log.Errorf("Unable to retrieve beacon state %v", err)
+		return false
+	}
+	if block.Slot <= beaconState.Slot {
+		log.WithFields(logrus.Fields{
+			"slot":      block.Slot,
+			"stateSlot": beaconState.Slot,
+		}).Info("Dropping block which is not past the slot of the chain head state")
+		return false
+	}
+	computedState, err := c.ReceiveBlock(block, beaconState)
+	if err != nil {
+		log.Errorf("Could not process received block: %v", err)
+		return false
+	}
+	if err := c.ApplyForkChoiceRule(block, computedState); err != nil {
+		log.Errorf("Could not update chain head: %v", err)
+		return false
+	}
+	return true
+}
+
+// requestBlock queues a request for the missing block with the given root, unless
+// too many requests are already waiting to be sent.
+func (c *ChainService) requestBlock(root [32]byte) {
+	select {
+	case c.blockRequests <- &pb.BeaconBlockRequest{Hash: root[:]}:
+		log.WithField("parentRoot", fmt.Sprintf("%#x", root)).Debug("Requesting missing parent block")
+	default:
+		log.WithField("parentRoot", fmt.Sprintf("%#x", root)).Warn("Dropping request for missing parent block, too many requests are pending")
+	}
+}
+
 // ApplyForkChoiceRule determines the current beacon chain head using LMD GHOST as a block-vote
 // weighted function to select a canonical head in Ethereum Serenity.
 func (c *ChainService) ApplyForkChoiceRule(block *pb.BeaconBlock, computedState *pb.BeaconState) error {
@@ -211,10 +377,18 @@ func (c *ChainService) ApplyForkChoiceRule(block *pb.BeaconBlock, computedState
 	}
 	// TODO(#1307): Use LMD GHOST as the fork-choice rule for Ethereum Serenity.
 	// TODO(#674): Handle chain reorgs.
-	if err := c.beaconDB.UpdateChainHead(block, computedState); err != nil {
+	if err := c.beaconDB.SaveBlockAndUpdateChainHead(block, computedState); err != nil {
 		return fmt.Errorf("failed to update chain: %v", err)
 	}
 	log.WithField("blockHash", fmt.Sprintf("0x%x", h)).Info("Chain head block and state updated")
+	// The report of an epoch is only saved for the block of the canonical chain, as
+	// blocks of other forks transition to the same epoch with different rewards.
+	if c.rewardReport != nil && c.rewardReportBlock == h {
+		if err := c.beaconDB.SaveRewardReport(c.rewardReport); err != nil {
+			return fmt.Errorf("could not save reward report of epoch %d: %v", c.rewardReport.Epoch, err)
+		}
+		c.rewardReport = nil
+	}
 	// We fire events that notify listeners of a new block in
 	// the case of a state transition. This is useful for the beacon node's gRPC
 	// server to stream these events to beacon clients.
@@ -278,33 +452,43 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 
 	log.WithField("slotNumber", block.Slot).Info("Executing state transition")
 
-	// Check for skipped slots and update the corresponding proposers
-	// randao layer.
-	for beaconState.Slot < block.Slot-1 {
-		beaconState, err = state.ExecuteStateTransition(
-			beaconState,
-			nil,
-			blockRoot,
-			true, /* no sig verify */
-		)
+	// The block is processed on the given state, which is modified in place as it is
+	// not shared with any copy. Check for skipped slots and update the corresponding
+	// proposers randao layer.
+	slotState := sharedstate.New(beaconState)
+	for slotState.Slot() < block.Slot-1 {
+		slotState, err = state.ProcessSlot(slotState, blockRoot)
 		if err != nil {
 			return nil, fmt.Errorf("could not execute state transition %v", err)
 		}
 	}
 
-	beaconState, err = state.ExecuteStateTransition(
-		beaconState,
-		block,
-		blockRoot,
-		true, /* no sig verify */
-	)
+	c.rewardReport = nil
+	if c.enableRewardReports {
+		beaconState, c.rewardReport, err = state.ExecuteStateTransitionWithReport(
+			slotState.MutableProto(),
+			block,
+			blockRoot,
+			true, /* no sig verify */
+		)
+		c.rewardReportBlock = blockHash
+	} else {
+		beaconState, err = state.ExecuteStateTransition(
+			slotState.MutableProto(),
+			block,
+			blockRoot,
+			true, /* no sig verify */
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not execute state transition %v", err)
 	}
 
 	// TODO(#1074): Verify block.state_root == hash_tree_root(state)
 	// if there exists a block for the slot being processed.
-	if err := c.beaconDB.SaveBlock(block); err != nil {
+	// The block is saved along with its post-state whether or not it becomes the
+	// chain head, so that a crash cannot leave a saved block without its state.
+	if err := c.beaconDB.SaveBlockAndState(block, beaconState); err != nil {
 		return nil, fmt.Errorf("failed to save block: %v", err)
 	}
 	log.WithField("hash", fmt.Sprintf("%#x", blockHash)).Debug("Processed beacon block")
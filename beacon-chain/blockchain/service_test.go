@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/gogo/protobuf/proto"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -22,6 +23,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -373,6 +375,13 @@ func TestRunningChainService(t *testing.T) {
 
 	currentSlot := uint64(5)
 	attestationSlot := uint64(0)
+	// The attestation's bitfield must match the size of the committee it attests for.
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attestationSlot, false)
+	if err != nil {
+		t.Fatalf("Could not get crosslink committees: %v", err)
+	}
+	bitfield := make([]byte, mathutil.CeilDiv8(len(committees[0].Committee)))
+	bitfield[0] = 128
 
 	block := &pb.BeaconBlock{
 		Slot:             currentSlot + 1,
@@ -384,10 +393,10 @@ func TestRunningChainService(t *testing.T) {
 		},
 		Body: &pb.BeaconBlockBody{
 			Attestations: []*pb.Attestation{{
-				AggregationBitfield: []byte{128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-					0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				AggregationBitfield: bitfield,
 				Data: &pb.AttestationData{
 					Slot:                      attestationSlot,
+					Shard:                     committees[0].Shard,
 					JustifiedBlockRootHash32:  params.BeaconConfig().ZeroHash[:],
 					LatestCrosslinkRootHash32: params.BeaconConfig().ZeroHash[:],
 				},
@@ -521,6 +530,102 @@ func TestUpdateHead(t *testing.T) {
 	}
 }
 
+func TestApplyForkChoiceRule_SavesRewardReportOfHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true)
+	deposits := setupInitialDeposits(t)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
+	}
+	beaconState, err := db.State()
+	if err != nil {
+		t.Fatalf("Could not get beacon state: %v", err)
+	}
+
+	received := &pb.BeaconBlock{Slot: 64, RandaoRevealHash32: []byte("received")}
+	receivedHash, err := hashutil.HashBeaconBlock(received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService.rewardReport = &pb.EpochRewardReport{Epoch: 1}
+	chainService.rewardReportBlock = receivedHash
+
+	// The report of a received block is not saved while another block is the head.
+	other := &pb.BeaconBlock{Slot: 64, RandaoRevealHash32: []byte("other")}
+	if err := chainService.ApplyForkChoiceRule(other, beaconState); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	if report, err := db.RewardReport(1); err != nil || report != nil {
+		t.Fatalf("Expected no reward report before the block is the head, received %v: %v", report, err)
+	}
+
+	if err := chainService.ApplyForkChoiceRule(received, beaconState); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	report, err := db.RewardReport(1)
+	if err != nil {
+		t.Fatalf("Could not get reward report: %v", err)
+	}
+	if report == nil || report.Epoch != 1 {
+		t.Errorf("Expected reward report of epoch 1 to be saved, received %v", report)
+	}
+	if chainService.rewardReport != nil {
+		t.Error("Expected saved reward report to be cleared")
+	}
+}
+
+func TestProcessIncomingBlock_DropsRequestsOncePendingRequestsAreFull(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true)
+	deposits := setupInitialDeposits(t)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
+	}
+
+	// Nothing sends the requests, so processing must not wait for them to be sent.
+	for i := 0; i <= blockRequestsBuf; i++ {
+		chainService.processIncomingBlock(&pb.BeaconBlock{
+			Slot:             1,
+			ParentRootHash32: []byte{byte(i), 'a'},
+		})
+	}
+	if len(chainService.blockRequests) != blockRequestsBuf {
+		t.Errorf("Expected %d pending requests, received %d", blockRequestsBuf, len(chainService.blockRequests))
+	}
+	testutil.AssertLogsContain(t, hook, "Dropping request for missing parent block")
+}
+
+func TestProcessIncomingBlock_LogsBlockNotPastStateSlot(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true)
+	deposits := setupInitialDeposits(t)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
+	}
+	if err := SetSlotInState(chainService, 5); err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Could not get chain head: %v", err)
+	}
+	genesisHash, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatalf("Could not hash genesis block: %v", err)
+	}
+
+	chainService.processIncomingBlock(&pb.BeaconBlock{
+		Slot:             5,
+		ParentRootHash32: genesisHash[:],
+	})
+	testutil.AssertLogsContain(t, hook, "Dropping block which is not past the slot of the chain head state")
+}
+
 func TestIsBlockReadyForProcessing(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
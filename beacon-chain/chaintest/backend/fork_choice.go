@@ -0,0 +1,339 @@
+package backend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// genesisBlockID is the ID fork choice tests use to refer to the genesis block.
+const genesisBlockID = "*"
+
+// forkChoiceTree is the block tree of a fork choice test together with the latest
+// attestation target of every validator.
+type forkChoiceTree struct {
+	beaconDB       *db.BeaconDB
+	genesisState   *sharedstate.BeaconState
+	blocks         map[string]*pb.BeaconBlock
+	blockIDs       map[[32]byte]string
+	observedBlocks []*pb.BeaconBlock
+	voteTargets    map[[32]byte]*pb.BeaconBlock
+	// epochAttesters are the validators attesting during an epoch to each
+	// block, used to determine the justified epoch boundary blocks.
+	epochAttesters map[uint64]map[uint64]*pb.BeaconBlock
+	// finalityState holds the justification and finalization fields updated by
+	// the node's epoch processing at the start of every epoch.
+	finalityState *pb.BeaconState
+	justified     *pb.BeaconBlock
+	finalized     *pb.BeaconBlock
+}
+
+// forkChoiceSlotResult is the outcome of running the fork choice rule after a slot.
+type forkChoiceSlotResult struct {
+	slot      uint64
+	head      string
+	justified string
+	finalized string
+}
+
+func (r *forkChoiceSlotResult) String() string {
+	return fmt.Sprintf("slot %d: head %s, last justified %s, last finalized %s",
+		r.slot, r.head, r.justified, r.finalized)
+}
+
+// newForkChoiceTree creates a tree containing only the genesis block, whose state
+// has the given number of validators with equal balances.
+func newForkChoiceTree(beaconDB *db.BeaconDB, validatorCount uint64) (*forkChoiceTree, error) {
+	deposits := make([]*pb.Deposit, validatorCount)
+	for i := uint64(0); i < validatorCount; i++ {
+		depositInput := &pb.DepositInput{
+			Pubkey: validatorPubkey(i),
+		}
+		depositData, err := b.EncodeDepositData(
+			depositInput,
+			params.BeaconConfig().MaxDeposit,
+			params.BeaconConfig().GenesisTime.Unix(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode deposit data: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+	}
+	genesisState, err := state.InitialBeaconState(deposits, uint64(params.BeaconConfig().GenesisTime.Unix()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize genesis state: %v", err)
+	}
+	stateRoot, err := state.Hash(genesisState)
+	if err != nil {
+		return nil, err
+	}
+	tree := &forkChoiceTree{
+		beaconDB:       beaconDB,
+		genesisState:   sharedstate.New(genesisState),
+		blocks:         make(map[string]*pb.BeaconBlock),
+		blockIDs:       make(map[[32]byte]string),
+		voteTargets:    make(map[[32]byte]*pb.BeaconBlock),
+		epochAttesters: make(map[uint64]map[uint64]*pb.BeaconBlock),
+		finalityState:  proto.Clone(genesisState).(*pb.BeaconState),
+	}
+	genesisBlock := b.NewGenesisBlock(stateRoot[:])
+	if err := tree.saveBlock(genesisBlockID, genesisBlock); err != nil {
+		return nil, err
+	}
+	tree.justified = genesisBlock
+	tree.finalized = genesisBlock
+	return tree, nil
+}
+
+// validatorPubkey returns the public key of the validator at the given index in
+// the genesis state of a fork choice test.
+func validatorPubkey(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	pubkey := hashutil.Hash(enc)
+	return pubkey[:]
+}
+
+func (t *forkChoiceTree) saveBlock(id string, block *pb.BeaconBlock) error {
+	if _, ok := t.blocks[id]; ok {
+		return fmt.Errorf("block %s already exists", id)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block %s: %v", id, err)
+	}
+	if err := t.beaconDB.SaveBlock(block); err != nil {
+		return fmt.Errorf("could not save block %s: %v", id, err)
+	}
+	t.blocks[id] = block
+	t.blockIDs[root] = id
+	t.observedBlocks = append(t.observedBlocks, block)
+	return nil
+}
+
+// addBlock adds a block proposed at the given slot to the tree. The ID of the block
+// is used as its state root so sibling blocks of the same slot are distinct.
+func (t *forkChoiceTree) addBlock(slot uint64, testBlock *TestBlock) error {
+	parent, ok := t.blocks[testBlock.Parent]
+	if !ok {
+		return fmt.Errorf("parent %s of block %s does not exist", testBlock.Parent, testBlock.ID)
+	}
+	if parent.Slot >= slot {
+		return fmt.Errorf("block %s at slot %d is not newer than its parent %s at slot %d",
+			testBlock.ID, slot, testBlock.Parent, parent.Slot)
+	}
+	parentRoot, err := hashutil.HashBeaconBlock(parent)
+	if err != nil {
+		return err
+	}
+	stateRoot := hashutil.Hash([]byte(testBlock.ID))
+	return t.saveBlock(testBlock.ID, &pb.BeaconBlock{
+		Slot:             slot,
+		ParentRootHash32: parentRoot[:],
+		StateRootHash32:  stateRoot[:],
+	})
+}
+
+// applyAttestation records the attested block as the latest target of the attesting
+// validators, which are given by their position in the committees of the committee slot.
+func (t *forkChoiceTree) applyAttestation(slot uint64, attestation *TestAttestation) error {
+	block, ok := t.blocks[attestation.Block]
+	if !ok {
+		return fmt.Errorf("attested block %s does not exist", attestation.Block)
+	}
+	committeeSlot := attestation.CommitteeSlot
+	if committeeSlot == 0 {
+		committeeSlot = slot
+	}
+	committee, err := t.committee(committeeSlot)
+	if err != nil {
+		return err
+	}
+	positions, err := parseValidatorRange(attestation.ValidatorRegistry)
+	if err != nil {
+		return err
+	}
+	epoch := helpers.SlotToEpoch(slot)
+	if _, ok := t.epochAttesters[epoch]; !ok {
+		t.epochAttesters[epoch] = make(map[uint64]*pb.BeaconBlock)
+	}
+	for _, position := range positions {
+		if position >= uint64(len(committee)) {
+			return fmt.Errorf("validator %d is out of range of the committee of size %d at slot %d",
+				position, len(committee), committeeSlot)
+		}
+		index := committee[position]
+		t.voteTargets[bytesutil.ToBytes32(validatorPubkey(index))] = block
+		t.epochAttesters[epoch][index] = block
+	}
+	return nil
+}
+
+// committee returns the validator indices of every crosslink committee at the slot.
+// The genesis state's registry never changes, so its shuffling is valid at any slot.
+func (t *forkChoiceTree) committee(slot uint64) ([]uint64, error) {
+	committeeState := t.genesisState.Copy()
+	defer committeeState.Release()
+	committeeState.SetSlot(slot)
+	crosslinkCommittees, err := helpers.CrosslinkCommitteesAtSlot(committeeState.Proto(), slot, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get committees at slot %d: %v", slot, err)
+	}
+	var committee []uint64
+	for _, crosslinkCommittee := range crosslinkCommittees {
+		committee = append(committee, crosslinkCommittee.Committee...)
+	}
+	return committee, nil
+}
+
+// updateHead runs the node's fork choice rule from the last justified block, then
+// updates the justified and finalized blocks on the chain of the new head.
+func (t *forkChoiceTree) updateHead(slot uint64) (*forkChoiceSlotResult, error) {
+	head, err := blockchain.LMDGhost(t.justified, t.voteTargets, t.observedBlocks, t.beaconDB)
+	if err != nil {
+		return nil, fmt.Errorf("could not run fork choice rule: %v", err)
+	}
+	if err := t.updateFinality(head, slot); err != nil {
+		return nil, err
+	}
+	return &forkChoiceSlotResult{
+		slot:      slot,
+		head:      t.id(head),
+		justified: t.id(t.justified),
+		finalized: t.id(t.finalized),
+	}, nil
+}
+
+// updateFinality runs the justification and finalization steps of the node's epoch
+// processing for every epoch started by the slot. The attesting balances of an
+// epoch are those of the validators which attested to its boundary block on the
+// chain of the head, or to descendants of it, during the epoch. The last justified
+// and finalized blocks are the boundary blocks of the resulting epochs on the
+// chain of the head.
+func (t *forkChoiceTree) updateFinality(head *pb.BeaconBlock, slot uint64) error {
+	for helpers.StartSlot(helpers.CurrentEpoch(t.finalityState)+1) <= slot {
+		nextEpoch := helpers.CurrentEpoch(t.finalityState) + 1
+		t.finalityState.Slot = helpers.StartSlot(nextEpoch)
+		thisEpochBalance, err := t.boundaryAttestingBalance(head, nextEpoch-1)
+		if err != nil {
+			return err
+		}
+		var prevEpochBalance uint64
+		if nextEpoch >= 2 {
+			prevEpochBalance, err = t.boundaryAttestingBalance(head, nextEpoch-2)
+			if err != nil {
+				return err
+			}
+		}
+		activeIndices := helpers.ActiveValidatorIndices(t.finalityState.ValidatorRegistry, nextEpoch)
+		totalBalance := e.TotalBalance(t.finalityState, activeIndices)
+		t.finalityState = e.ProcessJustification(t.finalityState, thisEpochBalance, prevEpochBalance, totalBalance)
+		t.finalityState = e.ProcessFinalization(t.finalityState)
+	}
+
+	justified, err := t.boundaryBlock(head, helpers.StartSlot(t.finalityState.JustifiedEpoch))
+	if err != nil {
+		return err
+	}
+	if justified != nil {
+		t.justified = justified
+	}
+	finalized, err := t.boundaryBlock(head, helpers.StartSlot(t.finalityState.FinalizedEpoch))
+	if err != nil {
+		return err
+	}
+	if finalized != nil {
+		t.finalized = finalized
+	}
+	return nil
+}
+
+// boundaryAttestingBalance returns the balance of the validators which attested
+// during the epoch to its boundary block on the chain of the head.
+func (t *forkChoiceTree) boundaryAttestingBalance(head *pb.BeaconBlock, epoch uint64) (uint64, error) {
+	boundary, err := t.boundaryBlock(head, helpers.StartSlot(epoch))
+	if err != nil || boundary == nil {
+		return 0, err
+	}
+	var attesters []uint64
+	for index, target := range t.epochAttesters[epoch] {
+		ancestor, err := t.boundaryBlock(target, helpers.StartSlot(epoch))
+		if err != nil {
+			return 0, err
+		}
+		if ancestor != nil && proto.Equal(ancestor, boundary) {
+			attesters = append(attesters, index)
+		}
+	}
+	return e.TotalBalance(t.finalityState, attesters), nil
+}
+
+// boundaryBlock returns the latest block at or before the slot on the chain of
+// the given block, or nil if the block is older than the slot.
+func (t *forkChoiceTree) boundaryBlock(block *pb.BeaconBlock, slot uint64) (*pb.BeaconBlock, error) {
+	if block.Slot < slot {
+		return nil, nil
+	}
+	for block.Slot > slot {
+		parent, err := t.beaconDB.Block(bytesutil.ToBytes32(block.ParentRootHash32))
+		if err != nil {
+			return nil, fmt.Errorf("could not get parent block: %v", err)
+		}
+		if parent == nil {
+			return nil, fmt.Errorf("parent of block %s does not exist", t.id(block))
+		}
+		block = parent
+	}
+	return block, nil
+}
+
+func (t *forkChoiceTree) id(block *pb.BeaconBlock) string {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return "unknown"
+	}
+	return t.blockIDs[root]
+}
+
+// parseValidatorRange parses the shorthand of the validators field of fork choice
+// test attestations, a comma separated list of positions and inclusive ranges
+// such as "0-3, 5".
+func parseValidatorRange(validators string) ([]uint64, error) {
+	var positions []uint64
+	for _, part := range strings.Split(validators, ",") {
+		part = strings.TrimSpace(part)
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validators %q: %v", validators, err)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.ParseUint(strings.TrimSpace(bounds[1]), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validators %q: %v", validators, err)
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid validators %q: range %d-%d is decreasing", validators, start, end)
+		}
+		for position := start; position <= end; position++ {
+			positions = append(positions, position)
+		}
+	}
+	return positions, nil
+}
@@ -0,0 +1,146 @@
+package backend
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func forkChoiceTestConfig() *ForkChoiceTestConfig {
+	return &ForkChoiceTestConfig{
+		ValidatorCount:   100,
+		CycleLength:      8,
+		ShardCount:       64,
+		MinCommitteeSize: 8,
+	}
+}
+
+func TestParseValidatorRange(t *testing.T) {
+	tests := []struct {
+		validators string
+		positions  []uint64
+	}{
+		{validators: "0-5", positions: []uint64{0, 1, 2, 3, 4, 5}},
+		{validators: "6, 7", positions: []uint64{6, 7}},
+		{validators: "1, 3-4", positions: []uint64{1, 3, 4}},
+	}
+	for _, tt := range tests {
+		positions, err := parseValidatorRange(tt.validators)
+		if err != nil {
+			t.Fatalf("Could not parse %q: %v", tt.validators, err)
+		}
+		if !reflect.DeepEqual(positions, tt.positions) {
+			t.Errorf("Expected %v for %q, received %v", tt.positions, tt.validators, positions)
+		}
+	}
+	for _, invalid := range []string{"", "a", "3-1", "1-b"} {
+		if _, err := parseValidatorRange(invalid); err == nil {
+			t.Errorf("Expected error when parsing %q", invalid)
+		}
+	}
+}
+
+func TestRunForkChoiceTest_ReportsMismatchPerSlot(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(sb.beaconDB)
+
+	testCase := &ForkChoiceTestCase{
+		Config: forkChoiceTestConfig(),
+		Slots: []*ForkChoiceTestSlot{
+			{
+				SlotNumber:   1,
+				NewBlock:     &TestBlock{ID: "A", Parent: "*"},
+				Attestations: []*TestAttestation{{Block: "A", ValidatorRegistry: "0-5"}},
+			},
+			{
+				SlotNumber: 2,
+				NewBlock:   &TestBlock{ID: "B", Parent: "*"},
+			},
+		},
+		Results: &ForkChoiceTestResult{
+			Head:               "B",
+			LastJustifiedBlock: "*",
+			LastFinalizedBlock: "*",
+		},
+	}
+	err = sb.RunForkChoiceTest(testCase)
+	if err == nil {
+		t.Fatal("Expected error when the head does not match")
+	}
+	for _, want := range []string{
+		"head: expected B, received A",
+		"slot 1: head A, last justified *, last finalized *",
+		"slot 2: head A, last justified *, last finalized *",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, received %v", want, err)
+		}
+	}
+
+	testCase.Slots[0].Results = &ForkChoiceTestResult{
+		Head:               "*",
+		LastJustifiedBlock: "*",
+		LastFinalizedBlock: "*",
+	}
+	err = sb.RunForkChoiceTest(testCase)
+	if err == nil || !strings.Contains(err.Error(), "do not match at slot 1, head: expected *, received A") {
+		t.Errorf("Expected error for the results of slot 1, received %v", err)
+	}
+	testCase.Slots[0].Results = nil
+
+	testCase.Slots[1].NewBlock.Parent = "Z"
+	if err := sb.RunForkChoiceTest(testCase); err == nil || !strings.Contains(err.Error(), "parent Z of block B does not exist") {
+		t.Errorf("Expected error for an unknown parent, received %v", err)
+	}
+}
+
+func TestRunForkChoiceTest_JustifiesAndFinalizesEpochBoundaries(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(sb.beaconDB)
+
+	// A block is proposed at every slot of the first three epochs, every
+	// validator attests to the block of the slot of its committee. The epoch
+	// processing of the node finalizes the justified epoch once the epoch
+	// before it was justified as well.
+	testCase := &ForkChoiceTestCase{
+		Config: forkChoiceTestConfig(),
+		Results: &ForkChoiceTestResult{
+			Head:               "24",
+			LastJustifiedBlock: "16",
+			LastFinalizedBlock: "16",
+		},
+	}
+	expected := map[uint64]*ForkChoiceTestResult{
+		7:  {Head: "7", LastJustifiedBlock: "*", LastFinalizedBlock: "*"},
+		8:  {Head: "8", LastJustifiedBlock: "*", LastFinalizedBlock: "*"},
+		15: {Head: "15", LastJustifiedBlock: "*", LastFinalizedBlock: "*"},
+		16: {Head: "16", LastJustifiedBlock: "8", LastFinalizedBlock: "8"},
+	}
+	parent := "*"
+	for slot := uint64(1); slot <= 24; slot++ {
+		id := strconv.FormatUint(slot, 10)
+		testCase.Slots = append(testCase.Slots, &ForkChoiceTestSlot{
+			SlotNumber:   slot,
+			NewBlock:     &TestBlock{ID: id, Parent: parent},
+			Attestations: []*TestAttestation{{Block: id, ValidatorRegistry: "0-11"}},
+			Results:      expected[slot],
+		})
+		parent = id
+	}
+	if err := sb.RunForkChoiceTest(testCase); err != nil {
+		t.Errorf("Fork choice test failed: %v", err)
+	}
+}
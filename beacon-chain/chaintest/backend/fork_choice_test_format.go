@@ -25,9 +25,10 @@ type ForkChoiceTestConfig struct {
 
 // ForkChoiceTestSlot --
 type ForkChoiceTestSlot struct {
-	SlotNumber   uint64             `yaml:"slot_number"`
-	NewBlock     *TestBlock         `yaml:"new_block"`
-	Attestations []*TestAttestation `yaml:",flow"`
+	SlotNumber   uint64                `yaml:"slot_number"`
+	NewBlock     *TestBlock            `yaml:"new_block"`
+	Attestations []*TestAttestation    `yaml:",flow"`
+	Results      *ForkChoiceTestResult `yaml:"results"`
 }
 
 // ForkChoiceTestResult --
@@ -39,7 +40,7 @@ type ForkChoiceTestResult struct {
 
 // TestBlock --
 type TestBlock struct {
-	ID     string `yaml:"ID"`
+	ID     string `yaml:"id"`
 	Parent string `yaml:"parent"`
 }
 
@@ -0,0 +1,305 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// maxReportedDiffs is the number of differing post-state fields listed when a
+// sanity test fails, the full state of a large registry can differ in thousands.
+const maxReportedDiffs = 20
+
+// RunSanityTest runs a test case of the cross-client sanity test format. The
+// blocks of the test case are applied on top of its pre-state, with the slots
+// in between and after the last block processed without a block, until the slot
+// of the post-state. The resulting state is then compared field by field against
+// the post-state of the test case.
+func (sb *SimulatedBackend) RunSanityTest(testCase *SanityTestCase) error {
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	defer params.OverrideContractConfig(params.ContractConfig())
+	if err := applySanityTestConfig(testCase.Config); err != nil {
+		return fmt.Errorf("%s: %v", testCase.Name, err)
+	}
+	pre := &pb.BeaconState{}
+	if err := decodeSSZHex(testCase.Pre, pre); err != nil {
+		return fmt.Errorf("%s: could not decode pre-state: %v", testCase.Name, err)
+	}
+	blocks := make([]*pb.BeaconBlock, len(testCase.Blocks))
+	for i, enc := range testCase.Blocks {
+		blocks[i] = &pb.BeaconBlock{}
+		if err := decodeSSZHex(enc, blocks[i]); err != nil {
+			return fmt.Errorf("%s: could not decode block %d: %v", testCase.Name, i, err)
+		}
+	}
+	post := &pb.BeaconState{}
+	if err := decodeSSZHex(testCase.Post, post); err != nil {
+		return fmt.Errorf("%s: could not decode post-state: %v", testCase.Name, err)
+	}
+
+	received, err := applyBlocks(pre, blocks, post.Slot, testCase.VerifySignatures)
+	if err != nil {
+		return fmt.Errorf("%s: %v", testCase.Name, err)
+	}
+	diffs := diffStates(post, received)
+	if len(diffs) == 0 {
+		return nil
+	}
+	reported := diffs
+	if len(reported) > maxReportedDiffs {
+		reported = append(reported[:maxReportedDiffs:maxReportedDiffs],
+			fmt.Sprintf("and %d more", len(diffs)-maxReportedDiffs))
+	}
+	return fmt.Errorf("%s: post-state does not match in %d fields:\n%s",
+		testCase.Name, len(diffs), strings.Join(reported, "\n"))
+}
+
+// RecordStateTransitionTest runs a state transition test case and records the
+// genesis state, the simulated blocks and the resulting state as a sanity test
+// case, which other clients can run to compare their state transition against ours.
+func (sb *SimulatedBackend) RecordStateTransitionTest(testCase *StateTestCase) (*SanityTestCase, error) {
+	// Blocks with deposits are only valid against a deposit root the backend
+	// injects into the state, so a client could not replay them from the blocks.
+	if len(testCase.Config.Deposits) > 0 {
+		return nil, errors.New("state test cases with deposits can not be recorded")
+	}
+	defer teardownDB(sb.beaconDB)
+	setTestConfig(testCase)
+
+	if err := sb.initializeStateTest(testCase); err != nil {
+		return nil, fmt.Errorf("could not initialize state test %v", err)
+	}
+	sb.depositTrie = trieutil.NewDepositTrie()
+	sb.inMemoryBlocks = nil
+
+	// The backend sets the ETH1.0 data of the state to the root of its deposit
+	// trie before every block. Without deposits the root never changes, so it is
+	// part of the recorded pre-state and the blocks replay as long as the epoch
+	// processing does not update the ETH1.0 data.
+	depositRoot := sb.depositTrie.Root()
+	injectedEth1Data := &pb.Eth1Data{
+		DepositRootHash32: depositRoot[:],
+		BlockHash32:       []byte{},
+	}
+	sb.state.LatestEth1Data = proto.Clone(injectedEth1Data).(*pb.Eth1Data)
+	pre, err := encodeSSZHex(sb.state)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode pre-state: %v", err)
+	}
+	for i := uint64(0); i < testCase.Config.NumSlots; i++ {
+		if sliceutil.IsInUint64(i, testCase.Config.SkipSlots) {
+			if err := sb.GenerateNilBlockAndAdvanceChain(); err != nil {
+				return nil, fmt.Errorf("could not advance the chain with a nil block %v", err)
+			}
+			continue
+		}
+		if !proto.Equal(sb.state.LatestEth1Data, injectedEth1Data) {
+			return nil, fmt.Errorf("ETH1.0 data of the state at slot %d was updated by the epoch processing "+
+				"and would be overridden by the next block", sb.state.Slot)
+		}
+		if err := sb.GenerateBlockAndAdvanceChain(sb.generateSimulatedObjects(testCase, i)); err != nil {
+			return nil, fmt.Errorf("could not generate the block and advance the chain %v", err)
+		}
+	}
+
+	blocks := make([]string, len(sb.inMemoryBlocks))
+	for i, block := range sb.inMemoryBlocks {
+		if blocks[i], err = encodeSSZHex(block); err != nil {
+			return nil, fmt.Errorf("could not encode block at slot %d: %v", block.Slot, err)
+		}
+	}
+	post, err := encodeSSZHex(sb.state)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode post-state: %v", err)
+	}
+	return &SanityTestCase{
+		Name: fmt.Sprintf("%d slots with %d validators", testCase.Config.NumSlots, testCase.Config.DepositsForChainStart),
+		Config: map[string]interface{}{
+			"epoch_length":                 testCase.Config.EpochLength,
+			"deposits_for_chain_start":     testCase.Config.DepositsForChainStart,
+			"shard_count":                  params.BeaconConfig().ShardCount,
+			"latest_block_roots_length":    params.BeaconConfig().LatestBlockRootsLength,
+			"latest_randao_mixes_length":   params.BeaconConfig().LatestRandaoMixesLength,
+			"latest_index_roots_length":    params.BeaconConfig().LatestIndexRootsLength,
+			"latest_penalized_exit_length": params.BeaconConfig().LatestPenalizedExitLength,
+		},
+		Pre:    pre,
+		Blocks: blocks,
+		Post:   post,
+	}, nil
+}
+
+// applySanityTestConfig overrides the beacon chain config with the values of a
+// sanity test case, keyed by the same names as a chain config file.
+func applySanityTestConfig(config map[string]interface{}) error {
+	if len(config) == 0 {
+		return nil
+	}
+	data, err := yaml.Marshal(map[string]interface{}{"beacon_chain_config": config})
+	if err != nil {
+		return fmt.Errorf("could not encode config: %v", err)
+	}
+	beaconCfg, contractCfg, err := params.ParseChainConfig(data, params.BeaconConfig())
+	if err != nil {
+		return err
+	}
+	params.OverrideBeaconConfig(beaconCfg)
+	params.OverrideContractConfig(contractCfg)
+	return nil
+}
+
+// applyBlocks runs the state transition for every block and every slot without a
+// block until the given slot. The slots without a block before the first block use
+// its parent root, those after the last block its root, and a state without blocks
+// must be a genesis state so the root of the genesis block is known.
+func applyBlocks(beaconState *pb.BeaconState, blocks []*pb.BeaconBlock, slot uint64, verifySignatures bool) (*pb.BeaconState, error) {
+	var prevBlockRoot [32]byte
+	knownRoot := false
+	if beaconState.Slot == params.BeaconConfig().GenesisSlot {
+		encodedState, err := proto.Marshal(beaconState)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal genesis state: %v", err)
+		}
+		stateRoot := hashutil.Hash(encodedState)
+		prevBlockRoot, err = hashutil.HashBeaconBlock(b.NewGenesisBlock(stateRoot[:]))
+		if err != nil {
+			return nil, fmt.Errorf("could not hash genesis block: %v", err)
+		}
+		knownRoot = true
+	}
+
+	var err error
+	for _, block := range blocks {
+		if block.Slot <= beaconState.Slot {
+			return nil, fmt.Errorf("block at slot %d is not newer than state at slot %d", block.Slot, beaconState.Slot)
+		}
+		prevBlockRoot = bytesutil.ToBytes32(block.ParentRootHash32)
+		for beaconState.Slot+1 < block.Slot {
+			beaconState, err = state.ExecuteStateTransition(beaconState, nil, prevBlockRoot, verifySignatures)
+			if err != nil {
+				return nil, fmt.Errorf("could not process slot %d: %v", beaconState.Slot+1, err)
+			}
+		}
+		beaconState, err = state.ExecuteStateTransition(beaconState, block, prevBlockRoot, verifySignatures)
+		if err != nil {
+			return nil, fmt.Errorf("could not process block at slot %d: %v", block.Slot, err)
+		}
+		prevBlockRoot, err = hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash block at slot %d: %v", block.Slot, err)
+		}
+		knownRoot = true
+	}
+
+	if beaconState.Slot > slot {
+		return nil, fmt.Errorf("post-state at slot %d is older than the last block at slot %d", slot, beaconState.Slot)
+	}
+	if beaconState.Slot < slot && !knownRoot {
+		return nil, fmt.Errorf("can not process slots of a state at slot %d without blocks", beaconState.Slot)
+	}
+	for beaconState.Slot < slot {
+		beaconState, err = state.ExecuteStateTransition(beaconState, nil, prevBlockRoot, verifySignatures)
+		if err != nil {
+			return nil, fmt.Errorf("could not process slot %d: %v", beaconState.Slot+1, err)
+		}
+	}
+	return beaconState, nil
+}
+
+// diffStates lists every field in which the received state differs from the
+// expected one, as the path of the field followed by both values. Nil and empty
+// slices are equal, as SSZ does not tell them apart.
+func diffStates(expected *pb.BeaconState, received *pb.BeaconState) []string {
+	var diffs []string
+	diffValues("", reflect.ValueOf(expected).Elem(), reflect.ValueOf(received).Elem(), &diffs)
+	return diffs
+}
+
+func diffValues(path string, expected reflect.Value, received reflect.Value, diffs *[]string) {
+	switch expected.Kind() {
+	case reflect.Ptr:
+		if expected.IsNil() || received.IsNil() {
+			if expected.IsNil() != received.IsNil() {
+				*diffs = append(*diffs, fmt.Sprintf("%s: expected %s, received %s",
+					path, nilOrSet(expected), nilOrSet(received)))
+			}
+			return
+		}
+		diffValues(path, expected.Elem(), received.Elem(), diffs)
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			diffValues(fieldPath, expected.Field(i), received.Field(i), diffs)
+		}
+	case reflect.Slice:
+		if expected.Type().Elem().Kind() == reflect.Uint8 {
+			if !bytes.Equal(expected.Bytes(), received.Bytes()) {
+				*diffs = append(*diffs, fmt.Sprintf("%s: expected %#x, received %#x",
+					path, expected.Bytes(), received.Bytes()))
+			}
+			return
+		}
+		if expected.Len() != received.Len() {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expected length %d, received length %d",
+				path, expected.Len(), received.Len()))
+		}
+		for i := 0; i < expected.Len() && i < received.Len(); i++ {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), received.Index(i), diffs)
+		}
+	default:
+		if expected.Interface() != received.Interface() {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expected %v, received %v",
+				path, expected.Interface(), received.Interface()))
+		}
+	}
+}
+
+func nilOrSet(v reflect.Value) string {
+	if v.IsNil() {
+		return "nil"
+	}
+	return "set"
+}
+
+// encodeSSZHex encodes the value with SSZ as a 0x prefixed hex string.
+func encodeSSZHex(val interface{}) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := ssz.Encode(buf, val); err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(buf.Bytes()), nil
+}
+
+// decodeSSZHex decodes a 0x prefixed hex string of an SSZ encoded value.
+func decodeSSZHex(enc string, val interface{}) error {
+	if !strings.HasPrefix(enc, "0x") {
+		return errors.New("expected a 0x prefixed hex string")
+	}
+	data, err := hex.DecodeString(enc[2:])
+	if err != nil {
+		return err
+	}
+	return ssz.Decode(bytes.NewReader(data), val)
+}
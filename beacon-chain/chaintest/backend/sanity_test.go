@@ -0,0 +1,160 @@
+package backend
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func recordSanityTest(t *testing.T) *SanityTestCase {
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	// Runs through two epoch transitions, the second one at the end of an ETH1.0
+	// data voting period, and ends with a skipped slot. The simulated randao
+	// reveals do not allow skipping the slot of a validator who proposes again
+	// later in the test.
+	testCase, err := sb.RecordStateTransitionTest(&StateTestCase{
+		Config: &StateTestConfig{
+			EpochLength:           8,
+			DepositsForChainStart: 64,
+			NumSlots:              17,
+			SkipSlots:             []uint64{16},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not record state test: %v", err)
+	}
+	return testCase
+}
+
+func TestRecordStateTransitionTest_RunsAsSanityTest(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+
+	testCase := recordSanityTest(t)
+	if len(testCase.Blocks) != 16 {
+		t.Errorf("Expected 16 blocks for 17 slots with 1 skipped, received %d", len(testCase.Blocks))
+	}
+	post := &pb.BeaconState{}
+	if err := decodeSSZHex(testCase.Post, post); err != nil {
+		t.Fatal(err)
+	}
+	if post.Slot != 17 {
+		t.Errorf("Expected post-state at slot 17, received %d", post.Slot)
+	}
+
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sb.RunSanityTest(testCase); err != nil {
+		t.Errorf("Expected recorded test case to pass: %v", err)
+	}
+}
+
+func TestRunSanityTest_ReportsDifferingFields(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+
+	testCase := recordSanityTest(t)
+	post := &pb.BeaconState{}
+	if err := decodeSSZHex(testCase.Post, post); err != nil {
+		t.Fatal(err)
+	}
+	post.ValidatorBalances[3]++
+	post.LatestEth1Data.BlockHash32 = []byte{'a'}
+	enc, err := encodeSSZHex(post)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCase.Post = enc
+
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sb.RunSanityTest(testCase)
+	if err == nil {
+		t.Fatal("Expected tampered post-state to fail")
+	}
+	for _, want := range []string{
+		"post-state does not match in 2 fields",
+		"ValidatorBalances[3]: expected",
+		"LatestEth1Data.BlockHash32: expected 0x61",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, received %v", want, err)
+		}
+	}
+}
+
+func TestRecordStateTransitionTest_RejectsDeposits(t *testing.T) {
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sb.RecordStateTransitionTest(&StateTestCase{
+		Config: &StateTestConfig{
+			EpochLength:           8,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			Deposits:              []*StateTestDeposit{{Slot: 1, Amount: 32}},
+		},
+	}); err == nil {
+		t.Error("Expected error when recording a test case with deposits")
+	}
+}
+
+func TestRecordStateTransitionTest_RejectsUpdatedEth1Data(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The block after the voting period would reset the ETH1.0 data voted in by
+	// the simulated blocks.
+	_, err = sb.RecordStateTransitionTest(&StateTestCase{
+		Config: &StateTestConfig{
+			EpochLength:           8,
+			DepositsForChainStart: 64,
+			NumSlots:              17,
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "ETH1.0 data of the state at slot 16 was updated") {
+		t.Errorf("Expected error for updated ETH1.0 data, received %v", err)
+	}
+}
+
+func TestDiffStates(t *testing.T) {
+	expected := &pb.BeaconState{
+		Slot:              5,
+		ValidatorBalances: []uint64{1, 2},
+		ValidatorRegistry: []*pb.Validator{{ExitEpoch: 1}},
+		Fork:              &pb.Fork{},
+	}
+	received := &pb.BeaconState{
+		Slot:                     5,
+		ValidatorBalances:        []uint64{1, 2, 3},
+		ValidatorRegistry:        []*pb.Validator{{ExitEpoch: 2}},
+		LatestRandaoMixesHash32S: [][]byte{},
+	}
+	want := []string{
+		"ValidatorRegistry[0].ExitEpoch: expected 1, received 2",
+		"ValidatorBalances: expected length 2, received length 3",
+		"Fork: expected set, received nil",
+	}
+	diffs := diffStates(expected, received)
+	if len(diffs) != len(want) {
+		t.Fatalf("Expected %d differences, received %v", len(want), diffs)
+	}
+	for i := range want {
+		if diffs[i] != want[i] {
+			t.Errorf("Expected difference %q, received %q", want[i], diffs[i])
+		}
+	}
+}
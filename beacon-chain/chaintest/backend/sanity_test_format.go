@@ -0,0 +1,21 @@
+package backend
+
+// SanityTest --
+type SanityTest struct {
+	Title     string
+	Summary   string
+	Fork      string            `yaml:"fork"`
+	Version   string            `yaml:"version"`
+	TestSuite string            `yaml:"test_suite"`
+	TestCases []*SanityTestCase `yaml:"test_cases"`
+}
+
+// SanityTestCase --
+type SanityTestCase struct {
+	Name             string                 `yaml:"name"`
+	Config           map[string]interface{} `yaml:"config"`
+	VerifySignatures bool                   `yaml:"verify_signatures"`
+	Pre              string                 `yaml:"pre"`
+	Blocks           []string               `yaml:"blocks"`
+	Post             string                 `yaml:"post"`
+}
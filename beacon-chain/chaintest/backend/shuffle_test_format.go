@@ -7,9 +7,16 @@ type ShuffleTest struct {
 	TestSuite string             `yaml:"test_suite"`
 	Fork      string             `yaml:"fork"`
 	Version   string             `yaml:"version"`
+	Config    ShuffleTestConfig  `yaml:"config"`
 	TestCases []*ShuffleTestCase `yaml:"test_cases"`
 }
 
+// ShuffleTestConfig --
+type ShuffleTestConfig struct {
+	ShuffleAlgorithm  string `yaml:"shuffle_algorithm"`
+	ShuffleRoundCount uint64 `yaml:"shuffle_round_count"`
+}
+
 // ShuffleTestCase --
 type ShuffleTestCase struct {
 	Input  []uint64 `yaml:"input,flow"`
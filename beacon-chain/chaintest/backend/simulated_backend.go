@@ -5,8 +5,10 @@ package backend
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -185,9 +187,20 @@ func (sb *SimulatedBackend) Shutdown() error {
 
 // RunForkChoiceTest uses a parsed set of chaintests from a YAML file
 // according to the ETH 2.0 client chain test specification and runs them
-// against the simulated backend.
+// against the simulated backend. The blocks and attestations of every slot
+// are applied to a block tree on which the node's fork choice rule runs,
+// and the resulting head, last justified and last finalized blocks are
+// compared against the expected results of every slot which has them and
+// against the expected results of the test case after the last slot.
 func (sb *SimulatedBackend) RunForkChoiceTest(testCase *ForkChoiceTestCase) error {
-	defer teardownDB(sb.beaconDB)
+	// Every test case builds its own block tree, so it uses a fresh DB
+	// instead of the one of the backend.
+	beaconDB, err := setupDB()
+	if err != nil {
+		return fmt.Errorf("could not setup fork choice test db: %v", err)
+	}
+	defer teardownDB(beaconDB)
+
 	// Utilize the config parameters in the test case to setup
 	// the DB and set global config parameters accordingly.
 	// Config parameters include: ValidatorCount, ShardCount,
@@ -199,29 +212,83 @@ func (sb *SimulatedBackend) RunForkChoiceTest(testCase *ForkChoiceTestCase) erro
 	c.TargetCommitteeSize = testCase.Config.MinCommitteeSize
 	params.OverrideBeaconConfig(c)
 
-	// Then, we create the validators based on the custom test config.
-	randaoPreCommit := [32]byte{}
-	randaoReveal := hashutil.Hash(randaoPreCommit[:])
-	validators := make([]*pb.Validator, testCase.Config.ValidatorCount)
-	for i := uint64(0); i < testCase.Config.ValidatorCount; i++ {
-		validators[i] = &pb.Validator{
-			ExitEpoch:              params.BeaconConfig().EntryExitDelay,
-			Pubkey:                 []byte{},
-			RandaoCommitmentHash32: randaoReveal[:],
+	tree, err := newForkChoiceTree(beaconDB, testCase.Config.ValidatorCount)
+	if err != nil {
+		return err
+	}
+	var results []*forkChoiceSlotResult
+	for _, slot := range testCase.Slots {
+		if slot.NewBlock != nil {
+			if err := tree.addBlock(slot.SlotNumber, slot.NewBlock); err != nil {
+				return fmt.Errorf("slot %d: %v", slot.SlotNumber, err)
+			}
+		}
+		for _, attestation := range slot.Attestations {
+			if err := tree.applyAttestation(slot.SlotNumber, attestation); err != nil {
+				return fmt.Errorf("slot %d: %v", slot.SlotNumber, err)
+			}
+		}
+		result, err := tree.updateHead(slot.SlotNumber)
+		if err != nil {
+			return fmt.Errorf("slot %d: %v", slot.SlotNumber, err)
+		}
+		results = append(results, result)
+		if slot.Results != nil {
+			if err := compareForkChoiceResults(slot.Results, results); err != nil {
+				return err
+			}
 		}
 	}
-	// TODO(#718): Next step is to update and save the blocks specified
-	// in the case case into the DB.
-	//
-	// Then, we call the updateHead routine and confirm the
-	// chain's head is the expected result from the test case.
-	return nil
+
+	if len(results) == 0 {
+		return errors.New("fork choice test case has no slots")
+	}
+	return compareForkChoiceResults(testCase.Results, results)
+}
+
+// compareForkChoiceResults checks the results after the latest slot against the
+// expected results, listing the results of every slot so far if they do not match.
+func compareForkChoiceResults(expected *ForkChoiceTestResult, results []*forkChoiceSlotResult) error {
+	last := results[len(results)-1]
+	var mismatches []string
+	if last.head != expected.Head {
+		mismatches = append(mismatches, fmt.Sprintf("head: expected %s, received %s", expected.Head, last.head))
+	}
+	if last.justified != expected.LastJustifiedBlock {
+		mismatches = append(mismatches, fmt.Sprintf(
+			"last justified block: expected %s, received %s", expected.LastJustifiedBlock, last.justified))
+	}
+	if last.finalized != expected.LastFinalizedBlock {
+		mismatches = append(mismatches, fmt.Sprintf(
+			"last finalized block: expected %s, received %s", expected.LastFinalizedBlock, last.finalized))
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	trace := make([]string, len(results))
+	for i, result := range results {
+		trace[i] = result.String()
+	}
+	return fmt.Errorf("fork choice results do not match at slot %d, %s\n%s",
+		last.slot, strings.Join(mismatches, ", "), strings.Join(trace, "\n"))
 }
 
 // RunShuffleTest uses validator set specified from a YAML file, runs the validator shuffle
-// algorithm, then compare the output with the expected output from the YAML file.
-func (sb *SimulatedBackend) RunShuffleTest(testCase *ShuffleTestCase) error {
+// algorithm, then compare the output with the expected output from the YAML file. The
+// shuffle algorithm of the test config is only used for the test case, and defaults to
+// the algorithm of the beacon chain config.
+func (sb *SimulatedBackend) RunShuffleTest(config *ShuffleTestConfig, testCase *ShuffleTestCase) error {
 	defer teardownDB(sb.beaconDB)
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	c := *params.BeaconConfig()
+	if config.ShuffleAlgorithm != "" {
+		c.ShuffleAlgorithm = config.ShuffleAlgorithm
+	}
+	if config.ShuffleRoundCount != 0 {
+		c.ShuffleRoundCount = config.ShuffleRoundCount
+	}
+	params.OverrideBeaconConfig(&c)
+
 	seed := common.BytesToHash([]byte(testCase.Seed))
 	output, err := utils.ShuffleIndices(seed, testCase.Input)
 	if err != nil {
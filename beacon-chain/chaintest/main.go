@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-yaml/yaml"
@@ -17,6 +19,7 @@ func readTestsFromYaml(yamlDir string) ([]interface{}, error) {
 	const forkChoiceTestsFolderName = "fork-choice-tests"
 	const shuffleTestsFolderName = "shuffle-tests"
 	const stateTestsFolderName = "state-tests"
+	const sanityTestsFolderName = "sanity-tests"
 
 	var tests []interface{}
 
@@ -55,6 +58,12 @@ func readTestsFromYaml(yamlDir string) ([]interface{}, error) {
 					return nil, fmt.Errorf("could not unmarshal YAML file into test struct: %v", err)
 				}
 				tests = append(tests, decoded)
+			case sanityTestsFolderName:
+				decoded := &backend.SanityTest{}
+				if err := yaml.Unmarshal(data, decoded); err != nil {
+					return nil, fmt.Errorf("could not unmarshal YAML file into test struct: %v", err)
+				}
+				tests = append(tests, decoded)
 			}
 		}
 	}
@@ -80,8 +89,11 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 			log.Infof("Test Suite: %v", typedTest.TestSuite)
 			log.Infof("Fork: %v", typedTest.Fork)
 			log.Infof("Version: %v", typedTest.Version)
+			if typedTest.Config.ShuffleAlgorithm != "" {
+				log.Infof("Shuffle Algorithm: %v", typedTest.Config.ShuffleAlgorithm)
+			}
 			for _, testCase := range typedTest.TestCases {
-				if err := sb.RunShuffleTest(testCase); err != nil {
+				if err := sb.RunShuffleTest(&typedTest.Config, testCase); err != nil {
 					return fmt.Errorf("chain test failed: %v", err)
 				}
 			}
@@ -98,6 +110,18 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 				}
 			}
 			log.Info("Test PASSED")
+		case *backend.SanityTest:
+			log.Infof("Title: %v", typedTest.Title)
+			log.Infof("Summary: %v", typedTest.Summary)
+			log.Infof("Test Suite: %v", typedTest.TestSuite)
+			log.Infof("Fork: %v", typedTest.Fork)
+			log.Infof("Version: %v", typedTest.Version)
+			for _, testCase := range typedTest.TestCases {
+				if err := sb.RunSanityTest(testCase); err != nil {
+					return fmt.Errorf("chain test failed: %v", err)
+				}
+			}
+			log.Info("Test PASSED")
 		default:
 			return fmt.Errorf("receive unknown test type: %T", typedTest)
 		}
@@ -106,8 +130,49 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 	return nil
 }
 
+var nonAlphanumeric = regexp.MustCompile("[^a-z0-9]+")
+
+// recordTests records the test cases of every state test as a sanity test with
+// SSZ encoded states and blocks, written to the directory in a file named after
+// the title of the state test. Test cases which can not be recorded are skipped.
+func recordTests(tests []interface{}, sb *backend.SimulatedBackend, recordDir string) error {
+	for _, tt := range tests {
+		stateTest, ok := tt.(*backend.StateTest)
+		if !ok {
+			continue
+		}
+		sanityTest := &backend.SanityTest{
+			Title:     stateTest.Title,
+			Summary:   stateTest.Summary,
+			Fork:      stateTest.Fork,
+			Version:   stateTest.Version,
+			TestSuite: stateTest.TestSuite,
+		}
+		for i, testCase := range stateTest.TestCases {
+			recorded, err := sb.RecordStateTransitionTest(testCase)
+			if err != nil {
+				log.Warnf("Skipping test case %d of %s: %v", i, stateTest.Title, err)
+				continue
+			}
+			sanityTest.TestCases = append(sanityTest.TestCases, recorded)
+		}
+		data, err := yaml.Marshal(sanityTest)
+		if err != nil {
+			return fmt.Errorf("could not marshal sanity test: %v", err)
+		}
+		name := strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(stateTest.Title), "_"), "_")
+		filePath := path.Join(recordDir, name+".yaml")
+		if err := ioutil.WriteFile(filePath, data, 0644); err != nil {
+			return fmt.Errorf("could not write sanity test: %v", err)
+		}
+		log.Infof("Recorded %d test cases of %s to %s", len(sanityTest.TestCases), stateTest.Title, filePath)
+	}
+	return nil
+}
+
 func main() {
 	var yamlDir = flag.String("tests-dir", "", "path to directory of yaml tests")
+	var recordDir = flag.String("record-dir", "", "record the state tests as SSZ sanity tests into this directory instead of running the tests")
 	flag.Parse()
 
 	customFormatter := new(prefixed.TextFormatter)
@@ -125,6 +190,13 @@ func main() {
 		log.Fatalf("Could not create backend: %v", err)
 	}
 
+	if *recordDir != "" {
+		if err := recordTests(tests, sb, *recordDir); err != nil {
+			log.Fatalf("Could not record tests: %v", err)
+		}
+		return
+	}
+
 	log.Info("----Running Tests----")
 	startTime := time.Now()
 
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/chaintest/backend"
@@ -22,6 +25,44 @@ func TestFromYaml(t *testing.T) {
 	}
 }
 
+func TestRecordTests(t *testing.T) {
+	tests, err := readTestsFromYaml("./tests")
+	if err != nil {
+		t.Fatalf("Failed to read yaml files: %v", err)
+	}
+	dir, err := ioutil.TempDir("", "chaintest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	recordDir := path.Join(dir, "sanity-tests")
+	if err := os.Mkdir(recordDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	sb, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create backend: %v", err)
+	}
+	if err := recordTests(tests, sb, recordDir); err != nil {
+		t.Fatalf("Failed to record tests: %v", err)
+	}
+
+	recorded, err := readTestsFromYaml(dir)
+	if err != nil {
+		t.Fatalf("Failed to read recorded tests: %v", err)
+	}
+	if len(recorded) != 1 {
+		t.Fatalf("Expected 1 recorded sanity test, received %d", len(recorded))
+	}
+	if len(recorded[0].(*backend.SanityTest).TestCases) == 0 {
+		t.Fatal("Expected recorded test cases")
+	}
+	if err := runTests(recorded, sb); err != nil {
+		t.Errorf("Failed to run recorded tests %v", err)
+	}
+}
+
 func BenchmarkStateTestFromYaml(b *testing.B) {
 	tests, err := readTestsFromYaml("./tests")
 	if err != nil {
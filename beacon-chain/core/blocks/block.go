@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -88,13 +89,16 @@ func BlockRoot(state *pb.BeaconState, slot uint64) ([]byte, error) {
 //  Let previous_block_root be the tree_hash_root of the previous beacon block processed in the chain.
 //	Set state.latest_block_roots[(state.slot - 1) % LATEST_BLOCK_ROOTS_LENGTH] = previous_block_root.
 //	If state.slot % LATEST_BLOCK_ROOTS_LENGTH == 0 append merkle_root(state.latest_block_roots) to state.batched_block_roots.
-func ProcessBlockRoots(state *pb.BeaconState, prevBlockRoot [32]byte) *pb.BeaconState {
-	state.LatestBlockRootHash32S[(state.Slot-1)%params.BeaconConfig().LatestBlockRootsLength] = prevBlockRoot[:]
-	if state.Slot%params.BeaconConfig().LatestBlockRootsLength == 0 {
-		merkleRoot := hashutil.MerkleRoot(state.LatestBlockRootHash32S)
-		state.BatchedBlockRootHash32S = append(state.BatchedBlockRootHash32S, merkleRoot)
+func ProcessBlockRoots(state *sharedstate.BeaconState, prevBlockRoot [32]byte) (*sharedstate.BeaconState, error) {
+	rootIdx := (state.Slot() - 1) % params.BeaconConfig().LatestBlockRootsLength
+	if err := state.UpdateBlockRootAtIndex(rootIdx, prevBlockRoot[:]); err != nil {
+		return nil, err
 	}
-	return state
+	if state.Slot()%params.BeaconConfig().LatestBlockRootsLength == 0 {
+		merkleRoot := hashutil.MerkleRoot(state.LatestBlockRoots())
+		state.AppendBatchedBlockRoot(merkleRoot)
+	}
+	return state, nil
 }
 
 // EncodeDepositData converts a deposit input proto into an a byte slice
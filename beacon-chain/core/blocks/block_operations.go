@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"reflect"
 
+	att "github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
@@ -22,20 +23,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
-// VerifyProposerSignature uses BLS signature verification to ensure
-// the correct proposer created an incoming beacon block during state
-// transition processing.
-//
-// WIP - this is stubbed out until BLS is integrated into Prysm.
-func VerifyProposerSignature(
-	block *pb.BeaconBlock,
-) error {
-	if block == nil {
-		return errors.New("received nil block")
-	}
-	return nil
-}
-
 // ProcessEth1Data is an operation performed on each
 // beacon block to ensure the ETH1 data votes are processed
 // into the beacon state.
@@ -192,8 +179,8 @@ func verifyProposerSlashing(
 	if shard1 != shard2 {
 		return fmt.Errorf("slashing proposal data shards do not match: %d, %d", shard1, shard2)
 	}
-	if !bytes.Equal(root1, root2) {
-		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
+	if bytes.Equal(root1, root2) {
+		return fmt.Errorf("slashing proposal data block roots are the same: %#x", root1)
 	}
 	if verifySignatures {
 		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
@@ -219,16 +206,8 @@ func verifyProposerSlashing(
 //   Let intersection = [x for x in indices(attester_slashing.votes_1)
 //     if x in indices(attester_slashing.votes_2)].
 //   Verify that len(intersection) >= 1.
-//	 Verify the following about the attester votes:
-//     (vote1.justified_slot < vote2.justified_slot) &&
-//     (vote2.justified_slot + 1 == vote2.slot) &&
-//     (vote2.slot < vote1.slot)
-//     OR
-//     vote1.slot == vote.slot
-//   Verify that attester_slashing.votes_1.data.justified_slot + 1 <
-//     attester_slashing.votes_2.data.justified_slot + 1 ==
-//     attester_slashing.votes_2.data.slot < attester_slashing.votes_1.data.slot
-//     or attester_slashing.votes_1.data.slot == attester_slashing.votes_2.data.slot.
+//   Verify that is_double_vote(attester_slashing.votes_1.data, attester_slashing.votes_2.data)
+//     or is_surround_vote(attester_slashing.votes_1.data, attester_slashing.votes_2.data).
 //   For each validator index i in intersection,
 //     if state.validator_registry[i].penalized_slot > state.slot, then
 // 	   run penalize_validator(state, i)
@@ -292,39 +271,16 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 		)
 	}
 
-	// Unless the following holds, the slashing is invalid:
-	// (vote1.justified_slot < vote2.justified_slot) &&
-	// (vote2.justified_slot + 1 == vote2.slot) &&
-	// (vote2.slot < vote1.slot)
-	// OR
-	// vote1.slot == vote2.slot
-	justificationValidity :=
-		(slashableVoteData1Attestation.JustifiedSlot < slashableVoteData2Attestation.JustifiedSlot) &&
-			(slashableVoteData2Attestation.JustifiedSlot+1 == slashableVoteData2Attestation.Slot) &&
-			(slashableVoteData2Attestation.Slot < slashableVoteData1Attestation.Slot)
-
-	slotsEqual := slashableVoteData1Attestation.Slot == slashableVoteData2Attestation.Slot
-
-	if !(justificationValidity || slotsEqual) {
+	// The votes are only slashable if they have the same target, or if the first
+	// vote surrounds the second one.
+	if !att.IsDoubleVote(slashableVoteData1Attestation, slashableVoteData2Attestation) &&
+		!att.IsSurroundVote(slashableVoteData1Attestation, slashableVoteData2Attestation) {
 		return fmt.Errorf(
-			`
-			Expected the following conditions to hold:
-			(slashableVoteData1.JustifiedSlot <
-			slashableVoteData2.JustifiedSlot) &&
-			(slashableVoteData2.JustifiedSlot + 1
-			== slashableVoteData1.Slot) &&
-			(slashableVoteData2.Slot < slashableVoteData1.Slot)
-			OR
-			slashableVoteData1.Slot == slashableVoteData2.Slot
-
-			Instead, received slashableVoteData1.JustifiedSlot %d,
-			slashableVoteData2.JustifiedSlot %d
-			and slashableVoteData1.Slot %d, slashableVoteData2.Slot %d
-			`,
-			slashableVoteData1Attestation.JustifiedSlot,
-			slashableVoteData2Attestation.JustifiedSlot,
+			"attester slashing votes are neither a double vote nor a surround vote: slots %d, %d and justified slots %d, %d",
 			slashableVoteData1Attestation.Slot,
 			slashableVoteData2Attestation.Slot,
+			slashableVoteData1Attestation.JustifiedSlot,
+			slashableVoteData2Attestation.JustifiedSlot,
 		)
 	}
 	return nil
@@ -344,9 +300,10 @@ func attesterSlashingPenalizedIndices(slashing *pb.AttesterSlashing) ([]uint64,
 }
 
 func verifySlashableVote(votes *pb.SlashableVote, verifySignatures bool) error {
+	// Custody bits are not used until phase 1, the custody bitfield must be empty.
 	emptyCustody := make([]byte, len(votes.CustodyBitfield))
-	if bytes.Equal(votes.CustodyBitfield, emptyCustody) {
-		return errors.New("custody bit field can't all be 0s")
+	if !bytes.Equal(votes.CustodyBitfield, emptyCustody) {
+		return errors.New("custody bit field must be all 0s")
 	}
 	if len(votes.ValidatorIndices) == 0 {
 		return errors.New("empty validator indices")
@@ -686,7 +643,7 @@ func ProcessValidatorExits(
 
 	validatorRegistry := beaconState.ValidatorRegistry
 	for idx, exit := range exits {
-		if err := verifyExit(beaconState, exit, verifySignatures); err != nil {
+		if err := VerifyExit(beaconState, exit, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify exit #%d: %v", idx, err)
 		}
 		beaconState = v.InitiateValidatorExit(beaconState, exit.ValidatorIndex)
@@ -695,7 +652,16 @@ func ProcessValidatorExits(
 	return beaconState, nil
 }
 
-func verifyExit(beaconState *pb.BeaconState, exit *pb.Exit, verifySignatures bool) error {
+// VerifyExit checks that the exit of a validator can be included in a block
+// processed on top of the given state, as done by ProcessValidatorExits.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.Exit, verifySignatures bool) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf(
+			"validator index %d exceeds validator registry length %d",
+			exit.ValidatorIndex,
+			len(beaconState.ValidatorRegistry),
+		)
+	}
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	if validator.ExitEpoch <= beaconState.Slot+params.BeaconConfig().EntryExitDelay {
 		return fmt.Errorf(
@@ -251,7 +251,7 @@ func TestProcessProposerSlashings_UnmatchedShards(t *testing.T) {
 	}
 }
 
-func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
+func TestProcessProposerSlashings_SameBlockRoots(t *testing.T) {
 	registry := []*pb.Validator{}
 	currentSlot := uint64(0)
 	slashings := []*pb.ProposerSlashing{
@@ -265,7 +265,7 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           0,
-				BlockRootHash32: []byte{1, 1, 0},
+				BlockRootHash32: []byte{0, 1, 0},
 			},
 		},
 	}
@@ -280,8 +280,8 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 		},
 	}
 	want := fmt.Sprintf(
-		"slashing proposal data block roots do not match: %#x, %#x",
-		[]byte{0, 1, 0}, []byte{1, 1, 0},
+		"slashing proposal data block roots are the same: %#x",
+		[]byte{0, 1, 0},
 	)
 
 	if _, err := ProcessProposerSlashings(
@@ -320,7 +320,7 @@ func TestProcessProposerSlashings_AppliesCorrectStatus(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -382,20 +382,21 @@ func TestProcessAttesterSlashings_ThresholdReached(t *testing.T) {
 	}
 }
 
-func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
+func TestProcessAttesterSlashings_NonEmptyCustodyFields(t *testing.T) {
+	att1 := &pb.AttestationData{
+		Slot: 5,
+	}
 	slashings := []*pb.AttesterSlashing{
 		{
 			SlashableVote_1: &pb.SlashableVote{
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				Data:             att1,
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x80},
 			},
 			SlashableVote_2: &pb.SlashableVote{
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				Data:             att1,
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -411,47 +412,25 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 			AttesterSlashings: slashings,
 		},
 	}
-	want := fmt.Sprint("custody bit field can't all be 0")
+	want := "could not verify attester slashable vote data 1: custody bit field must be all 0s"
 
 	if _, err := ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 
-	// Perform the same check for SlashableVoteData_2.
-	slashings = []*pb.AttesterSlashing{
-		{
-			SlashableVote_1: &pb.SlashableVote{
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-			SlashableVote_2: &pb.SlashableVote{
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-		},
-	}
-	beaconState = &pb.BeaconState{
-		ValidatorRegistry: registry,
-		Slot:              currentSlot,
-	}
-	block = &pb.BeaconBlock{
-		Body: &pb.BeaconBlockBody{
-			AttesterSlashings: slashings,
-		},
-	}
+	// Perform the same check for SlashableVote_2.
+	slashings[0].SlashableVote_1.CustodyBitfield = []byte{0x00}
+	slashings[0].SlashableVote_2.CustodyBitfield = []byte{0x80}
+	want = "could not verify attester slashable vote data 2: custody bit field must be all 0s"
 	if _, err := ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 }
@@ -465,12 +444,12 @@ func TestProcessAttesterSlashings_UnmatchedAttestations(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{2},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -501,51 +480,32 @@ func TestProcessAttesterSlashings_UnmatchedAttestations(t *testing.T) {
 	}
 }
 
-func TestProcessAttesterSlashings_SlotsInequalities(t *testing.T) {
+func TestProcessAttesterSlashings_NeitherDoubleNorSurroundVote(t *testing.T) {
+	epochLength := params.BeaconConfig().EpochLength
 	testCases := []struct {
 		att1 *pb.AttestationData
 		att2 *pb.AttestationData
 	}{
 		{
-			// Case 0: vote1.JustifiedSlot < vote2.JustifiedSlot is false
-			// vote2.JustifiedSlot + 1 == vote2.Slot is true
-			// vote2.Slot < vote1.Slot is true
-			// and slots are unequal.
-			att1: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          6,
-			},
-			att2: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          5,
-			},
-		},
-		{
-			// Case 1: vote1.JustifiedSlot < vote2.JustifiedSlot is false
-			// vote2.JustifiedSlot + 1 == vote2.Slot is false
-			// vote2.Slot < vote1.Slot is true
-			// and slots are unequal.
+			// Case 0: the votes have the same source but different targets.
 			att1: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          8,
+				JustifiedSlot: epochLength,
+				Slot:          3 * epochLength,
 			},
 			att2: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          7,
+				JustifiedSlot: epochLength,
+				Slot:          2 * epochLength,
 			},
 		},
 		{
-			// Case 2: vote1.JustifiedSlot < vote2.JustifiedSlot is false
-			// vote2.JustifiedSlot + 1 == vote2.Slot is false
-			// vote2.Slot < vote1.Slot is false
-			// and slots are unequal.
+			// Case 1: the second vote surrounds the first one.
 			att1: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          6,
+				JustifiedSlot: 2 * epochLength,
+				Slot:          3 * epochLength,
 			},
 			att2: &pb.AttestationData{
-				JustifiedSlot: 4,
-				Slot:          7,
+				JustifiedSlot: epochLength,
+				Slot:          4 * epochLength,
 			},
 		},
 	}
@@ -555,12 +515,12 @@ func TestProcessAttesterSlashings_SlotsInequalities(t *testing.T) {
 				SlashableVote_1: &pb.SlashableVote{
 					Data:             tt.att1,
 					ValidatorIndices: []uint64{1},
-					CustodyBitfield:  []byte{0xFF},
+					CustodyBitfield:  []byte{0x00},
 				},
 				SlashableVote_2: &pb.SlashableVote{
 					Data:             tt.att2,
 					ValidatorIndices: []uint64{2},
-					CustodyBitfield:  []byte{0xFF},
+					CustodyBitfield:  []byte{0x00},
 				},
 			},
 		}
@@ -576,26 +536,7 @@ func TestProcessAttesterSlashings_SlotsInequalities(t *testing.T) {
 				AttesterSlashings: slashings,
 			},
 		}
-		want := fmt.Sprintf(
-			`
-			Expected the following conditions to hold:
-			(slashableVoteData1.JustifiedSlot <
-			slashableVoteData2.JustifiedSlot) &&
-			(slashableVoteData2.JustifiedSlot + 1
-			== slashableVoteData1.Slot) &&
-			(slashableVoteData2.Slot < slashableVoteData1.Slot)
-			OR
-			slashableVoteData1.Slot == slashableVoteData2.Slot
-
-			Instead, received slashableVoteData1.JustifiedSlot %d,
-			slashableVoteData2.JustifiedSlot %d
-			and slashableVoteData1.Slot %d, slashableVoteData2.Slot %d
-			`,
-			tt.att1.JustifiedSlot,
-			tt.att2.JustifiedSlot,
-			tt.att1.Slot,
-			tt.att2.Slot,
-		)
+		want := "attester slashing votes are neither a double vote nor a surround vote"
 
 		if _, err := ProcessAttesterSlashings(
 			beaconState,
@@ -621,12 +562,12 @@ func TestProcessAttesterSlashings_EmptyVoteIndexIntersection(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att2,
 				ValidatorIndices: []uint64{2},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -680,12 +621,12 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att2,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -1421,6 +1362,36 @@ func TestProcessValidatorExits_ThresholdReached(t *testing.T) {
 	}
 }
 
+func TestProcessValidatorExits_ValidatorIndexOutOfRange(t *testing.T) {
+	exits := []*pb.Exit{
+		{
+			ValidatorIndex: 1,
+		},
+	}
+	registry := []*pb.Validator{
+		{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		},
+	}
+	state := &pb.BeaconState{
+		ValidatorRegistry: registry,
+	}
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			Exits: exits,
+		},
+	}
+
+	want := "validator index 1 exceeds validator registry length 1"
+	if _, err := ProcessValidatorExits(
+		state,
+		block,
+		false,
+	); !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessValidatorExits_ValidatorNotActive(t *testing.T) {
 	exits := []*pb.Exit{
 		{
@@ -1553,3 +1524,28 @@ func TestProcessValidatorExits_AppliesCorrectStatus(t *testing.T) {
 		t.Error("Expected validator status to change, remained INITIAL")
 	}
 }
+
+func TestVerifyAttesterSlashing_SurroundVote(t *testing.T) {
+	epochLength := params.BeaconConfig().EpochLength
+	slashing := &pb.AttesterSlashing{
+		SlashableVote_1: &pb.SlashableVote{
+			Data: &pb.AttestationData{
+				JustifiedSlot: epochLength,
+				Slot:          4 * epochLength,
+			},
+			ValidatorIndices: []uint64{1},
+			CustodyBitfield:  []byte{0x00},
+		},
+		SlashableVote_2: &pb.SlashableVote{
+			Data: &pb.AttestationData{
+				JustifiedSlot: 2 * epochLength,
+				Slot:          3 * epochLength,
+			},
+			ValidatorIndices: []uint64{1},
+			CustodyBitfield:  []byte{0x00},
+		},
+	}
+	if err := verifyAttesterSlashing(slashing, false); err != nil {
+		t.Errorf("Expected a surround vote to be slashable: %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -142,24 +143,30 @@ func TestProcessBlockRoots(t *testing.T) {
 
 	testRoot := [32]byte{'a'}
 
-	newState := ProcessBlockRoots(state, testRoot)
-	if !bytes.Equal(newState.LatestBlockRootHash32S[0], testRoot[:]) {
+	newState, err := ProcessBlockRoots(sharedstate.New(state), testRoot)
+	if err != nil {
+		t.Fatalf("Could not process block roots: %v", err)
+	}
+	if !bytes.Equal(newState.LatestBlockRoots()[0], testRoot[:]) {
 		t.Fatalf("Latest Block root hash not saved."+
-			" Supposed to get %#x , but got %#x", testRoot, newState.LatestBlockRootHash32S[0])
+			" Supposed to get %#x , but got %#x", testRoot, newState.LatestBlockRoots()[0])
 	}
 
-	newState.Slot = newState.Slot - 1
+	newState.SetSlot(newState.Slot() - 1)
 
-	newState = ProcessBlockRoots(newState, testRoot)
+	newState, err = ProcessBlockRoots(newState, testRoot)
+	if err != nil {
+		t.Fatalf("Could not process block roots: %v", err)
+	}
 	expectedHashes := make([][]byte, params.BeaconConfig().LatestBlockRootsLength)
 	expectedHashes[0] = testRoot[:]
 	expectedHashes[params.BeaconConfig().LatestBlockRootsLength-1] = testRoot[:]
 
 	expectedRoot := hashutil.MerkleRoot(expectedHashes)
 
-	if !bytes.Equal(newState.BatchedBlockRootHash32S[0], expectedRoot[:]) {
+	if !bytes.Equal(newState.Proto().BatchedBlockRootHash32S[0], expectedRoot[:]) {
 		t.Errorf("saved merkle root is not equal to expected merkle root"+
-			"\n expected %#x but got %#x", expectedRoot, newState.BatchedBlockRootHash32S[0])
+			"\n expected %#x but got %#x", expectedRoot, newState.Proto().BatchedBlockRootHash32S[0])
 	}
 }
 
@@ -105,7 +105,8 @@ func NextEpochCommitteeCount(state *pb.BeaconState) uint64 {
 
 // CrosslinkCommitteesAtSlot returns the list of crosslink committees, it
 // contains the shard associated with the committee and the validator indices
-// in that committee.
+// in that committee. The committees share the cached shuffling of their epoch,
+// so they must not be modified.
 //   def get_crosslink_committees_at_slot(state: BeaconState,
 //                                     slot: SlotNumber,
 //                                     registry_change=False: bool) -> List[Tuple[List[ValidatorIndex], ShardNumber]]:
@@ -249,6 +250,8 @@ func CrosslinkCommitteesAtSlot(
 }
 
 // Shuffling shuffles input validator indices and splits them by slot and shard.
+// Shufflings are cached, so the indices of the returned committees must not be
+// modified. Appending to the returned slices is safe, as it copies them.
 //
 // Spec pseudocode definition:
 //   def get_shuffling(seed: Bytes32,
@@ -283,18 +286,42 @@ func Shuffling(
 	activeCount := uint64(len(activeIndices))
 	committeesPerEpoch := EpochCommitteeCount(activeCount)
 
+	// The shuffling of an epoch is reused until the seed, the active validators or the
+	// config change.
+	key := shufflingKey{
+		seed:             seed,
+		startSlot:        slot,
+		activeCount:      activeCount,
+		committeeCount:   committeesPerEpoch,
+		shuffleAlgorithm: params.BeaconConfig().ShuffleAlgorithm,
+		shuffleRounds:    params.BeaconConfig().ShuffleRoundCount,
+	}
+	if shuffling, ok := committees.get(key, activeIndices); ok {
+		return shuffling, nil
+	}
+
 	// Convert slot to bytes and xor it with seed.
 	slotInBytes := make([]byte, 32)
 	binary.BigEndian.PutUint64(slotInBytes, slot)
 	seed = bytesutil.ToBytes32(bytesutil.Xor(seed[:], slotInBytes))
 
-	shuffledIndices, err := utils.ShuffleIndices(seed, activeIndices)
+	// The Fisher-Yates shuffle shuffles in place, while the cache keeps the active
+	// indices to compare them on lookup.
+	shuffledIndices, err := utils.ShuffleIndices(seed, append([]uint64{}, activeIndices...))
 	if err != nil {
 		return nil, err
 	}
 
 	// Split the shuffled list into epoch_length * committees_per_slot pieces.
-	return utils.SplitIndices(shuffledIndices, committeesPerEpoch), nil
+	// The committees share the backing array of the shuffled indices, so they are
+	// capped to their length for an append by a caller not to overwrite the next one.
+	shuffling := utils.SplitIndices(shuffledIndices, committeesPerEpoch)
+	for i, committee := range shuffling {
+		shuffling[i] = committee[:len(committee):len(committee)]
+	}
+	shuffling = shuffling[:len(shuffling):len(shuffling)]
+	committees.put(key, activeIndices, shuffling)
+	return shuffling, nil
 }
 
 // AttestationParticipants returns the attesting participants indices.
@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// maxCachedShufflings is the number of epoch shufflings kept in the committee cache,
+// which covers the previous, current and both possible next epoch shufflings.
+const maxCachedShufflings = 4
+
+var (
+	committeeCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "committee_cache_hits",
+		Help: "The number of epoch shufflings served from the committee cache",
+	})
+	committeeCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "committee_cache_misses",
+		Help: "The number of epoch shufflings computed because they were not in the committee cache",
+	})
+)
+
+// committees caches the crosslink committees of the epochs recently shuffled.
+var committees = newCommitteeCache(maxCachedShufflings)
+
+// shufflingKey identifies the shuffling of an epoch, along with the active validator
+// indices being shuffled which are compared on lookup instead of being hashed into the
+// key. The shuffle algorithm, its number of rounds and the committee count of the
+// epoch are part of the key, so a shuffling is not reused after the config changes.
+//
+// The root of the validator registry is left out of the key on purpose: only the
+// active indices determine the shuffling, and they are computed on every lookup anyway,
+// while hashing the registry would cost more than the shuffling saved. With 100k
+// validators, comparing the active indices takes a fraction of the time of hashing the
+// registry, see BenchmarkShuffling_100kValidators.
+type shufflingKey struct {
+	seed [32]byte
+	// startSlot is the first slot of the epoch being shuffled.
+	startSlot        uint64
+	activeCount      uint64
+	committeeCount   uint64
+	shuffleAlgorithm string
+	shuffleRounds    uint64
+}
+
+// cachedShuffling is a shuffling of the committee cache, and the active validator
+// indices it was shuffled from.
+type cachedShuffling struct {
+	activeIndices []uint64
+	committees    [][]uint64
+}
+
+// committeeCache is a concurrency safe cache of the shuffled committees of an epoch,
+// evicting the least recently added shuffling once full.
+type committeeCache struct {
+	lock       sync.RWMutex
+	size       int
+	shufflings map[shufflingKey]*cachedShuffling
+	keys       []shufflingKey
+}
+
+func newCommitteeCache(size int) *committeeCache {
+	return &committeeCache{
+		size:       size,
+		shufflings: make(map[shufflingKey]*cachedShuffling, size),
+	}
+}
+
+// get returns the cached committees of the shuffling of the active validator indices,
+// which must not be modified.
+func (c *committeeCache) get(key shufflingKey, activeIndices []uint64) ([][]uint64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	shuffling, ok := c.shufflings[key]
+	if !ok || !equalIndices(shuffling.activeIndices, activeIndices) {
+		committeeCacheMisses.Inc()
+		return nil, false
+	}
+	committeeCacheHits.Inc()
+	return shuffling.committees, true
+}
+
+// put caches the committees shuffled from the active validator indices, which must
+// not be modified afterwards. A cached shuffling of the same key is replaced, as its
+// active validator indices differ.
+func (c *committeeCache) put(key shufflingKey, activeIndices []uint64, committees [][]uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	shuffling := &cachedShuffling{
+		activeIndices: activeIndices,
+		committees:    committees,
+	}
+	if _, ok := c.shufflings[key]; ok {
+		c.shufflings[key] = shuffling
+		return
+	}
+	if len(c.keys) == c.size {
+		delete(c.shufflings, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.shufflings[key] = shuffling
+	c.keys = append(c.keys, key)
+}
+
+func (c *committeeCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.shufflings = make(map[shufflingKey]*cachedShuffling, c.size)
+	c.keys = nil
+}
+
+func equalIndices(a []uint64, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,252 @@
+package helpers
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestCommitteeCache_EvictsOldestShuffling(t *testing.T) {
+	cache := newCommitteeCache(2)
+	keys := []shufflingKey{{startSlot: 1}, {startSlot: 2}, {startSlot: 3}}
+	for i, key := range keys {
+		cache.put(key, nil, [][]uint64{{uint64(i)}})
+	}
+	if _, ok := cache.get(keys[0], nil); ok {
+		t.Error("Expected oldest shuffling to be evicted")
+	}
+	for i, key := range keys[1:] {
+		shuffling, ok := cache.get(key, nil)
+		if !ok {
+			t.Fatalf("Expected shuffling of slot %d to be cached", key.startSlot)
+		}
+		if shuffling[0][0] != uint64(i+1) {
+			t.Errorf("Expected shuffling %d, received %v", i+1, shuffling)
+		}
+	}
+
+	cache.clear()
+	if _, ok := cache.get(keys[2], nil); ok {
+		t.Error("Expected cache to be empty after clearing it")
+	}
+}
+
+func TestShuffling_CachedByRegistry(t *testing.T) {
+	committees.clear()
+	validators := make([]*pb.Validator, params.BeaconConfig().EpochLength*params.BeaconConfig().TargetCommitteeSize)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	seed := [32]byte{'A'}
+
+	shuffling, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	cached, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if &cached[0][0] != &shuffling[0][0] {
+		t.Error("Expected the shuffling to be served from the cache")
+	}
+
+	// Exiting a validator changes the active indices being shuffled.
+	validators[0] = &pb.Validator{ExitEpoch: 0}
+	changed, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if reflect.DeepEqual(changed, shuffling) {
+		t.Error("Expected a new shuffling after the registry changed")
+	}
+	committees.clear()
+	uncached, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if !reflect.DeepEqual(changed, uncached) {
+		t.Error("Expected cached shuffling to equal the computed one")
+	}
+
+	// Swapping which validator is active keeps the number of active validators.
+	validators[0] = &pb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+	validators[1] = &pb.Validator{ExitEpoch: 0}
+	swapped, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if reflect.DeepEqual(swapped, changed) {
+		t.Error("Expected a new shuffling after the active validators changed")
+	}
+}
+
+func TestShuffling_CachedByConfig(t *testing.T) {
+	committees.clear()
+	defer params.OverrideBeaconConfig(params.BeaconConfig())
+	validators := make([]*pb.Validator, params.BeaconConfig().EpochLength*params.BeaconConfig().TargetCommitteeSize)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	seed := [32]byte{'A'}
+
+	shuffling, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	config := *params.BeaconConfig()
+	config.ShuffleAlgorithm = params.SwapOrNotShuffle
+	params.OverrideBeaconConfig(&config)
+	swapOrNot, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if reflect.DeepEqual(swapOrNot, shuffling) {
+		t.Error("Expected a new shuffling after the shuffle algorithm changed")
+	}
+	config.ShuffleRoundCount = 10
+	params.OverrideBeaconConfig(&config)
+	fewerRounds, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if reflect.DeepEqual(fewerRounds, swapOrNot) {
+		t.Error("Expected a new shuffling after the number of shuffle rounds changed")
+	}
+}
+
+func TestShuffling_ConcurrentCallers(t *testing.T) {
+	committees.clear()
+	validators := make([]*pb.Validator, params.BeaconConfig().EpochLength*params.BeaconConfig().TargetCommitteeSize)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	want, err := Shuffling([32]byte{}, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(seed byte) {
+			defer wg.Done()
+			shuffling, err := Shuffling([32]byte{seed % 2}, validators, 0)
+			if err != nil {
+				t.Errorf("Could not shuffle validators: %v", err)
+				return
+			}
+			if seed%2 == 0 && !reflect.DeepEqual(shuffling, want) {
+				t.Error("Expected concurrent callers to receive the same shuffling")
+			}
+		}(byte(i))
+	}
+	wg.Wait()
+}
+
+func TestShuffling_AppendDoesNotModifyCachedCommittees(t *testing.T) {
+	committees.clear()
+	validators := make([]*pb.Validator, params.BeaconConfig().EpochLength*params.BeaconConfig().TargetCommitteeSize)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	seed := [32]byte{'A'}
+
+	shuffling, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	want := make([][]uint64, len(shuffling))
+	for i, committee := range shuffling {
+		want[i] = append([]uint64{}, committee...)
+	}
+	_ = append(shuffling[0], 1<<63)
+	_ = append(shuffling, []uint64{1 << 63})
+
+	cached, err := Shuffling(seed, validators, 0)
+	if err != nil {
+		t.Fatalf("Could not shuffle validators: %v", err)
+	}
+	if !reflect.DeepEqual(cached, want) {
+		t.Error("Expected appending to a returned committee to leave the cached committees unchanged")
+	}
+}
+
+func BenchmarkShuffling_100kValidators(b *testing.B) {
+	validators := make([]*pb.Validator, 100000)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			Pubkey:    make([]byte, 48),
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	seed := [32]byte{'A'}
+
+	b.Run("cache hit", func(b *testing.B) {
+		committees.clear()
+		if _, err := Shuffling(seed, validators, 0); err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := Shuffling(seed, validators, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	// Hashing the registry is what a cache keyed by the registry root would add to
+	// every lookup.
+	b.Run("registry root", func(b *testing.B) {
+		registry := &pb.BeaconState{ValidatorRegistry: validators}
+		for i := 0; i < b.N; i++ {
+			if _, err := hashutil.HashProto(registry); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkCrosslinkCommitteesAtSlot_100kValidators(b *testing.B) {
+	validators := make([]*pb.Validator, 100000)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &pb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state := &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+	}
+	epochLength := params.BeaconConfig().EpochLength
+
+	b.Run("cached", func(b *testing.B) {
+		committees.clear()
+		for i := 0; i < b.N; i++ {
+			slot := params.BeaconConfig().GenesisSlot + uint64(i)%epochLength
+			if _, err := CrosslinkCommitteesAtSlot(state, slot, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			committees.clear()
+			slot := params.BeaconConfig().GenesisSlot + uint64(i)%epochLength
+			if _, err := CrosslinkCommitteesAtSlot(state, slot, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -5,29 +5,39 @@ package randao
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // UpdateRandaoLayers increments the randao layer of the block proposer at the given slot.
-func UpdateRandaoLayers(state *pb.BeaconState, slot uint64) (*pb.BeaconState, error) {
-	vreg := state.ValidatorRegistry
-	proposerIndex, err := v.BeaconProposerIdx(state, slot)
+func UpdateRandaoLayers(state *sharedstate.BeaconState, slot uint64) (*sharedstate.BeaconState, error) {
+	proposerIndex, err := v.BeaconProposerIdx(state.Proto(), slot)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve proposer index %v", err)
 	}
 
-	vreg[proposerIndex].RandaoLayers++
-	state.ValidatorRegistry = vreg
+	proposer, err := state.ValidatorAtIndex(proposerIndex)
+	if err != nil {
+		return nil, err
+	}
+	proposer = proto.Clone(proposer).(*pb.Validator)
+	proposer.RandaoLayers++
+	if err := state.UpdateValidatorAtIndex(proposerIndex, proposer); err != nil {
+		return nil, err
+	}
 	return state, nil
 }
 
 // UpdateRandaoMixes sets the beacon state's latest randao mixes according to the latest
 // beacon slot.
-func UpdateRandaoMixes(state *pb.BeaconState) *pb.BeaconState {
+func UpdateRandaoMixes(state *sharedstate.BeaconState) (*sharedstate.BeaconState, error) {
 	latestMixesLength := params.BeaconConfig().LatestRandaoMixesLength
-	prevMixes := state.LatestRandaoMixesHash32S[(state.Slot-1)%latestMixesLength]
-	state.LatestRandaoMixesHash32S[state.Slot%latestMixesLength] = prevMixes
-	return state
+	prevMixes := state.LatestRandaoMixes()[(state.Slot()-1)%latestMixesLength]
+	if err := state.UpdateRandaoMixAtIndex(state.Slot()%latestMixesLength, prevMixes); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
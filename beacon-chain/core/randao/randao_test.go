@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -14,12 +15,12 @@ func TestUpdateRandaoLayers(t *testing.T) {
 	genesisValidatorRegistry := v.InitialValidatorRegistry()
 	beaconState.ValidatorRegistry = genesisValidatorRegistry
 
-	newState, err := UpdateRandaoLayers(beaconState, 1)
+	newState, err := UpdateRandaoLayers(sharedstate.New(beaconState), 1)
 	if err != nil {
 		t.Fatalf("failed to update randao layers: %v", err)
 	}
 
-	vreg := newState.ValidatorRegistry
+	vreg := newState.ValidatorRegistry()
 
 	// Since slot 1 has proposer index 511
 	if vreg[511].RandaoLayers != 2 {
@@ -38,10 +39,31 @@ func TestUpdateLatestRandaoMixes(t *testing.T) {
 	}
 	beaconState.LatestRandaoMixesHash32S[4%params.BeaconConfig().LatestRandaoMixesLength] = []byte{1, 2, 3}
 	beaconState.LatestRandaoMixesHash32S[5%params.BeaconConfig().LatestRandaoMixesLength] = []byte{4, 5, 6}
-	newState := UpdateRandaoMixes(beaconState)
-	prevSlotMix := newState.LatestRandaoMixesHash32S[4%params.BeaconConfig().LatestRandaoMixesLength]
-	currSlotMix := newState.LatestRandaoMixesHash32S[5%params.BeaconConfig().LatestRandaoMixesLength]
+	newState, err := UpdateRandaoMixes(sharedstate.New(beaconState))
+	if err != nil {
+		t.Fatalf("failed to update randao mixes: %v", err)
+	}
+	prevSlotMix := newState.LatestRandaoMixes()[4%params.BeaconConfig().LatestRandaoMixesLength]
+	currSlotMix := newState.LatestRandaoMixes()[5%params.BeaconConfig().LatestRandaoMixesLength]
 	if !bytes.Equal(currSlotMix, prevSlotMix) {
 		t.Errorf("Latest randao mix not updated, wanted %#x, received %#x", prevSlotMix, currSlotMix)
 	}
 }
+
+func TestUpdateRandaoLayers_DoesNotModifyCopies(t *testing.T) {
+	beaconState := sharedstate.New(&pb.BeaconState{
+		ValidatorRegistry: v.InitialValidatorRegistry(),
+	})
+	stateCopy := beaconState.Copy()
+
+	if _, err := UpdateRandaoLayers(beaconState, 1); err != nil {
+		t.Fatalf("failed to update randao layers: %v", err)
+	}
+
+	if beaconState.ValidatorRegistry()[511].RandaoLayers != 2 {
+		t.Errorf("randao layers not updated %d", beaconState.ValidatorRegistry()[511].RandaoLayers)
+	}
+	if stateCopy.ValidatorRegistry()[511].RandaoLayers != 1 {
+		t.Errorf("randao layers of state copy updated %d", stateCopy.ValidatorRegistry()[511].RandaoLayers)
+	}
+}
@@ -0,0 +1,231 @@
+// Package signatures collects the BLS signatures contained in beacon blocks and
+// verifies them on a pool of workers, ahead of the state transition which can
+// then apply the block's operations without verifying their signatures again.
+package signatures
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Signature domains as defined in the Ethereum Serenity specification.
+const (
+	domainDeposit uint64 = iota
+	domainAttestation
+	domainProposal
+	domainExit
+)
+
+// SignatureSet is a signature over a message, to be verified against the
+// aggregate of the public keys which signed it.
+type SignatureSet struct {
+	// Name describes the signed object in verification errors.
+	Name       string
+	PublicKeys [][]byte
+	Message    []byte
+	Signature  []byte
+	Domain     uint64
+}
+
+// BlockSignatureSets returns the signature sets of a block: the proposer's signature,
+// both proposals of each proposer slashing, both votes of each attester slashing,
+// and the signatures of each attestation and exit. The sets are determined from the
+// state the block is processed on, after its slot has been processed. The randao
+// reveal is a hash onion of the proposer's commitment rather than a signature, and
+// is still verified when the block is processed.
+func BlockSignatureSets(beaconState *pb.BeaconState, block *pb.BeaconBlock) ([]*SignatureSet, error) {
+	if block == nil || block.Body == nil {
+		return nil, errors.New("received nil block or block body")
+	}
+	var sets []*SignatureSet
+
+	proposerSet, err := proposerSignatureSet(beaconState, block)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer signature: %v", err)
+	}
+	sets = append(sets, proposerSet)
+
+	for idx, slashing := range block.Body.ProposerSlashings {
+		proposer, err := validatorPubkey(beaconState, slashing.ProposerIndex)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proposer slashing #%d signatures: %v", idx, err)
+		}
+		proposals := []struct {
+			data      *pb.ProposalSignedData
+			signature []byte
+		}{
+			{slashing.ProposalData_1, slashing.ProposalSignature_1},
+			{slashing.ProposalData_2, slashing.ProposalSignature_2},
+		}
+		for i, proposal := range proposals {
+			set, err := proposalSignatureSet(beaconState, proposer, proposal.data, proposal.signature)
+			if err != nil {
+				return nil, fmt.Errorf("could not get proposer slashing #%d signatures: %v", idx, err)
+			}
+			set.Name = fmt.Sprintf("proposer slashing #%d proposal %d", idx, i+1)
+			sets = append(sets, set)
+		}
+	}
+
+	for idx, slashing := range block.Body.AttesterSlashings {
+		for i, vote := range []*pb.SlashableVote{slashing.SlashableVote_1, slashing.SlashableVote_2} {
+			set, err := attestationSignatureSet(beaconState, vote.ValidatorIndices, vote.Data, vote.AggregateSignature)
+			if err != nil {
+				return nil, fmt.Errorf("could not get attester slashing #%d signatures: %v", idx, err)
+			}
+			set.Name = fmt.Sprintf("attester slashing #%d vote %d", idx, i+1)
+			sets = append(sets, set)
+		}
+	}
+
+	for idx, attestation := range block.Body.Attestations {
+		participants, err := helpers.AttestationParticipants(beaconState, attestation.Data, attestation.AggregationBitfield)
+		if err != nil {
+			return nil, fmt.Errorf("could not get participants of attestation #%d: %v", idx, err)
+		}
+		set, err := attestationSignatureSet(beaconState, participants, attestation.Data, attestation.AggregateSignature)
+		if err != nil {
+			return nil, fmt.Errorf("could not get attestation #%d signature: %v", idx, err)
+		}
+		set.Name = fmt.Sprintf("attestation #%d", idx)
+		sets = append(sets, set)
+	}
+
+	for idx, exit := range block.Body.Exits {
+		pubkey, err := validatorPubkey(beaconState, exit.ValidatorIndex)
+		if err != nil {
+			return nil, fmt.Errorf("could not get exit #%d signature: %v", idx, err)
+		}
+		domain, err := signatureDomain(beaconState, exit.Slot, domainExit)
+		if err != nil {
+			return nil, fmt.Errorf("could not get exit #%d signature: %v", idx, err)
+		}
+		sets = append(sets, &SignatureSet{
+			Name:       fmt.Sprintf("exit #%d", idx),
+			PublicKeys: [][]byte{pubkey},
+			Message:    params.BeaconConfig().ZeroHash[:],
+			Signature:  exit.Signature,
+			Domain:     domain,
+		})
+	}
+	return sets, nil
+}
+
+// BlockProposal returns the proposal the proposer of the block signs, which commits
+// to the root of the block without its signature.
+//
+// Spec definition:
+//   Let block_without_signature_root be the hash_tree_root of block where block.signature is set to EMPTY_SIGNATURE.
+//   Let proposal_root = hash_tree_root(ProposalSignedData(state.slot, BEACON_CHAIN_SHARD_NUMBER, block_without_signature_root)).
+func BlockProposal(block *pb.BeaconBlock) (*pb.ProposalSignedData, error) {
+	unsigned := *block
+	unsigned.Signature = nil
+	blockRoot, err := hashutil.HashBeaconBlock(&unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	return &pb.ProposalSignedData{
+		Slot:            block.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	}, nil
+}
+
+func proposerSignatureSet(beaconState *pb.BeaconState, block *pb.BeaconBlock) (*SignatureSet, error) {
+	proposerIndex, err := v.BeaconProposerIdx(beaconState, block.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer index: %v", err)
+	}
+	proposer, err := validatorPubkey(beaconState, proposerIndex)
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := BlockProposal(block)
+	if err != nil {
+		return nil, err
+	}
+	set, err := proposalSignatureSet(beaconState, proposer, proposal, bytes.Join(block.Signature, nil))
+	if err != nil {
+		return nil, err
+	}
+	set.Name = "block proposal"
+	return set, nil
+}
+
+func proposalSignatureSet(
+	beaconState *pb.BeaconState,
+	proposer []byte,
+	proposal *pb.ProposalSignedData,
+	signature []byte,
+) (*SignatureSet, error) {
+	message, err := hashutil.HashProto(proposal)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash proposal: %v", err)
+	}
+	domain, err := signatureDomain(beaconState, proposal.Slot, domainProposal)
+	if err != nil {
+		return nil, err
+	}
+	return &SignatureSet{
+		PublicKeys: [][]byte{proposer},
+		Message:    message[:],
+		Signature:  signature,
+		Domain:     domain,
+	}, nil
+}
+
+func attestationSignatureSet(
+	beaconState *pb.BeaconState,
+	indices []uint64,
+	data *pb.AttestationData,
+	signature []byte,
+) (*SignatureSet, error) {
+	pubkeys := make([][]byte, len(indices))
+	for i, index := range indices {
+		pubkey, err := validatorPubkey(beaconState, index)
+		if err != nil {
+			return nil, err
+		}
+		pubkeys[i] = pubkey
+	}
+	// Custody bits are not used until phase 1, so every attester signs the data with a zero custody bit.
+	message, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	domain, err := signatureDomain(beaconState, data.Slot, domainAttestation)
+	if err != nil {
+		return nil, err
+	}
+	return &SignatureSet{
+		PublicKeys: pubkeys,
+		Message:    message[:],
+		Signature:  signature,
+		Domain:     domain,
+	}, nil
+}
+
+func signatureDomain(beaconState *pb.BeaconState, slot uint64, domainType uint64) (uint64, error) {
+	if beaconState.Fork == nil {
+		return 0, errors.New("state has no fork to determine the signature domain")
+	}
+	return helpers.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(slot), domainType), nil
+}
+
+func validatorPubkey(beaconState *pb.BeaconState, index uint64) ([]byte, error) {
+	if index >= uint64(len(beaconState.ValidatorRegistry)) {
+		return nil, fmt.Errorf(
+			"validator index %d exceeds validator registry length %d",
+			index,
+			len(beaconState.ValidatorRegistry),
+		)
+	}
+	return beaconState.ValidatorRegistry[index].Pubkey, nil
+}
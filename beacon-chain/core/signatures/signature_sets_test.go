@@ -0,0 +1,162 @@
+package signatures
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func setupSignatureState() *pb.BeaconState {
+	return &pb.BeaconState{
+		Slot:                     64,
+		ValidatorRegistry:        validators.InitialValidatorRegistry(),
+		LatestRandaoMixesHash32S: make([][]byte, params.BeaconConfig().LatestRandaoMixesLength),
+		Fork: &pb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+	}
+}
+
+func TestBlockSignatureSets_CollectsEverySignature(t *testing.T) {
+	beaconState := setupSignatureState()
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, 60, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := &pb.Attestation{
+		Data: &pb.AttestationData{
+			Slot:  60,
+			Shard: committees[0].Shard,
+		},
+		AggregationBitfield: make([]byte, (len(committees[0].Committee)+7)/8),
+		AggregateSignature:  []byte("attestation"),
+	}
+	attestation.AggregationBitfield[0] = 0xff
+	vote := &pb.SlashableVote{
+		Data:               &pb.AttestationData{Slot: 5},
+		ValidatorIndices:   []uint64{1, 2},
+		AggregateSignature: []byte("vote"),
+	}
+	block := &pb.BeaconBlock{
+		Slot:      64,
+		Signature: [][]byte{[]byte("proposal")},
+		Body: &pb.BeaconBlockBody{
+			ProposerSlashings: []*pb.ProposerSlashing{{
+				ProposerIndex:       3,
+				ProposalData_1:      &pb.ProposalSignedData{Slot: 1},
+				ProposalSignature_1: []byte("proposal 1"),
+				ProposalData_2:      &pb.ProposalSignedData{Slot: 1, BlockRootHash32: []byte{1}},
+				ProposalSignature_2: []byte("proposal 2"),
+			}},
+			AttesterSlashings: []*pb.AttesterSlashing{{
+				SlashableVote_1: vote,
+				SlashableVote_2: vote,
+			}},
+			Attestations: []*pb.Attestation{attestation},
+			Exits: []*pb.Exit{{
+				ValidatorIndex: 4,
+				Signature:      []byte("exit"),
+			}},
+		},
+	}
+
+	sets, err := BlockSignatureSets(beaconState, block)
+	if err != nil {
+		t.Fatalf("Could not get block signature sets: %v", err)
+	}
+	var names []string
+	for _, set := range sets {
+		names = append(names, set.Name)
+	}
+	wantNames := []string{
+		"block proposal",
+		"proposer slashing #0 proposal 1",
+		"proposer slashing #0 proposal 2",
+		"attester slashing #0 vote 1",
+		"attester slashing #0 vote 2",
+		"attestation #0",
+		"exit #0",
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("Wanted signature sets %v, received %v", wantNames, names)
+	}
+
+	proposerIndex, err := validators.BeaconProposerIdx(beaconState, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := beaconState.ValidatorRegistry
+	if !bytes.Equal(sets[0].PublicKeys[0], registry[proposerIndex].Pubkey) {
+		t.Errorf("Wanted proposer public key %#x, received %#x", registry[proposerIndex].Pubkey, sets[0].PublicKeys[0])
+	}
+	if !bytes.Equal(sets[0].Signature, []byte("proposal")) {
+		t.Errorf("Wanted proposal signature, received %q", sets[0].Signature)
+	}
+	if !bytes.Equal(sets[2].PublicKeys[0], registry[3].Pubkey) || !bytes.Equal(sets[2].Signature, []byte("proposal 2")) {
+		t.Errorf("Unexpected proposer slashing signature set %v", sets[2])
+	}
+	if len(sets[3].PublicKeys) != 2 || !bytes.Equal(sets[3].PublicKeys[1], registry[2].Pubkey) {
+		t.Errorf("Unexpected attester slashing public keys %#x", sets[3].PublicKeys)
+	}
+	participants, err := helpers.AttestationParticipants(beaconState, attestation.Data, attestation.AggregationBitfield)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets[5].PublicKeys) != len(participants) {
+		t.Errorf("Wanted %d attestation public keys, received %d", len(participants), len(sets[5].PublicKeys))
+	}
+	if !bytes.Equal(sets[6].PublicKeys[0], registry[4].Pubkey) || !bytes.Equal(sets[6].Signature, []byte("exit")) {
+		t.Errorf("Unexpected exit signature set %v", sets[6])
+	}
+	if sets[0].Domain == sets[5].Domain || sets[5].Domain == sets[6].Domain {
+		t.Errorf("Expected proposals, attestations and exits to use different domains")
+	}
+}
+
+func TestBlockSignatureSets_ValidatorIndexOutOfRange(t *testing.T) {
+	beaconState := setupSignatureState()
+	block := &pb.BeaconBlock{
+		Slot: 64,
+		Body: &pb.BeaconBlockBody{
+			Exits: []*pb.Exit{{ValidatorIndex: uint64(len(beaconState.ValidatorRegistry))}},
+		},
+	}
+	want := "could not get exit #0 signature"
+	if _, err := BlockSignatureSets(beaconState, block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestBlockSignatureSets_NilBlockBody(t *testing.T) {
+	if _, err := BlockSignatureSets(setupSignatureState(), &pb.BeaconBlock{}); err == nil {
+		t.Error("Expected error for block without body")
+	}
+}
+
+func TestBlockSignatureSets_ProposalExcludesBlockSignature(t *testing.T) {
+	beaconState := setupSignatureState()
+	unsigned := &pb.BeaconBlock{Slot: 64, Body: &pb.BeaconBlockBody{}}
+	signed := &pb.BeaconBlock{Slot: 64, Signature: [][]byte{[]byte("signature")}, Body: &pb.BeaconBlockBody{}}
+
+	unsignedSets, err := BlockSignatureSets(beaconState, unsigned)
+	if err != nil {
+		t.Fatalf("Could not get block signature sets: %v", err)
+	}
+	signedSets, err := BlockSignatureSets(beaconState, signed)
+	if err != nil {
+		t.Fatalf("Could not get block signature sets: %v", err)
+	}
+	if !bytes.Equal(unsignedSets[0].Message, signedSets[0].Message) {
+		t.Error("Expected the signed proposal to commit to the block without its signature")
+	}
+	if len(signed.Signature) != 1 {
+		t.Errorf("Expected the block signature to be left unchanged, received %v", signed.Signature)
+	}
+}
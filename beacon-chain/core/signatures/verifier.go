@@ -0,0 +1,112 @@
+package signatures
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+var defaultVerifier = NewVerifier(0)
+
+// Verifier verifies signature sets in parallel on a pool of workers.
+type Verifier struct {
+	workers int
+}
+
+// NewVerifier creates a verifier running the given number of workers. A
+// non-positive number of workers uses one worker per CPU.
+func NewVerifier(workers int) *Verifier {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &Verifier{
+		workers: workers,
+	}
+}
+
+// Verify checks every signature set and returns an error describing the first
+// invalid set found, after which the remaining sets are skipped.
+func (v *Verifier) Verify(sets []*SignatureSet) error {
+	workers := v.workers
+	if len(sets) < workers {
+		workers = len(sets)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	failed := make(chan struct{})
+	pending := make(chan *SignatureSet)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for set := range pending {
+				if err := verifySignatureSet(set); err != nil {
+					once.Do(func() {
+						firstErr = err
+						close(failed)
+					})
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, set := range sets {
+		select {
+		case pending <- set:
+		case <-failed:
+			break feed
+		}
+	}
+	close(pending)
+	wg.Wait()
+	return firstErr
+}
+
+// VerifyBlock collects the signature sets of a block from the state it is processed
+// on and verifies them with the verifier.
+func (v *Verifier) VerifyBlock(beaconState *pb.BeaconState, block *pb.BeaconBlock) error {
+	sets, err := BlockSignatureSets(beaconState, block)
+	if err != nil {
+		return err
+	}
+	return v.Verify(sets)
+}
+
+// VerifyBlock verifies the signatures of a block with a verifier running one
+// worker per CPU.
+func VerifyBlock(beaconState *pb.BeaconState, block *pb.BeaconBlock) error {
+	return defaultVerifier.VerifyBlock(beaconState, block)
+}
+
+// verifySignatureSet is replaced in tests, as the BLS library does not reject signatures yet.
+var verifySignatureSet = verifyAggregateSignature
+
+// verifyAggregateSig is replaced in tests for the same reason.
+var verifyAggregateSig = bls.VerifyAggregateSig
+
+func verifyAggregateSignature(set *SignatureSet) error {
+	pubkeys := make([]*bls.PublicKey, len(set.PublicKeys))
+	for i, key := range set.PublicKeys {
+		pubkeys[i] = &bls.PublicKey{}
+		pubkeys[i].UnBufferPublicKey(key)
+	}
+	signature := &bls.Signature{}
+	signature.UnBufferSignature(set.Signature)
+	valid, err := verifyAggregateSig(pubkeys, set.Message, signature, set.Domain)
+	if err != nil {
+		return fmt.Errorf("could not verify signature of %s: %v", set.Name, err)
+	}
+	if !valid {
+		return fmt.Errorf("invalid signature of %s", set.Name)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package signatures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func testSignatureSets(n int) []*SignatureSet {
+	sets := make([]*SignatureSet, n)
+	for i := range sets {
+		sets[i] = &SignatureSet{Name: fmt.Sprintf("set %d", i)}
+	}
+	return sets
+}
+
+func TestVerify_VerifiesEverySet(t *testing.T) {
+	var verified int32
+	verifySignatureSet = func(set *SignatureSet) error {
+		atomic.AddInt32(&verified, 1)
+		return nil
+	}
+	defer func() { verifySignatureSet = verifyAggregateSignature }()
+
+	if err := NewVerifier(4).Verify(testSignatureSets(100)); err != nil {
+		t.Fatalf("Could not verify signature sets: %v", err)
+	}
+	if verified != 100 {
+		t.Errorf("Wanted 100 verified signature sets, received %d", verified)
+	}
+	if err := NewVerifier(4).Verify(nil); err != nil {
+		t.Errorf("Could not verify empty signature sets: %v", err)
+	}
+}
+
+func TestVerify_ReportsInvalidSet(t *testing.T) {
+	var verified int32
+	verifySignatureSet = func(set *SignatureSet) error {
+		atomic.AddInt32(&verified, 1)
+		if set.Name == "set 0" {
+			return errors.New("invalid signature of set 0")
+		}
+		return nil
+	}
+	defer func() { verifySignatureSet = verifyAggregateSignature }()
+
+	err := NewVerifier(1).Verify(testSignatureSets(100))
+	if err == nil || !strings.Contains(err.Error(), "set 0") {
+		t.Fatalf("Expected invalid signature of set 0, received %v", err)
+	}
+	if verified == 100 {
+		t.Error("Expected verification to stop after an invalid signature")
+	}
+}
+
+func TestVerifyBlock_PassesWithValidSignatures(t *testing.T) {
+	block := &pb.BeaconBlock{
+		Slot: 64,
+		Body: &pb.BeaconBlockBody{},
+	}
+	if err := VerifyBlock(setupSignatureState(), block); err != nil {
+		t.Errorf("Could not verify block signatures: %v", err)
+	}
+}
+
+func TestVerifyAggregateSignature_RejectsOtherDomain(t *testing.T) {
+	beaconState := setupSignatureState()
+	block := &pb.BeaconBlock{
+		Slot:      64,
+		Signature: [][]byte{[]byte("signature")},
+		Body:      &pb.BeaconBlockBody{},
+	}
+	sets, err := BlockSignatureSets(beaconState, block)
+	if err != nil {
+		t.Fatalf("Could not get block signature sets: %v", err)
+	}
+	proposerSet := sets[0]
+	// The BLS library does not verify signatures yet, so the signature is one which
+	// only verifies in the domain of block proposals it was made in.
+	signedDomain := helpers.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(block.Slot), domainProposal)
+	verifyAggregateSig = func(pubkeys []*bls.PublicKey, msg []byte, sig *bls.Signature, domain uint64) (bool, error) {
+		return domain == signedDomain, nil
+	}
+	defer func() { verifyAggregateSig = bls.VerifyAggregateSig }()
+
+	if err := verifyAggregateSignature(proposerSet); err != nil {
+		t.Errorf("Could not verify proposer signature in its domain: %v", err)
+	}
+	proposerSet.Domain = helpers.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(block.Slot), domainAttestation)
+	if err := verifyAggregateSignature(proposerSet); err == nil || !strings.Contains(err.Error(), "invalid signature") {
+		t.Errorf("Expected proposer signature to be invalid in the attestation domain, received %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// rewardReporter records the balance changes of each validator caused by the
+// rewards and penalties applied during epoch processing. A nil reporter records
+// nothing, so epoch processing only pays for the report when asked for one.
+type rewardReporter struct {
+	deltas   []*pb.ValidatorBalanceDeltas
+	balances []uint64
+}
+
+func newRewardReporter(state *pb.BeaconState) *rewardReporter {
+	deltas := make([]*pb.ValidatorBalanceDeltas, len(state.ValidatorBalances))
+	for i := range deltas {
+		deltas[i] = &pb.ValidatorBalanceDeltas{ValidatorIndex: uint64(i)}
+	}
+	return &rewardReporter{
+		deltas:   deltas,
+		balances: make([]uint64, len(state.ValidatorBalances)),
+	}
+}
+
+// snapshot saves the balances of the validators before a reward or penalty is applied.
+func (r *rewardReporter) snapshot(state *pb.BeaconState) {
+	if r == nil {
+		return
+	}
+	copy(r.balances, state.ValidatorBalances)
+}
+
+// record adds the balance changes since the last snapshot to the delta of each
+// validator returned by field.
+func (r *rewardReporter) record(state *pb.BeaconState, field func(*pb.ValidatorBalanceDeltas) *int64) {
+	if r == nil {
+		return
+	}
+	for i, balance := range state.ValidatorBalances {
+		if i >= len(r.deltas) {
+			break
+		}
+		if balance != r.balances[i] {
+			*field(r.deltas[i]) += int64(balance) - int64(r.balances[i])
+		}
+	}
+}
+
+// report returns the deltas of the validators whose balance changed during the epoch.
+func (r *rewardReporter) report(epoch uint64) *pb.EpochRewardReport {
+	report := &pb.EpochRewardReport{Epoch: epoch}
+	for _, delta := range r.deltas {
+		if delta.Source != 0 || delta.Target != 0 || delta.Head != 0 ||
+			delta.InclusionDistance != 0 || delta.AttestationInclusion != 0 ||
+			delta.Inactivity != 0 || delta.Crosslink != 0 || delta.Penalties != 0 {
+			report.ValidatorDeltas = append(report.ValidatorDeltas, delta)
+		}
+	}
+	return report
+}
+
+func sourceDelta(d *pb.ValidatorBalanceDeltas) *int64               { return &d.Source }
+func targetDelta(d *pb.ValidatorBalanceDeltas) *int64               { return &d.Target }
+func headDelta(d *pb.ValidatorBalanceDeltas) *int64                 { return &d.Head }
+func inclusionDistanceDelta(d *pb.ValidatorBalanceDeltas) *int64    { return &d.InclusionDistance }
+func attestationInclusionDelta(d *pb.ValidatorBalanceDeltas) *int64 { return &d.AttestationInclusion }
+func inactivityDelta(d *pb.ValidatorBalanceDeltas) *int64           { return &d.Inactivity }
+func crosslinkDelta(d *pb.ValidatorBalanceDeltas) *int64            { return &d.Crosslink }
+func penaltiesDelta(d *pb.ValidatorBalanceDeltas) *int64            { return &d.Penalties }
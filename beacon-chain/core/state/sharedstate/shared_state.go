@@ -0,0 +1,282 @@
+// Package sharedstate defines a copy-on-write wrapper around the beacon state
+// protobuf which shares its largest lists between copies of the state, so that
+// forking a state per slot does not clone the full validator registry.
+package sharedstate
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// sharedField identifies one of the lists shared between copies of a state.
+type sharedField int
+
+const (
+	validatorRegistry sharedField = iota
+	validatorBalances
+	latestRandaoMixes
+	latestBlockRoots
+	numSharedFields
+)
+
+// reference counts the states sharing the same backing list.
+type reference struct {
+	lock  sync.Mutex
+	count uint
+}
+
+func (r *reference) add() {
+	r.lock.Lock()
+	r.count++
+	r.lock.Unlock()
+}
+
+func (r *reference) release() {
+	r.lock.Lock()
+	if r.count > 0 {
+		r.count--
+	}
+	r.lock.Unlock()
+}
+
+func (r *reference) shared() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.count > 1
+}
+
+// BeaconState wraps a beacon state protobuf, sharing its validator registry,
+// balances, randao mixes and block roots with the copies made through Copy.
+// A shared list is only copied when one of the states writes to it, and the
+// validator records are copied along with the registry, so copies remain cheap
+// for as long as they are only read. A copy holds its lists until it is
+// released. A single BeaconState is not safe for concurrent use, while separate
+// copies of it can be used concurrently.
+type BeaconState struct {
+	state *pb.BeaconState
+	refs  [numSharedFields]*reference
+}
+
+// New wraps the given state, which must not be used by the caller afterwards.
+func New(state *pb.BeaconState) *BeaconState {
+	s := &BeaconState{state: state}
+	for i := range s.refs {
+		s.refs[i] = &reference{count: 1}
+	}
+	return s
+}
+
+// Copy returns a copy of the state sharing its large lists with the original. The
+// copy must be released once it is no longer used, or the other copies keep copying
+// the lists on their next write.
+func (s *BeaconState) Copy() *BeaconState {
+	shallow := *s.state
+	shallow.ValidatorRegistry = nil
+	shallow.ValidatorBalances = nil
+	shallow.LatestRandaoMixesHash32S = nil
+	shallow.LatestBlockRootHash32S = nil
+	cloned := proto.Clone(&shallow).(*pb.BeaconState)
+	cloned.ValidatorRegistry = s.state.ValidatorRegistry
+	cloned.ValidatorBalances = s.state.ValidatorBalances
+	cloned.LatestRandaoMixesHash32S = s.state.LatestRandaoMixesHash32S
+	cloned.LatestBlockRootHash32S = s.state.LatestBlockRootHash32S
+
+	dst := &BeaconState{state: cloned, refs: s.refs}
+	for _, ref := range dst.refs {
+		ref.add()
+	}
+	return dst
+}
+
+// Release drops the references of the state to the lists it shares with its copies,
+// so that the remaining copies write to them in place. The state must not be used
+// afterwards.
+func (s *BeaconState) Release() {
+	for i, ref := range s.refs {
+		if ref != nil {
+			ref.release()
+			s.refs[i] = nil
+		}
+	}
+	s.state = nil
+}
+
+// Proto returns the underlying protobuf of the state, which shares its lists with
+// the copies of the state and must therefore only be read. Use MutableProto for
+// functions modifying the state in place and CloneProto for a detached copy.
+func (s *BeaconState) Proto() *pb.BeaconState {
+	return s.state
+}
+
+// MutableProto returns the underlying protobuf of the state after copying the
+// lists and validator records it shares with other copies of the state, so that
+// it can be modified in place by functions operating on the protobuf.
+func (s *BeaconState) MutableProto() *pb.BeaconState {
+	s.unshareValidators()
+	s.unshareBalances()
+	s.unshareRandaoMixes()
+	s.unshareBlockRoots()
+	return s.state
+}
+
+// CloneProto returns a deep copy of the state as a protobuf, independent from
+// the state and its copies.
+func (s *BeaconState) CloneProto() *pb.BeaconState {
+	return proto.Clone(s.state).(*pb.BeaconState)
+}
+
+// Marshal serializes the state to its protobuf encoding.
+func (s *BeaconState) Marshal() ([]byte, error) {
+	return proto.Marshal(s.state)
+}
+
+// Slot returns the slot of the state.
+func (s *BeaconState) Slot() uint64 {
+	return s.state.Slot
+}
+
+// SetSlot sets the slot of the state.
+func (s *BeaconState) SetSlot(slot uint64) {
+	s.state.Slot = slot
+}
+
+// AppendBatchedBlockRoot appends a merkle root of the latest block roots to the state.
+func (s *BeaconState) AppendBatchedBlockRoot(root []byte) {
+	s.state.BatchedBlockRootHash32S = append(s.state.BatchedBlockRootHash32S, root)
+}
+
+// NumValidators returns the size of the validator registry.
+func (s *BeaconState) NumValidators() int {
+	return len(s.state.ValidatorRegistry)
+}
+
+// ValidatorRegistry returns the validator registry, which must only be read.
+func (s *BeaconState) ValidatorRegistry() []*pb.Validator {
+	return s.state.ValidatorRegistry
+}
+
+// ValidatorAtIndex returns the validator record at the given index, which must
+// only be read as it may be shared with other copies of the state.
+func (s *BeaconState) ValidatorAtIndex(idx uint64) (*pb.Validator, error) {
+	if idx >= uint64(len(s.state.ValidatorRegistry)) {
+		return nil, fmt.Errorf("validator index %d out of range, registry has %d validators", idx, len(s.state.ValidatorRegistry))
+	}
+	return s.state.ValidatorRegistry[idx], nil
+}
+
+// UpdateValidatorAtIndex replaces the validator record at the given index.
+func (s *BeaconState) UpdateValidatorAtIndex(idx uint64, validator *pb.Validator) error {
+	if idx >= uint64(len(s.state.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d out of range, registry has %d validators", idx, len(s.state.ValidatorRegistry))
+	}
+	s.unshareValidators()
+	s.state.ValidatorRegistry[idx] = validator
+	return nil
+}
+
+// ValidatorBalances returns the validator balances, which must only be read.
+func (s *BeaconState) ValidatorBalances() []uint64 {
+	return s.state.ValidatorBalances
+}
+
+// BalanceAtIndex returns the balance of the validator at the given index.
+func (s *BeaconState) BalanceAtIndex(idx uint64) (uint64, error) {
+	if idx >= uint64(len(s.state.ValidatorBalances)) {
+		return 0, fmt.Errorf("validator index %d out of range, state has %d balances", idx, len(s.state.ValidatorBalances))
+	}
+	return s.state.ValidatorBalances[idx], nil
+}
+
+// UpdateBalanceAtIndex sets the balance of the validator at the given index.
+func (s *BeaconState) UpdateBalanceAtIndex(idx uint64, balance uint64) error {
+	if idx >= uint64(len(s.state.ValidatorBalances)) {
+		return fmt.Errorf("validator index %d out of range, state has %d balances", idx, len(s.state.ValidatorBalances))
+	}
+	s.unshareBalances()
+	s.state.ValidatorBalances[idx] = balance
+	return nil
+}
+
+// LatestRandaoMixes returns the latest randao mixes, which must only be read.
+func (s *BeaconState) LatestRandaoMixes() [][]byte {
+	return s.state.LatestRandaoMixesHash32S
+}
+
+// UpdateRandaoMixAtIndex sets the randao mix at the given index.
+func (s *BeaconState) UpdateRandaoMixAtIndex(idx uint64, mix []byte) error {
+	if idx >= uint64(len(s.state.LatestRandaoMixesHash32S)) {
+		return fmt.Errorf("randao mix index %d out of range, state has %d mixes", idx, len(s.state.LatestRandaoMixesHash32S))
+	}
+	s.unshareRandaoMixes()
+	s.state.LatestRandaoMixesHash32S[idx] = mix
+	return nil
+}
+
+// LatestBlockRoots returns the latest block roots, which must only be read.
+func (s *BeaconState) LatestBlockRoots() [][]byte {
+	return s.state.LatestBlockRootHash32S
+}
+
+// UpdateBlockRootAtIndex sets the block root at the given index.
+func (s *BeaconState) UpdateBlockRootAtIndex(idx uint64, root []byte) error {
+	if idx >= uint64(len(s.state.LatestBlockRootHash32S)) {
+		return fmt.Errorf("block root index %d out of range, state has %d roots", idx, len(s.state.LatestBlockRootHash32S))
+	}
+	s.unshareBlockRoots()
+	s.state.LatestBlockRootHash32S[idx] = root
+	return nil
+}
+
+// unshareValidators copies the validator records along with the registry, as the
+// records of a registry no longer shared can be modified in place through MutableProto.
+func (s *BeaconState) unshareValidators() {
+	if s.refs[validatorRegistry].shared() {
+		validators := make([]*pb.Validator, len(s.state.ValidatorRegistry))
+		for i, validator := range s.state.ValidatorRegistry {
+			validators[i] = proto.Clone(validator).(*pb.Validator)
+		}
+		s.state.ValidatorRegistry = validators
+		s.detach(validatorRegistry)
+	}
+}
+
+func (s *BeaconState) unshareBalances() {
+	if s.refs[validatorBalances].shared() {
+		balances := make([]uint64, len(s.state.ValidatorBalances))
+		copy(balances, s.state.ValidatorBalances)
+		s.state.ValidatorBalances = balances
+		s.detach(validatorBalances)
+	}
+}
+
+func (s *BeaconState) unshareRandaoMixes() {
+	if s.refs[latestRandaoMixes].shared() {
+		s.state.LatestRandaoMixesHash32S = copyRoots(s.state.LatestRandaoMixesHash32S)
+		s.detach(latestRandaoMixes)
+	}
+}
+
+func (s *BeaconState) unshareBlockRoots() {
+	if s.refs[latestBlockRoots].shared() {
+		s.state.LatestBlockRootHash32S = copyRoots(s.state.LatestBlockRootHash32S)
+		s.detach(latestBlockRoots)
+	}
+}
+
+// detach releases the state's reference to a shared list after the state
+// replaced it with its own copy.
+func (s *BeaconState) detach(field sharedField) {
+	s.refs[field].release()
+	s.refs[field] = &reference{count: 1}
+}
+
+// copyRoots copies the list of roots. The roots themselves are only ever
+// replaced and never written to, so they can stay shared.
+func copyRoots(roots [][]byte) [][]byte {
+	copied := make([][]byte, len(roots))
+	copy(copied, roots)
+	return copied
+}
@@ -0,0 +1,207 @@
+package sharedstate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func testState(numValidators int) *pb.BeaconState {
+	validators := make([]*pb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	for i := range validators {
+		validators[i] = &pb.Validator{Pubkey: []byte{byte(i)}, RandaoLayers: 1}
+		balances[i] = 32
+	}
+	return &pb.BeaconState{
+		Slot:                     10,
+		ValidatorRegistry:        validators,
+		ValidatorBalances:        balances,
+		LatestRandaoMixesHash32S: [][]byte{{1}, {2}},
+		LatestBlockRootHash32S:   [][]byte{{3}, {4}},
+		BatchedBlockRootHash32S:  [][]byte{{5}},
+	}
+}
+
+func TestCopy_SharesListsUntilWritten(t *testing.T) {
+	original := New(testState(4))
+	stateCopy := original.Copy()
+
+	if &original.ValidatorRegistry()[0] != &stateCopy.ValidatorRegistry()[0] {
+		t.Error("Expected copy to share the validator registry")
+	}
+	if &original.ValidatorBalances()[0] != &stateCopy.ValidatorBalances()[0] {
+		t.Error("Expected copy to share the validator balances")
+	}
+
+	if err := stateCopy.UpdateBalanceAtIndex(1, 31); err != nil {
+		t.Fatal(err)
+	}
+	if &original.ValidatorBalances()[0] == &stateCopy.ValidatorBalances()[0] {
+		t.Error("Expected copy to stop sharing the balances once written")
+	}
+	if &original.ValidatorRegistry()[0] != &stateCopy.ValidatorRegistry()[0] {
+		t.Error("Expected copy to keep sharing the unmodified validator registry")
+	}
+	if balance, _ := original.BalanceAtIndex(1); balance != 32 {
+		t.Errorf("Wanted original balance 32, received %d", balance)
+	}
+	if balance, _ := stateCopy.BalanceAtIndex(1); balance != 31 {
+		t.Errorf("Wanted copied balance 31, received %d", balance)
+	}
+}
+
+func TestCopy_WritesDoNotAffectOtherCopies(t *testing.T) {
+	original := New(testState(4))
+	stateCopy := original.Copy()
+
+	stateCopy.SetSlot(11)
+	stateCopy.AppendBatchedBlockRoot([]byte{6})
+	if err := stateCopy.UpdateValidatorAtIndex(2, &pb.Validator{RandaoLayers: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stateCopy.UpdateRandaoMixAtIndex(0, []byte{7}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stateCopy.UpdateBlockRootAtIndex(1, []byte{8}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !proto.Equal(original.Proto(), testState(4)) {
+		t.Errorf("Expected original state to be unmodified, received %v", original.Proto())
+	}
+	if stateCopy.Slot() != 11 || len(stateCopy.Proto().BatchedBlockRootHash32S) != 2 {
+		t.Errorf("Copy not updated, received %v", stateCopy.Proto())
+	}
+	if validator, _ := stateCopy.ValidatorAtIndex(2); validator.RandaoLayers != 2 {
+		t.Errorf("Wanted randao layers 2, received %d", validator.RandaoLayers)
+	}
+	if !bytes.Equal(stateCopy.LatestRandaoMixes()[0], []byte{7}) {
+		t.Errorf("Wanted randao mix %#x, received %#x", []byte{7}, stateCopy.LatestRandaoMixes()[0])
+	}
+	if !bytes.Equal(stateCopy.LatestBlockRoots()[1], []byte{8}) {
+		t.Errorf("Wanted block root %#x, received %#x", []byte{8}, stateCopy.LatestBlockRoots()[1])
+	}
+}
+
+func TestUpdate_WritesInPlaceWhenNotShared(t *testing.T) {
+	beaconState := testState(4)
+	s := New(beaconState)
+
+	if err := s.UpdateBalanceAtIndex(0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.ValidatorBalances[0] != 1 {
+		t.Errorf("Expected balance to be written to the wrapped state, received %d", beaconState.ValidatorBalances[0])
+	}
+}
+
+func TestUpdate_IndexOutOfRange(t *testing.T) {
+	s := New(testState(4))
+
+	if err := s.UpdateValidatorAtIndex(4, &pb.Validator{}); err == nil {
+		t.Error("Expected error updating validator out of range")
+	}
+	if err := s.UpdateBalanceAtIndex(4, 0); err == nil {
+		t.Error("Expected error updating balance out of range")
+	}
+	if err := s.UpdateRandaoMixAtIndex(2, nil); err == nil {
+		t.Error("Expected error updating randao mix out of range")
+	}
+	if err := s.UpdateBlockRootAtIndex(2, nil); err == nil {
+		t.Error("Expected error updating block root out of range")
+	}
+	if _, err := s.ValidatorAtIndex(4); err == nil {
+		t.Error("Expected error reading validator out of range")
+	}
+}
+
+func TestMutableProto_CopiesSharedValidators(t *testing.T) {
+	original := New(testState(4))
+	stateCopy := original.Copy()
+
+	mutable := stateCopy.MutableProto()
+	mutable.ValidatorRegistry[0].RandaoLayers = 5
+	mutable.ValidatorBalances[0] = 0
+
+	if !proto.Equal(original.Proto(), testState(4)) {
+		t.Errorf("Expected original state to be unmodified, received %v", original.Proto())
+	}
+}
+
+func TestMutableProto_AfterUpdateDoesNotModifyCopies(t *testing.T) {
+	original := New(testState(4))
+	stateCopy := original.Copy()
+
+	if err := stateCopy.UpdateValidatorAtIndex(0, &pb.Validator{RandaoLayers: 2}); err != nil {
+		t.Fatal(err)
+	}
+	// The registry is no longer shared, but the records it was copied with must not
+	// be modified in place either.
+	stateCopy.MutableProto().ValidatorRegistry[1].RandaoLayers = 5
+
+	if !proto.Equal(original.Proto(), testState(4)) {
+		t.Errorf("Expected original state to be unmodified, received %v", original.Proto())
+	}
+}
+
+func TestRelease_WritesInPlaceOnceCopiesReleased(t *testing.T) {
+	beaconState := testState(4)
+	original := New(beaconState)
+	stateCopy := original.Copy()
+	stateCopy.Release()
+
+	if err := original.UpdateBalanceAtIndex(0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := original.UpdateValidatorAtIndex(0, &pb.Validator{RandaoLayers: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.ValidatorBalances[0] != 1 || beaconState.ValidatorRegistry[0].RandaoLayers != 2 {
+		t.Errorf("Expected writes to the wrapped state once its copy is released, received %v", beaconState)
+	}
+}
+
+func TestCloneProto_IsIndependent(t *testing.T) {
+	s := New(testState(4))
+
+	cloned := s.CloneProto()
+	cloned.ValidatorRegistry[0].RandaoLayers = 5
+
+	if validator, _ := s.ValidatorAtIndex(0); validator.RandaoLayers != 1 {
+		t.Errorf("Expected state to be unmodified, received randao layers %d", validator.RandaoLayers)
+	}
+
+	enc, err := s.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &pb.BeaconState{}
+	if err := proto.Unmarshal(enc, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, testState(4)) {
+		t.Errorf("Wanted %v, received %v", testState(4), decoded)
+	}
+}
+
+func BenchmarkCopy(b *testing.B) {
+	s := New(testState(100000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stateCopy := s.Copy()
+		stateCopy.SetSlot(uint64(i))
+		stateCopy.Release()
+	}
+}
+
+func BenchmarkProtoClone(b *testing.B) {
+	beaconState := testState(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stateCopy := proto.Clone(beaconState).(*pb.BeaconState)
+		stateCopy.Slot = uint64(i)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/randao"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signatures"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/sharedstate"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -29,32 +31,78 @@ func ExecuteStateTransition(
 	prevBlockRoot [32]byte,
 	verifySignatures bool,
 ) (*pb.BeaconState, error) {
-	var err error
+	beaconState, _, err := executeStateTransition(beaconState, block, prevBlockRoot, verifySignatures, false)
+	return beaconState, err
+}
+
+// ExecuteStateTransitionWithReport executes the state transition like ExecuteStateTransition,
+// and also returns the report of the rewards and penalties applied to each validator when the
+// transition processes an epoch. The report is nil otherwise.
+func ExecuteStateTransitionWithReport(
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
+	prevBlockRoot [32]byte,
+	verifySignatures bool,
+) (*pb.BeaconState, *pb.EpochRewardReport, error) {
+	return executeStateTransition(beaconState, block, prevBlockRoot, verifySignatures, true)
+}
 
-	currentSlot := beaconState.Slot
-	beaconState.Slot = currentSlot + 1
+// ProcessSlot advances the state to the next slot without a block, applying the
+// per-slot transitions to the randao layers, randao mixes and block roots. The
+// state's large lists are only copied if they are shared with another copy of it.
+func ProcessSlot(beaconState *sharedstate.BeaconState, prevBlockRoot [32]byte) (*sharedstate.BeaconState, error) {
+	beaconState.SetSlot(beaconState.Slot() + 1)
 
-	beaconState, err = randao.UpdateRandaoLayers(beaconState, beaconState.Slot)
+	beaconState, err := randao.UpdateRandaoLayers(beaconState, beaconState.Slot())
 	if err != nil {
 		return nil, fmt.Errorf("unable to update randao layer %v", err)
 	}
-	beaconState = randao.UpdateRandaoMixes(beaconState)
-	beaconState = b.ProcessBlockRoots(beaconState, prevBlockRoot)
+	beaconState, err = randao.UpdateRandaoMixes(beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update randao mixes %v", err)
+	}
+	beaconState, err = b.ProcessBlockRoots(beaconState, prevBlockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process block roots %v", err)
+	}
+	return beaconState, nil
+}
+
+func executeStateTransition(
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
+	prevBlockRoot [32]byte,
+	verifySignatures bool,
+	withReport bool,
+) (*pb.BeaconState, *pb.EpochRewardReport, error) {
+	var err error
+	var report *pb.EpochRewardReport
+
+	slotState, err := ProcessSlot(sharedstate.New(beaconState), prevBlockRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	beaconState = slotState.Proto()
+
 	if block != nil {
 		beaconState, err = ProcessBlock(beaconState, block, verifySignatures)
 		if err != nil {
-			return nil, fmt.Errorf("unable to process block: %v", err)
+			return nil, nil, fmt.Errorf("unable to process block: %v", err)
 		}
 
 		if e.CanProcessEpoch(beaconState) {
-			beaconState, err = ProcessEpoch(beaconState)
+			if withReport {
+				beaconState, report, err = ProcessEpochWithReport(beaconState)
+			} else {
+				beaconState, err = ProcessEpoch(beaconState)
+			}
 		}
 		if err != nil {
-			return nil, fmt.Errorf("unable to process epoch: %v", err)
+			return nil, nil, fmt.Errorf("unable to process epoch: %v", err)
 		}
 	}
 
-	return beaconState, nil
+	return beaconState, report, nil
 }
 
 // ProcessBlock creates a new, modified beacon state by applying block operation
@@ -69,9 +117,11 @@ func ProcessBlock(state *pb.BeaconState, block *pb.BeaconBlock, verifySignatures
 		)
 	}
 	if verifySignatures {
-		// TODO(#781): Verify Proposer Signature.
-		if err := b.VerifyProposerSignature(block); err != nil {
-			return nil, fmt.Errorf("could not verify proposer signature: %v", err)
+		// Every signature of the block, including the proposer signature, is verified
+		// upfront on a pool of workers, so the block operations below are applied
+		// without checking them again.
+		if err := signatures.VerifyBlock(state, block); err != nil {
+			return nil, fmt.Errorf("could not verify block signatures: %v", err)
 		}
 	}
 	var err error
@@ -79,16 +129,16 @@ func ProcessBlock(state *pb.BeaconState, block *pb.BeaconBlock, verifySignatures
 	if err != nil {
 		return nil, fmt.Errorf("could not verify and process block randao: %v", err)
 	}
-	state, err = b.ProcessProposerSlashings(state, block, verifySignatures)
+	state, err = b.ProcessProposerSlashings(state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block proposer slashings: %v", err)
 	}
 	state = b.ProcessEth1Data(state, block)
-	state, err = b.ProcessAttesterSlashings(state, block, verifySignatures)
+	state, err = b.ProcessAttesterSlashings(state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block attester slashings: %v", err)
 	}
-	state, err = b.ProcessBlockAttestations(state, block, verifySignatures)
+	state, err = b.ProcessBlockAttestations(state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not process block attestations: %v", err)
 	}
@@ -96,7 +146,7 @@ func ProcessBlock(state *pb.BeaconState, block *pb.BeaconBlock, verifySignatures
 	if err != nil {
 		return nil, fmt.Errorf("could not process block validator deposits: %v", err)
 	}
-	state, err = b.ProcessValidatorExits(state, block, verifySignatures)
+	state, err = b.ProcessValidatorExits(state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not process validator exits: %v", err)
 	}
@@ -116,6 +166,23 @@ func ProcessBlock(state *pb.BeaconState, block *pb.BeaconBlock, verifySignatures
 // 	 update_validator_registry(state)
 // 	 final_book_keeping(state)
 func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
+	return processEpoch(state, nil)
+}
+
+// ProcessEpochWithReport performs the per epoch operations like ProcessEpoch, and
+// reports the balance change each validator received from every kind of reward
+// and penalty applied during the epoch transition.
+func ProcessEpochWithReport(state *pb.BeaconState) (*pb.BeaconState, *pb.EpochRewardReport, error) {
+	currentEpoch := helpers.CurrentEpoch(state)
+	reporter := newRewardReporter(state)
+	state, err := processEpoch(state, reporter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return state, reporter.report(currentEpoch), nil
+}
+
+func processEpoch(state *pb.BeaconState, reporter *rewardReporter) (*pb.BeaconState, error) {
 	// Calculate total balances of active validators of the current state.
 	currentEpoch := helpers.CurrentEpoch(state)
 	activeValidatorIndices := helpers.ActiveValidatorIndices(state.ValidatorRegistry, currentEpoch)
@@ -207,27 +274,34 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 	case epochsSinceFinality <= 4:
 		// Apply rewards/penalties to validators for attesting
 		// expected FFG source.
+		reporter.snapshot(state)
 		state = bal.ExpectedFFGSource(
 			state,
 			prevJustifiedAttesterIndices,
 			prevJustifiedAttestingBalance,
 			totalBalance)
+		reporter.record(state, sourceDelta)
 		// Apply rewards/penalties to validators for attesting
 		// expected FFG target.
+		reporter.snapshot(state)
 		state = bal.ExpectedFFGTarget(
 			state,
 			prevBoundaryAttesterIndices,
 			prevBoundaryAttestingBalances,
 			totalBalance)
+		reporter.record(state, targetDelta)
 		// Apply rewards/penalties to validators for attesting
 		// expected beacon chain head.
+		reporter.snapshot(state)
 		state = bal.ExpectedBeaconChainHead(
 			state,
 			prevHeadAttesterIndices,
 			prevHeadAttestingBalances,
 			totalBalance)
+		reporter.record(state, headDelta)
 		// Apply rewards for to validators for including attestations
 		// based on inclusion distance.
+		reporter.snapshot(state)
 		state, err = bal.InclusionDistance(
 			state,
 			prevAttesterIndices,
@@ -235,34 +309,44 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 		if err != nil {
 			return nil, fmt.Errorf("could not calculate inclusion dist rewards: %v", err)
 		}
+		reporter.record(state, inclusionDistanceDelta)
 
 	case epochsSinceFinality > 4:
 		// Apply penalties for long inactive FFG source participants.
+		reporter.snapshot(state)
 		state = bal.InactivityFFGSource(
 			state,
 			prevJustifiedAttesterIndices,
 			totalBalance,
 			epochsSinceFinality)
+		reporter.record(state, inactivityDelta)
 		// Apply penalties for long inactive FFG target participants.
+		reporter.snapshot(state)
 		state = bal.InactivityFFGTarget(
 			state,
 			prevBoundaryAttesterIndices,
 			totalBalance,
 			epochsSinceFinality)
+		reporter.record(state, inactivityDelta)
 		// Apply penalties for long inactive validators who didn't
 		// attest to head canonical chain.
+		reporter.snapshot(state)
 		state = bal.InactivityChainHead(
 			state,
 			prevHeadAttesterIndices,
 			totalBalance)
+		reporter.record(state, inactivityDelta)
 		// Apply penalties for long inactive validators who also
 		// exited with penalties.
+		reporter.snapshot(state)
 		state = bal.InactivityExitedPenalties(
 			state,
 			totalBalance,
 			epochsSinceFinality)
+		reporter.record(state, penaltiesDelta)
 		// Apply penalties for long inactive validators that
 		// don't include attestations.
+		reporter.snapshot(state)
 		state, err = bal.InactivityInclusionDistance(
 			state,
 			prevAttesterIndices,
@@ -270,9 +354,11 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 		if err != nil {
 			return nil, fmt.Errorf("could not calculate inclusion penalties: %v", err)
 		}
+		reporter.record(state, inactivityDelta)
 	}
 
 	// Process Attestation Inclusion Rewards.
+	reporter.snapshot(state)
 	state, err = bal.AttestationInclusion(
 		state,
 		totalBalance,
@@ -280,8 +366,10 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not process attestation inclusion rewards: %v", err)
 	}
+	reporter.record(state, attestationInclusionDelta)
 
 	// Process crosslink rewards and penalties.
+	reporter.snapshot(state)
 	state, err = bal.Crosslinks(
 		state,
 		currentAttestations,
@@ -289,6 +377,7 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not process crosslink rewards and penalties: %v", err)
 	}
+	reporter.record(state, crosslinkDelta)
 
 	// Process ejections.
 	state, err = e.ProcessEjections(state)
@@ -298,7 +387,9 @@ func ProcessEpoch(state *pb.BeaconState) (*pb.BeaconState, error) {
 
 	// Process validator registry.
 	state = e.ProcessPrevSlotShardSeed(state)
+	reporter.snapshot(state)
 	state = v.ProcessPenaltiesAndExits(state)
+	reporter.record(state, penaltiesDelta)
 	if e.CanProcessValidatorRegistry(state) {
 		state, err = e.ProcessValidatorRegistry(state)
 		if err != nil {
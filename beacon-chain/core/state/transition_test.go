@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -86,7 +88,7 @@ func TestProcessBlock_IncorrectAttesterSlashing(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -128,7 +130,7 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -145,12 +147,12 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att2,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -194,7 +196,7 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -211,12 +213,12 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att2,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -284,7 +286,7 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -301,12 +303,12 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			SlashableVote_1: &pb.SlashableVote{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableVote_2: &pb.SlashableVote{
 				Data:             att2,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -572,3 +574,74 @@ func TestProcessEpoch_CantProcessEjections(t *testing.T) {
 		t.Errorf("Expected: %s, received: %v", want, err)
 	}
 }
+
+func TestProcessEpochWithReport_AccountsForBalanceChanges(t *testing.T) {
+	validatorRegistry := make([]*pb.Validator, 8)
+	validatorBalances := make([]uint64, len(validatorRegistry))
+	for i := range validatorRegistry {
+		validatorRegistry[i] = &pb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+		validatorBalances[i] = params.BeaconConfig().MaxDeposit
+	}
+
+	var attestations []*pb.PendingAttestationRecord
+	for i := uint64(0); i < params.BeaconConfig().EpochLength*2; i++ {
+		attestations = append(attestations, &pb.PendingAttestationRecord{
+			Data: &pb.AttestationData{
+				Slot:                     i + params.BeaconConfig().EpochLength,
+				Shard:                    1,
+				JustifiedSlot:            64,
+				JustifiedBlockRootHash32: []byte{0},
+			},
+			AggregationBitfield: []byte{},
+			SlotIncluded:        i + params.BeaconConfig().EpochLength + 1,
+		})
+	}
+
+	var blockRoots [][]byte
+	for i := uint64(0); i < 2*params.BeaconConfig().EpochLength; i++ {
+		blockRoots = append(blockRoots, []byte{byte(i)})
+	}
+
+	var randaoHashes [][]byte
+	for i := uint64(0); i < 5*params.BeaconConfig().EpochLength; i++ {
+		randaoHashes = append(randaoHashes, []byte{byte(i)})
+	}
+
+	beaconState := &pb.BeaconState{
+		Slot:                     params.BeaconConfig().EpochLength,
+		LatestAttestations:       attestations,
+		ValidatorBalances:        validatorBalances,
+		ValidatorRegistry:        validatorRegistry,
+		LatestBlockRootHash32S:   blockRoots,
+		LatestCrosslinks:         []*pb.CrosslinkRecord{{}, {}},
+		LatestRandaoMixesHash32S: randaoHashes,
+	}
+
+	want, err := ProcessEpoch(proto.Clone(beaconState).(*pb.BeaconState))
+	if err != nil {
+		t.Fatalf("Could not process epoch: %v", err)
+	}
+	newState, report, err := ProcessEpochWithReport(beaconState)
+	if err != nil {
+		t.Fatalf("Could not process epoch with report: %v", err)
+	}
+	if !proto.Equal(newState, want) {
+		t.Error("Expected reporting not to change the epoch transition")
+	}
+	if report.Epoch != helpers.CurrentEpoch(want) {
+		t.Errorf("Expected report of epoch %d, received %d", helpers.CurrentEpoch(want), report.Epoch)
+	}
+	if len(report.ValidatorDeltas) == 0 {
+		t.Fatal("Expected the report to contain balance changes")
+	}
+
+	for _, delta := range report.ValidatorDeltas {
+		total := delta.Source + delta.Target + delta.Head + delta.InclusionDistance +
+			delta.AttestationInclusion + delta.Inactivity + delta.Crosslink + delta.Penalties
+		change := int64(want.ValidatorBalances[delta.ValidatorIndex]) - int64(params.BeaconConfig().MaxDeposit)
+		if total != change {
+			t.Errorf("Expected deltas of validator %d to add up to %d, received %d: %v",
+				delta.ValidatorIndex, change, total, delta)
+		}
+	}
+}
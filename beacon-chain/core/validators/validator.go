@@ -483,6 +483,40 @@ func UpdateRegistry(state *pb.BeaconState) (*pb.BeaconState, error) {
 	return state, nil
 }
 
+// EstimatedActivationEpoch estimates the epoch at which the validator at the given
+// index becomes active. Validators which already have an activation epoch return it,
+// otherwise the estimate assumes the registry is updated every epoch and counts how
+// many epochs of balance churn are needed to activate the validators queued ahead of it.
+func EstimatedActivationEpoch(state *pb.BeaconState, idx uint64) uint64 {
+	// The estimate reads the balances of the validators, which a well formed state
+	// has for every validator of the registry.
+	if idx >= uint64(len(state.ValidatorRegistry)) || len(state.ValidatorBalances) != len(state.ValidatorRegistry) {
+		return params.BeaconConfig().FarFutureEpoch
+	}
+	validator := state.ValidatorRegistry[idx]
+	if validator.ActivationEpoch != params.BeaconConfig().FarFutureEpoch {
+		return validator.ActivationEpoch
+	}
+	currentEpoch := helpers.CurrentEpoch(state)
+	activeValidatorIndices := helpers.ActiveValidatorIndices(
+		state.ValidatorRegistry, currentEpoch)
+	maxBalChurn := maxBalanceChurn(TotalEffectiveBalance(state, activeValidatorIndices))
+
+	// UpdateRegistry activates queued validators in registry order.
+	var queuedBalance uint64
+	for i := uint64(0); i <= idx; i++ {
+		if state.ValidatorRegistry[i].ActivationEpoch > helpers.EntryExitEffectEpoch(currentEpoch) &&
+			state.ValidatorBalances[i] >= params.BeaconConfig().MaxDeposit {
+			queuedBalance += EffectiveBalance(state, i)
+		}
+	}
+	var churnEpochs uint64
+	if queuedBalance > 0 {
+		churnEpochs = (queuedBalance - 1) / maxBalChurn
+	}
+	return helpers.EntryExitEffectEpoch(currentEpoch + churnEpochs)
+}
+
 // ProcessPenaltiesAndExits prepares the validators and the penalized validators
 // for withdrawal.
 //
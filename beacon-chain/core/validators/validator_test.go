@@ -786,3 +786,37 @@ func TestMaxBalanceChurn(t *testing.T) {
 		}
 	}
 }
+
+func TestEstimatedActivationEpoch(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	state := &pb.BeaconState{
+		Slot: 5 * params.BeaconConfig().EpochLength,
+		ValidatorRegistry: []*pb.Validator{
+			{ActivationEpoch: 0, ExitEpoch: farFuture},
+		},
+		ValidatorBalances: []uint64{params.BeaconConfig().MaxDeposit},
+	}
+	// A single active validator allows MaxBalanceChurnQuotient / 2 deposits to be activated per epoch.
+	perEpoch := params.BeaconConfig().MaxBalanceChurnQuotient / 2
+	for i := uint64(0); i < perEpoch+1; i++ {
+		state.ValidatorRegistry = append(state.ValidatorRegistry,
+			&pb.Validator{ActivationEpoch: farFuture, ExitEpoch: farFuture})
+		state.ValidatorBalances = append(state.ValidatorBalances, params.BeaconConfig().MaxDeposit)
+	}
+
+	tests := []struct {
+		idx   uint64
+		epoch uint64
+	}{
+		{idx: 0, epoch: 0},
+		{idx: 1, epoch: helpers.EntryExitEffectEpoch(5)},
+		{idx: perEpoch, epoch: helpers.EntryExitEffectEpoch(5)},
+		{idx: perEpoch + 1, epoch: helpers.EntryExitEffectEpoch(6)},
+		{idx: perEpoch + 2, epoch: farFuture},
+	}
+	for _, tt := range tests {
+		if epoch := EstimatedActivationEpoch(state, tt.idx); epoch != tt.epoch {
+			t.Errorf("Expected validator %d to be activated at epoch %d, received %d", tt.idx, tt.epoch, epoch)
+		}
+	}
+}
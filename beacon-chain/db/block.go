@@ -72,6 +72,35 @@ func (db *BeaconDB) SaveBlock(block *pb.BeaconBlock) error {
 	})
 }
 
+// SaveBlockAndState saves a block along with the state it produced in a single
+// transaction, without making it the head of the chain. The state is saved under
+// the hash of its encoding, as read by UnfinalizedBlockState.
+func (db *BeaconDB) SaveBlockAndState(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	blockHash, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("unable to get the block hash: %v", err)
+	}
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("unable to encode the block: %v", err)
+	}
+	beaconStateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return fmt.Errorf("unable to encode the beacon state: %v", err)
+	}
+	stateHash := hashutil.Hash(beaconStateEnc)
+
+	return db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(blockBucket).Put(blockHash[:], blockEnc); err != nil {
+			return fmt.Errorf("failed to save block: %v", err)
+		}
+		if err := tx.Bucket(chainInfoBucket).Put(stateHash[:], beaconStateEnc); err != nil {
+			return fmt.Errorf("failed to save beacon state: %v", err)
+		}
+		return nil
+	})
+}
+
 // ChainHead returns the head of the main chain.
 func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
@@ -116,32 +145,63 @@ func (db *BeaconDB) UpdateChainHead(block *pb.BeaconBlock, beaconState *pb.Beaco
 		return fmt.Errorf("unable to encode the beacon state: %v", err)
 	}
 
-	slotBinary := encodeSlotNumber(block.GetSlot())
-
 	return db.update(func(tx *bolt.Tx) error {
 		blockBucket := tx.Bucket(blockBucket)
-		chainInfo := tx.Bucket(chainInfoBucket)
-		mainChain := tx.Bucket(mainChainBucket)
 
 		if blockBucket.Get(blockHash[:]) == nil {
 			return fmt.Errorf("expected block %#x to have already been saved before updating head: %v", blockHash, err)
 		}
 
-		if err := mainChain.Put(slotBinary, blockHash[:]); err != nil {
-			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
-		}
+		return putChainHead(tx, blockHash, block.GetSlot(), beaconStateEnc)
+	})
+}
 
-		if err := chainInfo.Put(mainChainHeightKey, slotBinary); err != nil {
-			return fmt.Errorf("failed to record the block as the head of the main chain: %v", err)
-		}
+// SaveBlockAndUpdateChainHead saves a block, records it as the head of the chain and
+// saves the state it produced in a single transaction, so a crash can never leave
+// the head pointing at a block or state that was not written.
+func (db *BeaconDB) SaveBlockAndUpdateChainHead(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	blockHash, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("unable to get the block hash: %v", err)
+	}
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("unable to encode the block: %v", err)
+	}
+	beaconStateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return fmt.Errorf("unable to encode the beacon state: %v", err)
+	}
 
-		if err := chainInfo.Put(stateLookupKey, beaconStateEnc); err != nil {
-			return fmt.Errorf("failed to save beacon state as canonical: %v", err)
+	return db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(blockBucket).Put(blockHash[:], blockEnc); err != nil {
+			return fmt.Errorf("failed to save block: %v", err)
 		}
-		return nil
+		return putChainHead(tx, blockHash, block.GetSlot(), beaconStateEnc)
 	})
 }
 
+// putChainHead records the block with the given hash as the head of the main chain
+// and the encoded state as the canonical state within the transaction.
+func putChainHead(tx *bolt.Tx, blockHash [32]byte, slot uint64, beaconStateEnc []byte) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	mainChain := tx.Bucket(mainChainBucket)
+	slotBinary := encodeSlotNumber(slot)
+
+	if err := mainChain.Put(slotBinary, blockHash[:]); err != nil {
+		return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+	}
+
+	if err := chainInfo.Put(mainChainHeightKey, slotBinary); err != nil {
+		return fmt.Errorf("failed to record the block as the head of the main chain: %v", err)
+	}
+
+	if err := chainInfo.Put(stateLookupKey, beaconStateEnc); err != nil {
+		return fmt.Errorf("failed to save beacon state as canonical: %v", err)
+	}
+	return nil
+}
+
 // BlockBySlot accepts a slot number and returns the corresponding block in the main chain.
 // Returns nil if a block was not recorded for the given slot.
 func (db *BeaconDB) BlockBySlot(slot uint64) (*pb.BeaconBlock, error) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -72,6 +73,50 @@ func TestSave(t *testing.T) {
 	}
 }
 
+func TestSaveBlockAndState(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	genesisTime := uint64(time.Now().Unix())
+	deposits := setupInitialDeposits(t)
+	if err := db.InitializeState(genesisTime, deposits); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+
+	block := &pb.BeaconBlock{Slot: 1}
+	beaconState := &pb.BeaconState{Slot: 1}
+	if err := db.SaveBlockAndState(block, beaconState); err != nil {
+		t.Fatalf("failed to save block and state: %v", err)
+	}
+
+	blockHash, _ := hashutil.HashBeaconBlock(block)
+	if !db.HasBlock(blockHash) {
+		t.Error("expected block to be saved")
+	}
+	enc, err := proto.Marshal(beaconState)
+	if err != nil {
+		t.Fatalf("failed to encode state: %v", err)
+	}
+	savedState, err := db.UnfinalizedBlockState(hashutil.Hash(enc))
+	if err != nil {
+		t.Fatalf("failed to get block state: %v", err)
+	}
+	if !proto.Equal(savedState, beaconState) {
+		t.Errorf("expected state %v to be saved with the block, received %v", beaconState, savedState)
+	}
+	newHead, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if !proto.Equal(newHead, head) {
+		t.Errorf("expected chain head to stay %v, received %v", head, newHead)
+	}
+}
+
 func TestBlockBySlotEmptyChain(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
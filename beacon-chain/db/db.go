@@ -2,6 +2,7 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sync"
@@ -51,6 +52,7 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 }
 
 // NewDB initializes a new DB. If the genesis block and states do not exist, this method creates it.
+// A chain head left half-written by a process that stopped while saving it is rolled back.
 func NewDB(dirPath string) (*BeaconDB, error) {
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
@@ -68,11 +70,16 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 
 	if err := db.update(func(tx *bolt.Tx) error {
 		return createBuckets(tx, blockBucket, attestationBucket, mainChainBucket,
-			chainInfoBucket, blockVoteCacheBucket, cleanupHistoryBucket, blockOperationsBucket)
+			chainInfoBucket, blockVoteCacheBucket, cleanupHistoryBucket, blockOperationsBucket,
+			rewardReportBucket)
 
 	}); err != nil {
 		return nil, err
 	}
 
+	if err := db.repairChainHead(); err != nil {
+		return nil, fmt.Errorf("could not repair chain head: %v", err)
+	}
+
 	return db, err
 }
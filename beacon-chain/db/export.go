@@ -0,0 +1,229 @@
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// exportHeader starts every exported chain, identifying the format and its version.
+var exportHeader = []byte("prysm-beacon-chain-export/1\n")
+
+// maxExportRecordSize bounds the size of a record read from an exported chain, so a
+// corrupted length can not make the reader allocate an unbounded buffer.
+const maxExportRecordSize = 1 << 28
+
+// The kinds of records of an exported chain. Each record is its kind, followed by
+// the uvarint length of its protobuf encoding and the encoding itself.
+const (
+	stateRecord byte = 1
+	blockRecord byte = 2
+)
+
+// ExportRecord is a record of an exported chain, holding either a block or the
+// state produced by the block before it.
+type ExportRecord struct {
+	Block *pb.BeaconBlock
+	State *pb.BeaconState
+}
+
+// ExportSummary describes the chain written by Export.
+type ExportSummary struct {
+	// BaseSlot is the slot of the first exported block, whose state the chain is
+	// replayed from.
+	BaseSlot uint64
+	EndSlot  uint64
+	Blocks   int
+	States   int
+}
+
+// Export writes the main chain blocks up to the end slot as a stream of length-prefixed
+// protobuf records, which ImportReader reads back. The stream starts with the state of
+// the most recent block at or before the start slot whose state is available, followed
+// by that block and every block after it, so the chain can be replayed into an empty
+// database. The states saved for the other blocks follow them, allowing the replayed
+// states to be checked against the original ones. The end slot is capped at the head.
+func (db *BeaconDB) Export(w io.Writer, startSlot uint64, endSlot uint64) (*ExportSummary, error) {
+	summary := &ExportSummary{}
+	buf := bufio.NewWriter(w)
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		mainChain := tx.Bucket(mainChainBucket)
+		blockBkt := tx.Bucket(blockBucket)
+
+		height := chainInfo.Get(mainChainHeightKey)
+		if height == nil {
+			return errors.New("the chain has not been initialized")
+		}
+		if headSlot := decodeToSlotNumber(height); endSlot > headSlot {
+			endSlot = headSlot
+		}
+		if startSlot > endSlot {
+			return fmt.Errorf("start slot %d is after end slot %d", startSlot, endSlot)
+		}
+		firstKey, _ := mainChain.Cursor().First()
+
+		// stateOf returns the encoded state of a main chain block, or nil if it is not saved.
+		// Databases created before the initial state was recorded may still hold the
+		// genesis state by the state root of the genesis block, as for other blocks.
+		stateOf := func(key []byte, block *pb.BeaconBlock) []byte {
+			if bytes.Equal(key, height) {
+				return chainInfo.Get(stateLookupKey)
+			}
+			if bytes.Equal(key, firstKey) {
+				if enc := chainInfo.Get(initialStateLookupKey); enc != nil {
+					return enc
+				}
+			}
+			if len(block.StateRootHash32) == 32 {
+				return chainInfo.Get(block.StateRootHash32)
+			}
+			return nil
+		}
+		blockAt := func(key []byte, hash []byte) (*pb.BeaconBlock, []byte, error) {
+			enc := blockBkt.Get(hash)
+			if enc == nil {
+				return nil, nil, fmt.Errorf("main chain block %#x at slot %d not found", hash, decodeToSlotNumber(key))
+			}
+			block, err := createBlock(enc)
+			return block, enc, err
+		}
+
+		c := mainChain.Cursor()
+		startKey := encodeSlotNumber(startSlot)
+		k, v := c.Seek(startKey)
+		if k == nil || bytes.Compare(k, startKey) > 0 {
+			k, v = c.Prev()
+		}
+		var baseState []byte
+		for ; k != nil; k, v = c.Prev() {
+			block, _, err := blockAt(k, v)
+			if err != nil {
+				return err
+			}
+			if baseState = stateOf(k, block); baseState != nil {
+				break
+			}
+		}
+		if baseState == nil {
+			return fmt.Errorf("no state is saved for a block at or before slot %d to replay the chain from", startSlot)
+		}
+		summary.BaseSlot = decodeToSlotNumber(k)
+		summary.EndSlot = endSlot
+
+		if _, err := buf.Write(exportHeader); err != nil {
+			return err
+		}
+		if err := writeExportRecord(buf, stateRecord, baseState); err != nil {
+			return err
+		}
+		summary.States++
+		for ; k != nil && decodeToSlotNumber(k) <= endSlot; k, v = c.Next() {
+			block, blockEnc, err := blockAt(k, v)
+			if err != nil {
+				return err
+			}
+			if err := writeExportRecord(buf, blockRecord, blockEnc); err != nil {
+				return err
+			}
+			summary.Blocks++
+			if decodeToSlotNumber(k) == summary.BaseSlot {
+				continue
+			}
+			if stateEnc := stateOf(k, block); stateEnc != nil {
+				if err := writeExportRecord(buf, stateRecord, stateEnc); err != nil {
+					return err
+				}
+				summary.States++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := buf.Flush(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func writeExportRecord(w io.Writer, kind byte, enc []byte) error {
+	prefix := make([]byte, 1+binary.MaxVarintLen64)
+	prefix[0] = kind
+	n := binary.PutUvarint(prefix[1:], uint64(len(enc)))
+	if _, err := w.Write(prefix[:1+n]); err != nil {
+		return fmt.Errorf("could not write record: %v", err)
+	}
+	if _, err := w.Write(enc); err != nil {
+		return fmt.Errorf("could not write record: %v", err)
+	}
+	return nil
+}
+
+// ImportReader reads the records of a chain written by Export.
+type ImportReader struct {
+	r *bufio.Reader
+}
+
+// NewImportReader checks that the stream holds an exported chain and returns a
+// reader of its records.
+func NewImportReader(r io.Reader) (*ImportReader, error) {
+	buf := bufio.NewReader(r)
+	header := make([]byte, len(exportHeader))
+	if _, err := io.ReadFull(buf, header); err != nil || !bytes.Equal(header, exportHeader) {
+		return nil, errors.New("not an exported beacon chain")
+	}
+	return &ImportReader{r: buf}, nil
+}
+
+// Next returns the next record of the exported chain, or io.EOF once every record
+// has been read.
+func (r *ImportReader) Next() (*ExportRecord, error) {
+	kind, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read record length: %v", unexpectedEOF(err))
+	}
+	if size > maxExportRecordSize {
+		return nil, fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", size, maxExportRecordSize)
+	}
+	enc := make([]byte, size)
+	if _, err := io.ReadFull(r.r, enc); err != nil {
+		return nil, fmt.Errorf("could not read record: %v", unexpectedEOF(err))
+	}
+
+	switch kind {
+	case blockRecord:
+		block := &pb.BeaconBlock{}
+		if err := proto.Unmarshal(enc, block); err != nil {
+			return nil, fmt.Errorf("could not decode block: %v", err)
+		}
+		return &ExportRecord{Block: block}, nil
+	case stateRecord:
+		beaconState := &pb.BeaconState{}
+		if err := proto.Unmarshal(enc, beaconState); err != nil {
+			return nil, fmt.Errorf("could not decode state: %v", err)
+		}
+		return &ExportRecord{State: beaconState}, nil
+	default:
+		return nil, fmt.Errorf("unknown record kind %d", kind)
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// buildTestChain extends the main chain with a block at every slot up to the head
+// slot, saving the states of the blocks at the given slots as unfinalized states.
+func buildTestChain(t *testing.T, db *BeaconDB, genesis *pb.BeaconBlock, genesisState *pb.BeaconState,
+	headSlot uint64, savedStates ...uint64) {
+	saved := make(map[uint64]bool)
+	for _, slot := range savedStates {
+		saved[slot] = true
+	}
+	parent := genesis
+	for slot := uint64(1); slot <= headSlot; slot++ {
+		beaconState := proto.Clone(genesisState).(*pb.BeaconState)
+		beaconState.Slot = slot
+		enc, err := proto.Marshal(beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stateHash := hashutil.Hash(enc)
+		block := childBlock(t, parent, slot, stateHash[:])
+		if saved[slot] {
+			if err := db.SaveUnfinalizedBlockState(beaconState); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+		}
+		if err := db.SaveBlockAndUpdateChainHead(block, beaconState); err != nil {
+			t.Fatalf("failed to update chain head: %v", err)
+		}
+		parent = block
+	}
+}
+
+func readExport(t *testing.T, enc []byte) []*ExportRecord {
+	r, err := NewImportReader(bytes.NewReader(enc))
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	var records []*ExportRecord
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("failed to read record: %v", err)
+		}
+		records = append(records, record)
+	}
+}
+
+func TestExport_FromGenesis(t *testing.T) {
+	db, genesis, genesisState := initializeTestDB(t)
+	defer teardownDB(t, db)
+	buildTestChain(t, db, genesis, genesisState, 3, 2)
+
+	var buf bytes.Buffer
+	summary, err := db.Export(&buf, 0, 100)
+	if err != nil {
+		t.Fatalf("failed to export chain: %v", err)
+	}
+	if summary.BaseSlot != 0 || summary.EndSlot != 3 || summary.Blocks != 4 || summary.States != 3 {
+		t.Errorf("unexpected export summary %+v", summary)
+	}
+
+	records := readExport(t, buf.Bytes())
+	var kinds []string
+	for _, record := range records {
+		if record.Block != nil {
+			kinds = append(kinds, "block")
+		} else {
+			kinds = append(kinds, "state")
+		}
+	}
+	// The genesis state, the blocks, and the states saved for slot 2 and the head.
+	expected := "state block block block state block state"
+	if strings.Join(kinds, " ") != expected {
+		t.Errorf("expected records %q, received %q", expected, strings.Join(kinds, " "))
+	}
+	if !proto.Equal(records[0].State, genesisState) {
+		t.Error("expected the genesis state to be exported first")
+	}
+	if !proto.Equal(records[1].Block, genesis) {
+		t.Error("expected the genesis block to follow the genesis state")
+	}
+	if records[4].State.Slot != 2 || records[6].State.Slot != 3 {
+		t.Errorf("expected states of slots 2 and 3, received %d and %d", records[4].State.Slot, records[6].State.Slot)
+	}
+}
+
+func TestExport_StartsFromSavedState(t *testing.T) {
+	db, genesis, genesisState := initializeTestDB(t)
+	defer teardownDB(t, db)
+	buildTestChain(t, db, genesis, genesisState, 5, 2)
+
+	var buf bytes.Buffer
+	summary, err := db.Export(&buf, 3, 4)
+	if err != nil {
+		t.Fatalf("failed to export chain: %v", err)
+	}
+	if summary.BaseSlot != 2 || summary.EndSlot != 4 || summary.Blocks != 3 || summary.States != 1 {
+		t.Errorf("unexpected export summary %+v", summary)
+	}
+	records := readExport(t, buf.Bytes())
+	if records[0].State == nil || records[0].State.Slot != 2 {
+		t.Fatal("expected the export to start with the state of slot 2")
+	}
+	if records[1].Block.Slot != 2 || records[3].Block.Slot != 4 {
+		t.Errorf("expected blocks of slots 2 to 4, received %d to %d", records[1].Block.Slot, records[3].Block.Slot)
+	}
+
+	if _, err := db.Export(&buf, 5, 4); err == nil {
+		t.Error("expected a start slot after the end slot to be rejected")
+	}
+}
+
+func TestExport_ImportAnchor(t *testing.T) {
+	db, genesis, genesisState := initializeTestDB(t)
+	defer teardownDB(t, db)
+	buildTestChain(t, db, genesis, genesisState, 3, 2)
+
+	var buf bytes.Buffer
+	if _, err := db.Export(&buf, 2, 2); err != nil {
+		t.Fatalf("failed to export chain: %v", err)
+	}
+	records := readExport(t, buf.Bytes())
+
+	imported := setupDB(t)
+	defer teardownDB(t, imported)
+	if err := imported.InitializeAnchor(records[0].State, records[1].Block); err != nil {
+		t.Fatalf("failed to initialize chain from anchor: %v", err)
+	}
+	head, err := imported.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, records[1].Block) {
+		t.Error("expected the anchor block to be the chain head")
+	}
+	initialState, err := imported.InitialState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(initialState, records[0].State) {
+		t.Error("expected the anchor state to be the initial state")
+	}
+	if err := imported.InitializeAnchor(records[0].State, records[1].Block); err == nil {
+		t.Error("expected a database with a chain to be rejected")
+	}
+
+	other := setupDB(t)
+	defer teardownDB(t, other)
+	otherState := proto.Clone(records[0].State).(*pb.BeaconState)
+	otherState.GenesisTime++
+	if err := other.InitializeAnchor(otherState, records[1].Block); err == nil {
+		t.Error("expected a state the anchor block does not commit to to be rejected")
+	}
+}
+
+func TestExport_GenesisStateWithoutInitialState(t *testing.T) {
+	db, genesis, genesisState := initializeTestDB(t)
+	defer teardownDB(t, db)
+	buildTestChain(t, db, genesis, genesisState, 2)
+	// Databases created before the initial state was recorded do not hold it.
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Delete(initialStateLookupKey)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := db.Export(&buf, 0, 2); err == nil {
+		t.Error("expected an export without any saved state to replay from to fail")
+	}
+
+	if err := db.SaveUnfinalizedBlockState(genesisState); err != nil {
+		t.Fatalf("failed to save genesis state: %v", err)
+	}
+	buf.Reset()
+	summary, err := db.Export(&buf, 0, 2)
+	if err != nil {
+		t.Fatalf("failed to export chain: %v", err)
+	}
+	if summary.BaseSlot != 0 {
+		t.Errorf("expected the export to start from genesis, received slot %d", summary.BaseSlot)
+	}
+	if records := readExport(t, buf.Bytes()); !proto.Equal(records[0].State, genesisState) {
+		t.Error("expected the genesis state to be exported first")
+	}
+}
+
+func TestImportReader_RejectsInvalidStreams(t *testing.T) {
+	if _, err := NewImportReader(strings.NewReader("not an export")); err == nil {
+		t.Error("expected a stream without the export header to be rejected")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(exportHeader)
+	if err := writeExportRecord(&buf, blockRecord, []byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	truncated := buf.Bytes()[:buf.Len()-1]
+	r, err := NewImportReader(bytes.NewReader(truncated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err == nil || err == io.EOF {
+		t.Errorf("expected a truncated record to be rejected, received %v", err)
+	}
+
+	buf.Reset()
+	buf.Write(exportHeader)
+	if err := writeExportRecord(&buf, 9, nil); err != nil {
+		t.Fatal(err)
+	}
+	r, err = NewImportReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err == nil {
+		t.Error("expected an unknown record kind to be rejected")
+	}
+}
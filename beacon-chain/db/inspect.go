@@ -0,0 +1,78 @@
+package db
+
+import (
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// BucketStats describes the contents of a bucket of the database.
+type BucketStats struct {
+	Name string
+	Keys int
+	// Size is the number of bytes used by the pages of the bucket.
+	Size int
+}
+
+// Inspection summarizes the contents of the database, to debug a node without
+// opening the database by hand.
+type Inspection struct {
+	Buckets       []BucketStats
+	HeadSlot      uint64
+	HeadRoot      [32]byte
+	JustifiedSlot uint64
+	FinalizedSlot uint64
+	Blocks        int
+	States        int
+	Attestations  int
+}
+
+// Inspect returns the size of every bucket, the head of the chain and the number of
+// blocks, states and attestations saved in the database.
+func (db *BeaconDB) Inspect() (*Inspection, error) {
+	inspection := &Inspection{}
+	err := db.view(func(tx *bolt.Tx) error {
+		if err := tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+			stats := bkt.Stats()
+			inspection.Buckets = append(inspection.Buckets, BucketStats{
+				Name: string(name),
+				Keys: stats.KeyN,
+				Size: stats.BranchInuse + stats.LeafInuse + stats.InlineBucketInuse,
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+		inspection.Blocks = tx.Bucket(blockBucket).Stats().KeyN
+		inspection.Attestations = tx.Bucket(attestationBucket).Stats().KeyN
+
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if height := chainInfo.Get(mainChainHeightKey); height != nil {
+			inspection.HeadSlot = decodeToSlotNumber(height)
+			inspection.HeadRoot = bytesutil.ToBytes32(tx.Bucket(mainChainBucket).Get(height))
+		}
+		if enc := chainInfo.Get(stateLookupKey); enc != nil {
+			beaconState, err := createState(enc)
+			if err != nil {
+				return err
+			}
+			inspection.JustifiedSlot = beaconState.JustifiedEpoch * params.BeaconConfig().EpochLength
+			inspection.FinalizedSlot = beaconState.FinalizedEpoch * params.BeaconConfig().EpochLength
+			inspection.States++
+		}
+		if chainInfo.Get(initialStateLookupKey) != nil {
+			inspection.States++
+		}
+		// The states of unfinalized blocks are saved by their hash.
+		return chainInfo.ForEach(func(k, _ []byte) error {
+			if len(k) == 32 {
+				inspection.States++
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inspection, nil
+}
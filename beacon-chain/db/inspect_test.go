@@ -0,0 +1,43 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestInspect(t *testing.T) {
+	db, genesis, genesisState := initializeTestDB(t)
+	defer teardownDB(t, db)
+	buildTestChain(t, db, genesis, genesisState, 3, 2)
+
+	inspection, err := db.Inspect()
+	if err != nil {
+		t.Fatalf("failed to inspect database: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inspection.HeadSlot != 3 || inspection.HeadRoot != headRoot {
+		t.Errorf("expected head %#x at slot 3, received %#x at slot %d",
+			headRoot, inspection.HeadRoot, inspection.HeadSlot)
+	}
+	// The canonical, initial and unfinalized states.
+	if inspection.Blocks != 4 || inspection.States != 3 || inspection.Attestations != 0 {
+		t.Errorf("unexpected counts of blocks, states and attestations: %+v", inspection)
+	}
+	for _, bucket := range inspection.Buckets {
+		if bucket.Name == string(blockBucket) {
+			if bucket.Keys != 4 || bucket.Size == 0 {
+				t.Errorf("unexpected block bucket stats %+v", bucket)
+			}
+			return
+		}
+	}
+	t.Error("expected the block bucket to be inspected")
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+)
+
+// repairChainHead checks that the head of the main chain was fully written, and rolls it
+// back otherwise. A process that stopped between writing a block, the chain head and the
+// canonical state can leave the chain height pointing at a block that was never saved,
+// or at a block newer than the canonical state. In that case the head is moved back to
+// the most recent main chain block whose state is available, and the main chain entries
+// above it are removed.
+func (db *BeaconDB) repairChainHead() error {
+	return db.update(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		mainChain := tx.Bucket(mainChainBucket)
+		blockBkt := tx.Bucket(blockBucket)
+
+		height := chainInfo.Get(mainChainHeightKey)
+		if height == nil {
+			// The chain has not been initialized yet.
+			return nil
+		}
+
+		var canonicalSlot uint64
+		stateEnc := chainInfo.Get(stateLookupKey)
+		if stateEnc != nil {
+			beaconState, err := createState(stateEnc)
+			if err != nil {
+				return fmt.Errorf("could not decode canonical state: %v", err)
+			}
+			canonicalSlot = beaconState.Slot
+		}
+
+		var headKey, restoredStateEnc []byte
+		var staleKeys [][]byte
+		c := mainChain.Cursor()
+		k, v := c.Seek(height)
+		if k == nil || !bytes.Equal(k, height) {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			enc := blockBkt.Get(v)
+			if enc == nil {
+				staleKeys = append(staleKeys, k)
+				continue
+			}
+			block, err := createBlock(enc)
+			if err != nil {
+				return fmt.Errorf("could not decode main chain block %#x: %v", v, err)
+			}
+			if stateEnc != nil && canonicalSlot >= block.Slot {
+				headKey = k
+				break
+			}
+			// The canonical state was not written along with this block, so the
+			// block can only stay the head if its own state was saved.
+			if len(block.StateRootHash32) == 32 {
+				if enc := chainInfo.Get(block.StateRootHash32); enc != nil {
+					headKey = k
+					restoredStateEnc = enc
+					break
+				}
+			}
+			staleKeys = append(staleKeys, k)
+		}
+		if headKey == nil {
+			return errors.New("no main chain block with an available state, the database must be synced again")
+		}
+		if len(staleKeys) == 0 && restoredStateEnc == nil {
+			return nil
+		}
+
+		for _, key := range staleKeys {
+			if err := mainChain.Delete(key); err != nil {
+				return fmt.Errorf("failed to remove main chain entry: %v", err)
+			}
+		}
+		if err := chainInfo.Put(mainChainHeightKey, headKey); err != nil {
+			return fmt.Errorf("failed to roll back the head of the main chain: %v", err)
+		}
+		if restoredStateEnc != nil {
+			if err := chainInfo.Put(stateLookupKey, restoredStateEnc); err != nil {
+				return fmt.Errorf("failed to restore the state of the head block: %v", err)
+			}
+		}
+		log.WithField("removedEntries", len(staleKeys)).Warnf(
+			"Rolled back half-written chain head from slot %d to slot %d",
+			decodeToSlotNumber(height), decodeToSlotNumber(headKey))
+		return nil
+	})
+}
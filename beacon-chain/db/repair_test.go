@@ -0,0 +1,178 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func initializeTestDB(t *testing.T) (*BeaconDB, *pb.BeaconBlock, *pb.BeaconState) {
+	db := setupDB(t)
+	if err := db.InitializeState(uint64(time.Now().Unix()), setupInitialDeposits(t)); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get genesis block: %v", err)
+	}
+	beaconState, err := db.State()
+	if err != nil {
+		t.Fatalf("failed to get beacon state: %v", err)
+	}
+	return db, genesis, beaconState
+}
+
+func reopenDB(t *testing.T, db *BeaconDB) *BeaconDB {
+	if err := db.Close(); err != nil {
+		t.Fatalf("failed to close database: %v", err)
+	}
+	reopened, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("failed to reopen database: %v", err)
+	}
+	return reopened
+}
+
+func childBlock(t *testing.T, parent *pb.BeaconBlock, slot uint64, stateRoot []byte) *pb.BeaconBlock {
+	parentHash, err := hashutil.HashBeaconBlock(parent)
+	if err != nil {
+		t.Fatalf("failed to hash block: %v", err)
+	}
+	return &pb.BeaconBlock{
+		Slot:             slot,
+		ParentRootHash32: parentHash[:],
+		StateRootHash32:  stateRoot,
+	}
+}
+
+func TestRepairChainHead_KeepsConsistentHead(t *testing.T) {
+	db, genesis, beaconState := initializeTestDB(t)
+
+	beaconState.Slot = 1
+	block := childBlock(t, genesis, 1, nil)
+	if err := db.SaveBlockAndUpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("failed to save block and head: %v", err)
+	}
+
+	db = reopenDB(t, db)
+	defer teardownDB(t, db)
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if head.Slot != 1 {
+		t.Errorf("expected head at slot 1, received %d", head.Slot)
+	}
+	savedState, err := db.State()
+	if err != nil {
+		t.Fatalf("failed to get beacon state: %v", err)
+	}
+	if savedState.Slot != 1 {
+		t.Errorf("expected state at slot 1, received %d", savedState.Slot)
+	}
+}
+
+func TestRepairChainHead_RemovesMissingHeadBlock(t *testing.T) {
+	db, genesis, _ := initializeTestDB(t)
+
+	missing := childBlock(t, genesis, 1, nil)
+	missingHash, err := hashutil.HashBeaconBlock(missing)
+	if err != nil {
+		t.Fatalf("failed to hash block: %v", err)
+	}
+	// Record the block as the head without saving it, as an interrupted write would.
+	if err := db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(mainChainBucket).Put(encodeSlotNumber(1), missingHash[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(chainInfoBucket).Put(mainChainHeightKey, encodeSlotNumber(1))
+	}); err != nil {
+		t.Fatalf("failed to write head: %v", err)
+	}
+
+	db = reopenDB(t, db)
+	defer teardownDB(t, db)
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if head.Slot != genesis.Slot {
+		t.Errorf("expected head to be rolled back to genesis, received slot %d", head.Slot)
+	}
+	block, err := db.BlockBySlot(1)
+	if err != nil {
+		t.Fatalf("failed to get block by slot: %v", err)
+	}
+	if block != nil {
+		t.Error("expected main chain entry of the missing block to be removed")
+	}
+}
+
+func TestRepairChainHead_RollsBackHeadAheadOfState(t *testing.T) {
+	db, genesis, beaconState := initializeTestDB(t)
+
+	block := childBlock(t, genesis, 1, nil)
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("failed to save block: %v", err)
+	}
+	// The head moves to slot 1 while the canonical state stays at the genesis slot.
+	if err := db.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("failed to update chain head: %v", err)
+	}
+
+	db = reopenDB(t, db)
+	defer teardownDB(t, db)
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if head.Slot != genesis.Slot {
+		t.Errorf("expected head to be rolled back to genesis, received slot %d", head.Slot)
+	}
+}
+
+func TestRepairChainHead_RestoresHeadBlockState(t *testing.T) {
+	db, genesis, beaconState := initializeTestDB(t)
+
+	headState := &pb.BeaconState{Slot: 1, GenesisTime: beaconState.GenesisTime}
+	if err := db.SaveUnfinalizedBlockState(headState); err != nil {
+		t.Fatalf("failed to save block state: %v", err)
+	}
+	enc, err := proto.Marshal(headState)
+	if err != nil {
+		t.Fatalf("failed to encode state: %v", err)
+	}
+	stateRoot := hashutil.Hash(enc)
+	block := childBlock(t, genesis, 1, stateRoot[:])
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("failed to save block: %v", err)
+	}
+	if err := db.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("failed to update chain head: %v", err)
+	}
+
+	db = reopenDB(t, db)
+	defer teardownDB(t, db)
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if head.Slot != 1 {
+		t.Errorf("expected head to stay at slot 1, received %d", head.Slot)
+	}
+	savedState, err := db.State()
+	if err != nil {
+		t.Fatalf("failed to get beacon state: %v", err)
+	}
+	if savedState.Slot != 1 {
+		t.Errorf("expected state of the head block to be restored, received slot %d", savedState.Slot)
+	}
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// SaveRewardReport puts the report of the rewards and penalties applied at the
+// transition to an epoch into the beacon chain db, keyed by the epoch.
+func (db *BeaconDB) SaveRewardReport(report *pb.EpochRewardReport) error {
+	enc, err := proto.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rewardReportBucket)
+
+		return b.Put(encodeSlotNumber(report.Epoch), enc)
+	})
+}
+
+// RewardReport retrieves the report of the rewards and penalties applied at the
+// transition to the epoch. It returns nil if no report was saved for the epoch.
+func (db *BeaconDB) RewardReport(epoch uint64) (*pb.EpochRewardReport, error) {
+	var report *pb.EpochRewardReport
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rewardReportBucket)
+
+		enc := b.Get(encodeSlotNumber(epoch))
+		if enc == nil {
+			return nil
+		}
+
+		report = &pb.EpochRewardReport{}
+		if err := proto.Unmarshal(enc, report); err != nil {
+			return fmt.Errorf("failed to unmarshal encoding: %v", err)
+		}
+		return nil
+	})
+
+	return report, err
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestSaveAndRetrieveRewardReport(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	report := &pb.EpochRewardReport{
+		Epoch: 5,
+		ValidatorDeltas: []*pb.ValidatorBalanceDeltas{
+			{ValidatorIndex: 0, Source: 10, Target: 10, Head: 10, AttestationInclusion: 2},
+			{ValidatorIndex: 3, Source: -10, Inactivity: -4, Penalties: -100},
+		},
+	}
+	if err := db.SaveRewardReport(report); err != nil {
+		t.Fatalf("Failed to save reward report: %v", err)
+	}
+
+	saved, err := db.RewardReport(5)
+	if err != nil {
+		t.Fatalf("Failed to retrieve reward report: %v", err)
+	}
+	if !reflect.DeepEqual(saved, report) {
+		t.Errorf("Expected saved report %v, received %v", report, saved)
+	}
+
+	missing, err := db.RewardReport(6)
+	if err != nil {
+		t.Fatalf("Failed to retrieve reward report: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("Expected no report for an epoch without one, received %v", missing)
+	}
+}
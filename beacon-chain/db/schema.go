@@ -19,10 +19,15 @@ var (
 	mainChainBucket       = []byte("main-chain-bucket")
 	chainInfoBucket       = []byte("chain-info")
 	blockVoteCacheBucket  = []byte("block-vote-cache")
+	rewardReportBucket    = []byte("reward-report-bucket")
 
 	mainChainHeightKey = []byte("chain-height")
 	stateLookupKey     = []byte("state")
 
+	// initialStateLookupKey stores the state of the first block of the main chain,
+	// from which the chain can be replayed.
+	initialStateLookupKey = []byte("initial-state")
+
 	// DB internal use
 	cleanupHistoryBucket    = []byte("cleanup-history-bucket")
 	cleanedFinalizedSlotKey = []byte("cleaned-finalized-slot")
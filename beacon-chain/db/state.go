@@ -1,6 +1,8 @@
 package db
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // InitializeState creates an initial genesis state for the beacon
@@ -23,36 +26,102 @@ func (db *BeaconDB) InitializeState(genesisTime uint64, deposits []*pb.Deposit)
 	// #nosec G104
 	stateEnc, _ := proto.Marshal(beaconState)
 	stateHash := hashutil.Hash(stateEnc)
-	genesisBlock := b.NewGenesisBlock(stateHash[:])
-	// #nosec G104
-	blockHash, _ := hashutil.HashBeaconBlock(genesisBlock)
-	// #nosec G104
-	blockEnc, _ := proto.Marshal(genesisBlock)
-	zeroBinary := encodeSlotNumber(0)
+	return db.InitializeGenesis(beaconState, b.NewGenesisBlock(stateHash[:]))
+}
 
-	return db.update(func(tx *bolt.Tx) error {
-		blockBkt := tx.Bucket(blockBucket)
-		mainChain := tx.Bucket(mainChainBucket)
-		chainInfo := tx.Bucket(chainInfoBucket)
+// InitializeGenesis saves a pre-computed genesis state and block as the start of
+// the canonical chain, such as the ones written by the beacon node's genesis command.
+// The block must be at the genesis slot and commit to the given state.
+func (db *BeaconDB) InitializeGenesis(genesisState *pb.BeaconState, genesisBlock *pb.BeaconBlock) error {
+	if genesisState == nil || genesisBlock == nil {
+		return errors.New("genesis state and block must both be provided")
+	}
+	if genesisBlock.Slot != params.BeaconConfig().GenesisSlot {
+		return fmt.Errorf("genesis block slot %d is not the genesis slot %d",
+			genesisBlock.Slot, params.BeaconConfig().GenesisSlot)
+	}
+	stateEnc, err := proto.Marshal(genesisState)
+	if err != nil {
+		return fmt.Errorf("failed to encode genesis state: %v", err)
+	}
+	stateHash := hashutil.Hash(stateEnc)
+	if !bytes.Equal(genesisBlock.StateRootHash32, stateHash[:]) {
+		return fmt.Errorf("genesis block state root %#x does not match genesis state hash %#x",
+			genesisBlock.StateRootHash32, stateHash)
+	}
+	return db.initializeChain(genesisBlock, stateEnc)
+}
 
-		if err := chainInfo.Put(mainChainHeightKey, zeroBinary); err != nil {
-			return fmt.Errorf("failed to record block height: %v", err)
-		}
+// InitializeAnchor saves a state and the block which produced it as the start of the
+// canonical chain, such as the first block of a chain exported from another database.
+// Unlike InitializeGenesis, the block may be at any slot, but it must still commit to
+// the given state. The database must be empty.
+func (db *BeaconDB) InitializeAnchor(anchorState *pb.BeaconState, anchorBlock *pb.BeaconBlock) error {
+	if anchorState == nil || anchorBlock == nil {
+		return errors.New("anchor state and block must both be provided")
+	}
+	if anchorState.Slot != anchorBlock.Slot {
+		return fmt.Errorf("anchor state slot %d does not match anchor block slot %d",
+			anchorState.Slot, anchorBlock.Slot)
+	}
+	existing, err := db.State()
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("database already has a chain at slot %d", existing.Slot)
+	}
+	stateEnc, err := proto.Marshal(anchorState)
+	if err != nil {
+		return fmt.Errorf("failed to encode anchor state: %v", err)
+	}
+	stateHash := hashutil.Hash(stateEnc)
+	if !bytes.Equal(anchorBlock.StateRootHash32, stateHash[:]) {
+		return fmt.Errorf("anchor block state root %#x does not match anchor state hash %#x",
+			anchorBlock.StateRootHash32, stateHash)
+	}
+	return db.initializeChain(anchorBlock, stateEnc)
+}
 
-		if err := mainChain.Put(zeroBinary, blockHash[:]); err != nil {
-			return fmt.Errorf("failed to record block hash: %v", err)
-		}
+// initializeChain records the block as the first block and head of the main chain, with
+// the encoded state as both the canonical state and the initial state of the chain.
+func (db *BeaconDB) initializeChain(block *pb.BeaconBlock, stateEnc []byte) error {
+	blockHash, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("failed to hash block: %v", err)
+	}
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("failed to encode block: %v", err)
+	}
 
-		if err := blockBkt.Put(blockHash[:], blockEnc); err != nil {
+	return db.update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(blockBucket).Put(blockHash[:], blockEnc); err != nil {
 			return err
 		}
+		if err := tx.Bucket(chainInfoBucket).Put(initialStateLookupKey, stateEnc); err != nil {
+			return fmt.Errorf("failed to save initial state: %v", err)
+		}
+		return putChainHead(tx, blockHash, block.Slot, stateEnc)
+	})
+}
 
-		if err := chainInfo.Put(stateLookupKey, stateEnc); err != nil {
-			return err
+// InitialState fetches the state of the first block of the main chain, which is
+// the genesis state unless the chain was initialized from an anchor. It returns nil
+// for databases created before the initial state was recorded.
+func (db *BeaconDB) InitialState() (*pb.BeaconState, error) {
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainInfoBucket).Get(initialStateLookupKey)
+		if enc == nil {
+			return nil
 		}
 
-		return nil
+		var err error
+		beaconState, err = createState(enc)
+		return err
 	})
+	return beaconState, err
 }
 
 // State fetches the canonical beacon chain's state from the DB.
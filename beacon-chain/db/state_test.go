@@ -7,8 +7,10 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -72,6 +74,49 @@ func TestInitializeState(t *testing.T) {
 	}
 }
 
+func TestInitializeGenesis(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	beaconState, err := state.InitialBeaconState(setupInitialDeposits(t), uint64(time.Now().Unix()), nil)
+	if err != nil {
+		t.Fatalf("Could not create genesis state: %v", err)
+	}
+	stateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateHash := hashutil.Hash(stateEnc)
+
+	if err := db.InitializeGenesis(beaconState, blocks.NewGenesisBlock([]byte{'a'})); err == nil {
+		t.Error("Expected error when the genesis block does not commit to the genesis state")
+	}
+	wrongSlot := blocks.NewGenesisBlock(stateHash[:])
+	wrongSlot.Slot = params.BeaconConfig().GenesisSlot + 1
+	if err := db.InitializeGenesis(beaconState, wrongSlot); err == nil {
+		t.Error("Expected error when the genesis block is not at the genesis slot")
+	}
+
+	genesisBlock := blocks.NewGenesisBlock(stateHash[:])
+	if err := db.InitializeGenesis(beaconState, genesisBlock); err != nil {
+		t.Fatalf("Could not initialize genesis: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Failed to get chain head: %v", err)
+	}
+	if !proto.Equal(head, genesisBlock) {
+		t.Errorf("Expected genesis block as chain head, received %v", head)
+	}
+	savedState, err := db.State()
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	if !proto.Equal(savedState, beaconState) {
+		t.Error("Expected saved state to equal the genesis state")
+	}
+}
+
 func TestGenesisTime(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
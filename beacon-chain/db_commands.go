@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var dbCommand = cli.Command{
+	Name:  "db",
+	Usage: "inspects, exports and imports the beacon chain database",
+	Description: `operates on the database in the data directory while the beacon node is stopped, to back up
+a chain or attach the blocks leading to a problem to a bug report`,
+	Subcommands: []cli.Command{
+		{
+			Name:   "inspect",
+			Usage:  "shows the size of every bucket, the head of the chain and the number of saved blocks, states and attestations",
+			Action: inspectDB,
+		},
+		{
+			Name:  "export",
+			Usage: "writes the canonical blocks and saved states of a slot range to a file",
+			Flags: []cli.Flag{
+				utils.ChainFileFlag,
+				utils.StartSlotFlag,
+				utils.EndSlotFlag,
+			},
+			Action: exportChain,
+		},
+		{
+			Name:  "import",
+			Usage: "replays a chain written by the export command into an empty database",
+			Description: `processes every block of the file like a block received from the network, and checks
+the resulting states against the states saved in the file`,
+			Flags: []cli.Flag{
+				utils.ChainFileFlag,
+			},
+			Action: importChain,
+		},
+	},
+}
+
+func openDB(ctx *cli.Context) (*db.BeaconDB, error) {
+	if ctx.GlobalBool(utils.DemoConfigFlag.Name) {
+		params.UseDemoBeaconConfig()
+	}
+	if configFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name); configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return nil, err
+		}
+	}
+	return db.NewDB(node.DBPath(ctx.GlobalString(cmd.DataDirFlag.Name)))
+}
+
+func inspectDB(ctx *cli.Context) error {
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	inspection, err := beaconDB.Inspect()
+	if err != nil {
+		return fmt.Errorf("could not inspect database: %v", err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Database:\t%s\n", beaconDB.DatabasePath)
+	fmt.Fprintf(w, "Head slot:\t%d\n", inspection.HeadSlot)
+	fmt.Fprintf(w, "Head root:\t%#x\n", inspection.HeadRoot)
+	fmt.Fprintf(w, "Justified slot:\t%d\n", inspection.JustifiedSlot)
+	fmt.Fprintf(w, "Finalized slot:\t%d\n", inspection.FinalizedSlot)
+	fmt.Fprintf(w, "Blocks:\t%d\n", inspection.Blocks)
+	fmt.Fprintf(w, "States:\t%d\n", inspection.States)
+	fmt.Fprintf(w, "Attestations:\t%d\n", inspection.Attestations)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "BUCKET\tKEYS\tBYTES")
+	for _, bucket := range inspection.Buckets {
+		fmt.Fprintf(w, "%s\t%d\t%d\n", bucket.Name, bucket.Keys, bucket.Size)
+	}
+	return w.Flush()
+}
+
+func exportChain(ctx *cli.Context) error {
+	file := ctx.String(utils.ChainFileFlag.Name)
+	if file == "" {
+		return errors.New("expected a path to export the chain to, use --chain-file")
+	}
+	endSlot := uint64(math.MaxUint64)
+	if ctx.IsSet(utils.EndSlotFlag.Name) {
+		endSlot = ctx.Uint64(utils.EndSlotFlag.Name)
+	}
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	summary, err := beaconDB.Export(f, ctx.Uint64(utils.StartSlotFlag.Name), endSlot)
+	if err != nil {
+		// #nosec G104
+		f.Close()
+		// #nosec G104
+		os.Remove(file)
+		return fmt.Errorf("could not export chain: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"path":     file,
+		"baseSlot": summary.BaseSlot,
+		"endSlot":  summary.EndSlot,
+		"blocks":   summary.Blocks,
+		"states":   summary.States,
+	}).Info("Exported chain")
+	return nil
+}
+
+func importChain(ctx *cli.Context) error {
+	file := ctx.String(utils.ChainFileFlag.Name)
+	if file == "" {
+		return errors.New("expected a path to import the chain from, use --chain-file")
+	}
+	// #nosec G304
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := db.NewImportReader(f)
+	if err != nil {
+		return fmt.Errorf("could not read %s: %v", file, err)
+	}
+
+	beaconDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+	existing, err := beaconDB.State()
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("database %s already has a chain, import into an empty data directory", beaconDB.DatabasePath)
+	}
+
+	baseState, err := r.Next()
+	if err != nil || baseState.State == nil {
+		return fmt.Errorf("expected the exported chain to start with a state: %v", err)
+	}
+	baseBlock, err := r.Next()
+	if err != nil || baseBlock.Block == nil {
+		return fmt.Errorf("expected the exported chain to start with a block: %v", err)
+	}
+	if baseBlock.Block.Slot == params.BeaconConfig().GenesisSlot {
+		err = beaconDB.InitializeGenesis(baseState.State, baseBlock.Block)
+	} else {
+		err = beaconDB.InitializeAnchor(baseState.State, baseBlock.Block)
+	}
+	if err != nil {
+		return fmt.Errorf("could not initialize chain at slot %d: %v", baseBlock.Block.Slot, err)
+	}
+
+	chainService, err := blockchain.NewChainService(context.Background(), &blockchain.Config{BeaconDB: beaconDB})
+	if err != nil {
+		return err
+	}
+	lastState := baseState.State
+	blocks, checkedStates := 1, 0
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read exported chain: %v", err)
+		}
+
+		if record.State != nil {
+			if !proto.Equal(record.State, lastState) {
+				return fmt.Errorf("replayed state of slot %d does not match the exported state", lastState.Slot)
+			}
+			checkedStates++
+			continue
+		}
+		beaconState, err := beaconDB.State()
+		if err != nil {
+			return err
+		}
+		computedState, err := chainService.ReceiveBlock(record.Block, beaconState)
+		if err != nil {
+			return fmt.Errorf("could not process block of slot %d: %v", record.Block.Slot, err)
+		}
+		if err := chainService.ApplyForkChoiceRule(record.Block, computedState); err != nil {
+			return fmt.Errorf("could not update chain head to slot %d: %v", record.Block.Slot, err)
+		}
+		lastState = computedState
+		blocks++
+	}
+	logrus.WithFields(logrus.Fields{
+		"path":          file,
+		"headSlot":      lastState.Slot,
+		"blocks":        blocks,
+		"checkedStates": checkedStates,
+	}).Info("Imported chain")
+	return nil
+}
@@ -0,0 +1,158 @@
+// Package genesis generates the genesis state and block of a beacon chain from a
+// set of validator deposits, so local testnets can start without an ETH1.0 chain
+// and deposit contract.
+package genesis
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// validatorKeyFile is the name of the file a validator account stores its
+	// signing key in when it is the only account of its keystore, see validator/accounts.
+	validatorKeyFile = "validatorprivatekey"
+	// shardWithdrawalKeyDir is the subdirectory of a keystore holding the shard
+	// withdrawal keys of its accounts, which are not part of the genesis state.
+	shardWithdrawalKeyDir = "shardwithdrawal"
+)
+
+// DeterministicDeposits creates a max deposit for each of the given number of
+// validators. The secret key of the validator at index i is the hash of i as
+// an 8 byte big endian integer, so devnet validators can derive their own key.
+func DeterministicDeposits(numValidators uint64) ([]*pb.Deposit, error) {
+	if numValidators == 0 {
+		return nil, errors.New("number of validators must be greater than 0")
+	}
+	keys := make([]*bls.SecretKey, numValidators)
+	for i := uint64(0); i < numValidators; i++ {
+		keys[i] = DeterministicKey(i)
+	}
+	return deposits(keys)
+}
+
+// DeterministicKey returns the secret key of the validator at the given index
+// in a genesis state created by DeterministicDeposits.
+func DeterministicKey(index uint64) *bls.SecretKey {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	seed := hashutil.Hash(enc)
+	return bls.GenerateKey(seed[:])
+}
+
+// KeystoreDeposits creates a max deposit for every validator account keystore
+// found in the given directory or any of its subdirectories, in lexical order of
+// their path. All keystores must be unlocked by the same password.
+func KeystoreDeposits(directory string, password string) ([]*pb.Deposit, error) {
+	var keys []*bls.SecretKey
+	ks := keystore.NewKeystore(directory)
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == shardWithdrawalKeyDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() != validatorKeyFile && !keystore.IsKeyFileName(info.Name()) {
+			return nil
+		}
+		key, err := ks.GetKey(path, password)
+		if err != nil {
+			return fmt.Errorf("could not decrypt keystore %s: %v", path, err)
+		}
+		keys = append(keys, key.SecretKey)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no validator keystores found in %s", directory)
+	}
+	return deposits(keys)
+}
+
+func deposits(keys []*bls.SecretKey) ([]*pb.Deposit, error) {
+	deposits := make([]*pb.Deposit, len(keys))
+	for i, key := range keys {
+		depositInput := &pb.DepositInput{
+			Pubkey:                      key.K.Bytes(), // TODO(#1367): Use real BLS public key here.
+			ProofOfPossession:           []byte("pop"),
+			WithdrawalCredentialsHash32: []byte("withdraw"),
+		}
+		depositData, err := b.EncodeDepositData(
+			depositInput,
+			params.BeaconConfig().MaxDeposit,
+			params.BeaconConfig().GenesisTime.Unix(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode deposit data: %v", err)
+		}
+		deposits[i] = &pb.Deposit{
+			MerkleTreeIndex: uint64(i),
+			DepositData:     depositData,
+		}
+	}
+	return deposits, nil
+}
+
+// NewGenesis creates the genesis state of the given deposits and the genesis block
+// committing to it, the same way the beacon node does on ChainStart.
+func NewGenesis(deposits []*pb.Deposit, genesisTime uint64) (*pb.Genesis, error) {
+	beaconState, err := state.InitialBeaconState(deposits, genesisTime, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create genesis state: %v", err)
+	}
+	stateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode genesis state: %v", err)
+	}
+	stateHash := hashutil.Hash(stateEnc)
+	return &pb.Genesis{
+		State: beaconState,
+		Block: b.NewGenesisBlock(stateHash[:]),
+	}, nil
+}
+
+// WriteGenesisFile writes the genesis state and block to a file which beacon
+// nodes load with the --genesis-file flag.
+func WriteGenesisFile(path string, genesis *pb.Genesis) error {
+	enc, err := proto.Marshal(genesis)
+	if err != nil {
+		return fmt.Errorf("could not encode genesis: %v", err)
+	}
+	return ioutil.WriteFile(path, enc, 0644)
+}
+
+// ReadGenesisFile reads a genesis state and block written by WriteGenesisFile.
+func ReadGenesisFile(path string) (*pb.Genesis, error) {
+	// #nosec G304
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	genesis := &pb.Genesis{}
+	if err := proto.Unmarshal(enc, genesis); err != nil {
+		return nil, fmt.Errorf("could not decode genesis file %s: %v", path, err)
+	}
+	if genesis.State == nil || genesis.Block == nil {
+		return nil, fmt.Errorf("genesis file %s is missing the genesis state or block", path)
+	}
+	return genesis, nil
+}
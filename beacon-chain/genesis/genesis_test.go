@@ -0,0 +1,151 @@
+package genesis
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestDeterministicDeposits(t *testing.T) {
+	if _, err := DeterministicDeposits(0); err == nil {
+		t.Error("Expected error when creating deposits for 0 validators")
+	}
+	deposits, err := DeterministicDeposits(8)
+	if err != nil {
+		t.Fatalf("Could not create deposits: %v", err)
+	}
+	if len(deposits) != 8 {
+		t.Fatalf("Expected 8 deposits, received %d", len(deposits))
+	}
+	for i, deposit := range deposits {
+		depositInput, err := blocks.DecodeDepositInput(deposit.DepositData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(depositInput.Pubkey, DeterministicKey(uint64(i)).K.Bytes()) {
+			t.Errorf("Expected deposit %d to be made by the deterministic key of index %d", i, i)
+		}
+		amount, _, err := blocks.DecodeDepositAmountAndTimeStamp(deposit.DepositData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if amount != params.BeaconConfig().MaxDeposit {
+			t.Errorf("Expected a max deposit, received %d", amount)
+		}
+	}
+}
+
+func TestKeystoreDeposits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := KeystoreDeposits(dir, "password"); err == nil {
+		t.Error("Expected error when there are no keystores")
+	}
+	ks := keystore.NewKeystore(dir)
+	var pubKeys [][]byte
+	for _, name := range []string{"validator-0", "validator-1"} {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ks.StoreKey(filepath.Join(dir, name, validatorKeyFile), key, "password"); err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, key.SecretKey.K.Bytes())
+	}
+	// Keystores can hold several accounts, named after their key.
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	multiKs := keystore.NewKeystore(filepath.Join(dir, "validator-2"))
+	if err := multiKs.StoreKey(multiKs.KeyFilePath(key), key, "password"); err != nil {
+		t.Fatal(err)
+	}
+	pubKeys = append(pubKeys, key.SecretKey.K.Bytes())
+	// Other keys of the validator accounts are not part of the genesis state.
+	if err := ioutil.WriteFile(filepath.Join(dir, "validator-0", "shardwithdrawalkey"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "validator-2", shardWithdrawalKeyDir), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "validator-2", shardWithdrawalKeyDir, "UTC--withdrawal"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := KeystoreDeposits(dir, "wrong"); err == nil {
+		t.Error("Expected error when decrypting keystores with the wrong password")
+	}
+	deposits, err := KeystoreDeposits(dir, "password")
+	if err != nil {
+		t.Fatalf("Could not create deposits: %v", err)
+	}
+	if len(deposits) != len(pubKeys) {
+		t.Fatalf("Expected %d deposits, received %d", len(pubKeys), len(deposits))
+	}
+	for i, deposit := range deposits {
+		depositInput, err := blocks.DecodeDepositInput(deposit.DepositData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(depositInput.Pubkey, pubKeys[i]) {
+			t.Errorf("Expected deposit %d to be made by keystore %d", i, i)
+		}
+	}
+}
+
+func TestGenesisFile_RoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	deposits, err := DeterministicDeposits(params.BeaconConfig().EpochLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := NewGenesis(deposits, 1000)
+	if err != nil {
+		t.Fatalf("Could not create genesis: %v", err)
+	}
+	if genesis.State.GenesisTime != 1000 || len(genesis.State.ValidatorRegistry) != len(deposits) {
+		t.Errorf("Unexpected genesis state, genesis time %d with %d validators",
+			genesis.State.GenesisTime, len(genesis.State.ValidatorRegistry))
+	}
+	if genesis.Block.Slot != params.BeaconConfig().GenesisSlot {
+		t.Errorf("Expected genesis block at slot %d, received %d", params.BeaconConfig().GenesisSlot, genesis.Block.Slot)
+	}
+
+	path := filepath.Join(dir, "genesis.bin")
+	if err := WriteGenesisFile(path, genesis); err != nil {
+		t.Fatalf("Could not write genesis file: %v", err)
+	}
+	read, err := ReadGenesisFile(path)
+	if err != nil {
+		t.Fatalf("Could not read genesis file: %v", err)
+	}
+	if !proto.Equal(read, genesis) {
+		t.Error("Expected genesis read from file to equal the written genesis")
+	}
+
+	if err := ioutil.WriteFile(path, []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadGenesisFile(path); err == nil {
+		t.Error("Expected error when reading an empty genesis file")
+	}
+}
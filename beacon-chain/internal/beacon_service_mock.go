@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_StreamChainEventsServer,BeaconService_WaitForChainStartServer)
 
 package internal
 
@@ -50,6 +50,32 @@ func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
 }
 
+// ChainConfig mocks base method
+func (m *MockBeaconServiceServer) ChainConfig(arg0 context.Context, arg1 *types.Empty) (*v10.ChainConfigResponse, error) {
+	ret := m.ctrl.Call(m, "ChainConfig", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ChainConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainConfig indicates an expected call of ChainConfig
+func (mr *MockBeaconServiceServerMockRecorder) ChainConfig(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainConfig", reflect.TypeOf((*MockBeaconServiceServer)(nil).ChainConfig), arg0, arg1)
+}
+
+// Eth1Data mocks base method
+func (m *MockBeaconServiceServer) Eth1Data(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1DataResponse, error) {
+	ret := m.ctrl.Call(m, "Eth1Data", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1DataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1Data indicates an expected call of Eth1Data
+func (mr *MockBeaconServiceServerMockRecorder) Eth1Data(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1Data), arg0, arg1)
+}
+
 // LatestAttestation mocks base method
 func (m *MockBeaconServiceServer) LatestAttestation(arg0 *types.Empty, arg1 v10.BeaconService_LatestAttestationServer) error {
 	ret := m.ctrl.Call(m, "LatestAttestation", arg0, arg1)
@@ -62,6 +88,31 @@ func (mr *MockBeaconServiceServerMockRecorder) LatestAttestation(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAttestation", reflect.TypeOf((*MockBeaconServiceServer)(nil).LatestAttestation), arg0, arg1)
 }
 
+// PendingDeposits mocks base method
+func (m *MockBeaconServiceServer) PendingDeposits(arg0 context.Context, arg1 *types.Empty) (*v10.PendingDepositsResponse, error) {
+	ret := m.ctrl.Call(m, "PendingDeposits", arg0, arg1)
+	ret0, _ := ret[0].(*v10.PendingDepositsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDeposits indicates an expected call of PendingDeposits
+func (mr *MockBeaconServiceServerMockRecorder) PendingDeposits(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDeposits), arg0, arg1)
+}
+
+// StreamChainEvents mocks base method
+func (m *MockBeaconServiceServer) StreamChainEvents(arg0 *v10.ChainEventsRequest, arg1 v10.BeaconService_StreamChainEventsServer) error {
+	ret := m.ctrl.Call(m, "StreamChainEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamChainEvents indicates an expected call of StreamChainEvents
+func (mr *MockBeaconServiceServerMockRecorder) StreamChainEvents(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChainEvents", reflect.TypeOf((*MockBeaconServiceServer)(nil).StreamChainEvents), arg0, arg1)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v10.BeaconService_WaitForChainStartServer) error {
 	ret := m.ctrl.Call(m, "WaitForChainStart", arg0, arg1)
@@ -179,6 +230,111 @@ func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SetTrailer(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SetTrailer), arg0)
 }
 
+// MockBeaconService_StreamChainEventsServer is a mock of BeaconService_StreamChainEventsServer interface
+type MockBeaconService_StreamChainEventsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_StreamChainEventsServerMockRecorder
+}
+
+// MockBeaconService_StreamChainEventsServerMockRecorder is the mock recorder for MockBeaconService_StreamChainEventsServer
+type MockBeaconService_StreamChainEventsServerMockRecorder struct {
+	mock *MockBeaconService_StreamChainEventsServer
+}
+
+// NewMockBeaconService_StreamChainEventsServer creates a new mock instance
+func NewMockBeaconService_StreamChainEventsServer(ctrl *gomock.Controller) *MockBeaconService_StreamChainEventsServer {
+	mock := &MockBeaconService_StreamChainEventsServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_StreamChainEventsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_StreamChainEventsServer) EXPECT() *MockBeaconService_StreamChainEventsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) Context() context.Context {
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) Context() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) RecvMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) Send(arg0 *v10.ChainEvent) error {
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) SendHeader(arg0 metadata.MD) error {
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) SendMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) SetHeader(arg0 metadata.MD) error {
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconService_StreamChainEventsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconService_StreamChainEventsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_StreamChainEventsServer)(nil).SetTrailer), arg0)
+}
+
 // MockBeaconService_WaitForChainStartServer is a mock of BeaconService_WaitForChainStartServer interface
 type MockBeaconService_WaitForChainStartServer struct {
 	ctrl     *gomock.Controller
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient,ValidatorService_ValidatorStatusServer)
 
 package internal
 
@@ -10,6 +10,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockValidatorServiceClient is a mock of ValidatorServiceClient interface
@@ -70,3 +71,126 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorIndex(arg0, arg1 inte
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIndex", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorIndex), varargs...)
 }
+
+// ValidatorStatus mocks base method
+func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorStatusRequest, arg2 ...grpc.CallOption) (v1.ValidatorService_ValidatorStatusClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorStatus", varargs...)
+	ret0, _ := ret[0].(v1.ValidatorService_ValidatorStatusClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorStatus indicates an expected call of ValidatorStatus
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorStatus", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorStatus), varargs...)
+}
+
+// MockValidatorService_ValidatorStatusServer is a mock of ValidatorService_ValidatorStatusServer interface
+type MockValidatorService_ValidatorStatusServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorService_ValidatorStatusServerMockRecorder
+}
+
+// MockValidatorService_ValidatorStatusServerMockRecorder is the mock recorder for MockValidatorService_ValidatorStatusServer
+type MockValidatorService_ValidatorStatusServerMockRecorder struct {
+	mock *MockValidatorService_ValidatorStatusServer
+}
+
+// NewMockValidatorService_ValidatorStatusServer creates a new mock instance
+func NewMockValidatorService_ValidatorStatusServer(ctrl *gomock.Controller) *MockValidatorService_ValidatorStatusServer {
+	mock := &MockValidatorService_ValidatorStatusServer{ctrl: ctrl}
+	mock.recorder = &MockValidatorService_ValidatorStatusServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidatorService_ValidatorStatusServer) EXPECT() *MockValidatorService_ValidatorStatusServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) Context() context.Context {
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) Context() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) RecvMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) Send(arg0 *v1.ValidatorStatusResponse) error {
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) SendHeader(arg0 metadata.MD) error {
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) SendMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) SetHeader(arg0 metadata.MD) error {
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockValidatorService_ValidatorStatusServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockValidatorService_ValidatorStatusServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockValidatorService_ValidatorStatusServer)(nil).SetTrailer), arg0)
+}
@@ -2,13 +2,19 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"runtime"
+	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -31,6 +37,57 @@ func startNode(ctx *cli.Context) error {
 	return nil
 }
 
+func generateGenesis(ctx *cli.Context) error {
+	if ctx.GlobalBool(utils.DemoConfigFlag.Name) {
+		params.UseDemoBeaconConfig()
+	}
+	if configFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name); configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return err
+		}
+	}
+	outputFile := ctx.String(utils.GenesisFile.Name)
+	if outputFile == "" {
+		return errors.New("expected a path to write the genesis file to, use --genesis-file")
+	}
+
+	var deposits []*pb.Deposit
+	var err error
+	numValidators := ctx.Uint64(utils.NumValidatorsFlag.Name)
+	keystoreDirectory := ctx.String(cmd.KeystoreDirectoryFlag.Name)
+	switch {
+	case numValidators > 0 && keystoreDirectory != "":
+		return errors.New("expected either a number of validators or a keystore directory, received both")
+	case numValidators > 0:
+		deposits, err = genesis.DeterministicDeposits(numValidators)
+	case keystoreDirectory != "":
+		deposits, err = genesis.KeystoreDeposits(keystoreDirectory, ctx.String(cmd.KeystorePasswordFlag.Name))
+	default:
+		return errors.New("expected a number of validators or a keystore directory to create the genesis state from")
+	}
+	if err != nil {
+		return fmt.Errorf("could not create genesis deposits: %v", err)
+	}
+
+	genesisTime := ctx.Int64(utils.GenesisTimeFlag.Name)
+	if genesisTime == 0 {
+		genesisTime = time.Now().Unix()
+	}
+	genesisState, err := genesis.NewGenesis(deposits, uint64(genesisTime))
+	if err != nil {
+		return err
+	}
+	if err := genesis.WriteGenesisFile(outputFile, genesisState); err != nil {
+		return fmt.Errorf("could not write genesis file: %v", err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"path":        outputFile,
+		"validators":  len(deposits),
+		"genesisTime": time.Unix(genesisTime, 0),
+	}).Info("Genesis state generated, start beacon nodes with --genesis-file to use it")
+	return nil
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -61,6 +118,25 @@ VERSION:
 	app.Action = startNode
 	app.Version = version.GetVersion()
 
+	app.Commands = []cli.Command{
+		{
+			Name:  "genesis",
+			Usage: "generates the genesis state and block of a local testnet",
+			Description: `creates a genesis state from deposits of a number of validators with deterministic keys,
+or of every validator account keystore in a directory, and writes it with its genesis block to a file
+which beacon nodes started with --genesis-file use instead of waiting for ChainStart from the deposit contract`,
+			Flags: []cli.Flag{
+				utils.GenesisFile,
+				utils.NumValidatorsFlag,
+				utils.GenesisTimeFlag,
+				cmd.KeystoreDirectoryFlag,
+				cmd.KeystorePasswordFlag,
+			},
+			Action: generateGenesis,
+		},
+		dbCommand,
+	}
+
 	app.Flags = []cli.Flag{
 		utils.DemoConfigFlag,
 		utils.VrcContractFlag,
@@ -69,9 +145,15 @@ VERSION:
 		utils.RPCPort,
 		utils.CertFlag,
 		utils.KeyFlag,
+		utils.GatewayPort,
+		utils.GatewayEnableWrites,
 		utils.GenesisJSON,
+		utils.GenesisFile,
 		utils.EnablePOWChain,
 		utils.EnableDBCleanup,
+		utils.EnableSlasher,
+		utils.SlasherHistoryEpochs,
+		utils.EnableRewardReports,
 		cmd.BootstrapNode,
 		cmd.RelayNode,
 		cmd.P2PPort,
@@ -82,6 +164,7 @@ VERSION:
 		cmd.TraceSampleFractionFlag,
 		cmd.MonitoringPortFlag,
 		cmd.DisableMonitoringFlag,
+		cmd.ChainConfigFileFlag,
 		cmd.KeystorePasswordFlag,
 		cmd.KeystoreDirectoryFlag,
 		debug.PProfFlag,
@@ -90,6 +173,8 @@ VERSION:
 		debug.MemProfileRateFlag,
 		debug.CPUProfileFlag,
 		debug.TraceFlag,
+		debug.DebugAPIFlag,
+		debug.DebugAPITokenFileFlag,
 	}
 
 	app.Before = func(ctx *cli.Context) error {
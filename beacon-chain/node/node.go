@@ -16,9 +16,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/dbcleanup"
+	"github.com/prysmaticlabs/prysm/beacon-chain/genesis"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -62,6 +64,14 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		params.UseDemoBeaconConfig()
 	}
 
+	// Values from a chain config file take precedence over the demo config.
+	if configFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name); configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return nil, err
+		}
+		log.WithField("file", configFile).Info("Using chain config file")
+	}
+
 	if err := beacon.startDB(ctx); err != nil {
 		return nil, err
 	}
@@ -86,6 +96,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerSlasherService(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerSyncService(); err != nil {
 		return nil, err
 	}
@@ -143,23 +157,61 @@ func (b *BeaconNode) Close() {
 	defer b.lock.Unlock()
 
 	log.Info("Stopping beacon node")
-	b.services.StopAll()
-	if err := b.db.Close(); err != nil {
+	// A service which has not stopped may still be writing to the database, so
+	// the database is left for the process exit to release.
+	if err := b.services.StopAll(); err != nil {
+		log.Errorf("Not closing database as services are still running: %v", err)
+	} else if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
 	close(b.stop)
 }
 
+// DBPath returns the directory of the beacon chain database in the data directory.
+func DBPath(dataDir string) string {
+	return path.Join(dataDir, beaconChainDBName)
+}
+
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 
-	db, err := db.NewDB(path.Join(baseDir, beaconChainDBName))
+	db, err := db.NewDB(DBPath(baseDir))
 	if err != nil {
 		return err
 	}
 
 	log.Info("checking db")
 	b.db = db
+
+	if genesisFile := ctx.GlobalString(utils.GenesisFile.Name); genesisFile != "" {
+		return b.loadGenesisFile(genesisFile)
+	}
+	return nil
+}
+
+// loadGenesisFile initializes an empty database with the genesis state and block
+// written by the genesis command, a database which already contains a chain is
+// left untouched.
+func (b *BeaconNode) loadGenesisFile(path string) error {
+	beaconState, err := b.db.State()
+	if err != nil {
+		return fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if beaconState != nil {
+		log.WithField("file", path).Warn("Beacon chain data already exists, ignoring genesis file")
+		return nil
+	}
+	genesisData, err := genesis.ReadGenesisFile(path)
+	if err != nil {
+		return err
+	}
+	if err := b.db.InitializeGenesis(genesisData.State, genesisData.Block); err != nil {
+		return fmt.Errorf("could not initialize genesis from %s: %v", path, err)
+	}
+	log.WithFields(logrus.Fields{
+		"file":       path,
+		"validators": len(genesisData.State.ValidatorRegistry),
+	}).Info("Initialized beacon chain from genesis file")
 	return nil
 }
 
@@ -182,15 +234,16 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 	}
 
 	blockchainService, err := blockchain.NewChainService(context.TODO(), &blockchain.Config{
-		BeaconDB:         b.db,
-		Web3Service:      web3Service,
-		BeaconBlockBuf:   10,
-		IncomingBlockBuf: 100, // Big buffer to accommodate other feed subscribers.
+		BeaconDB:            b.db,
+		Web3Service:         web3Service,
+		BeaconBlockBuf:      10,
+		IncomingBlockBuf:    100, // Big buffer to accommodate other feed subscribers.
+		EnableRewardReports: ctx.GlobalBool(utils.EnableRewardReports.Name),
 	})
 	if err != nil {
 		return fmt.Errorf("could not register blockchain service: %v", err)
 	}
-	return b.services.RegisterService(blockchainService)
+	return b.services.RegisterService(blockchainService, web3Service)
 }
 
 func (b *BeaconNode) registerDBCleanService(ctx *cli.Context) error {
@@ -209,15 +262,58 @@ func (b *BeaconNode) registerDBCleanService(ctx *cli.Context) error {
 		ChainService:    chainService,
 	})
 
-	return b.services.RegisterService(dbCleanService)
+	return b.services.RegisterService(dbCleanService, chainService)
 }
 
 func (b *BeaconNode) registerOperationService() error {
+	var chainService *blockchain.ChainService
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
 	operationService := operations.NewOperationService(context.TODO(), &operations.Config{
-		BeaconDB: b.db,
+		BeaconDB:           b.db,
+		ChainService:       chainService,
+		ReceiveExitBuf:     100,
+		ReceiveAttBuf:      100,
+		ReceiveSlashingBuf: 100,
+		ReceiveBlockBuf:    100,
 	})
 
-	return b.services.RegisterService(operationService)
+	return b.services.RegisterService(operationService, chainService)
+}
+
+func (b *BeaconNode) registerSlasherService(ctx *cli.Context) error {
+	if !ctx.GlobalBool(utils.EnableSlasher.Name) {
+		return nil
+	}
+
+	var chainService *blockchain.ChainService
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var operationService *operations.Service
+	if err := b.services.FetchService(&operationService); err != nil {
+		return err
+	}
+
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	slasherService := slasher.NewSlasherService(context.TODO(), &slasher.Config{
+		BeaconDB:         b.db,
+		ChainService:     chainService,
+		OperationService: operationService,
+		P2P:              p2pService,
+		ReceiveBlockBuf:  100,
+		ReceiveAttBuf:    100,
+		HistoryEpochs:    ctx.GlobalUint64(utils.SlasherHistoryEpochs.Name),
+	})
+
+	return b.services.RegisterService(slasherService, chainService, operationService, p2pService)
 }
 
 func (b *BeaconNode) registerPOWChainService(ctx *cli.Context) error {
@@ -270,7 +366,7 @@ func (b *BeaconNode) registerSyncService() error {
 	}
 
 	syncService := rbcsync.NewSyncService(context.Background(), cfg)
-	return b.services.RegisterService(syncService)
+	return b.services.RegisterService(syncService, chainService, p2pService, operationService)
 }
 
 func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
@@ -284,6 +380,11 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
 	var web3Service *powchain.Web3Service
 	var enablePOWChain = ctx.GlobalBool(utils.EnablePOWChain.Name)
 	if enablePOWChain {
@@ -295,18 +396,27 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
-	rpcService := rpc.NewRPCService(context.TODO(), &rpc.Config{
-		Port:             port,
-		CertFlag:         cert,
-		KeyFlag:          key,
-		SubscriptionBuf:  100,
-		BeaconDB:         b.db,
-		ChainService:     chainService,
-		OperationService: operationService,
-		POWChainService:  web3Service,
-	})
+	gatewayPort := ctx.GlobalString(utils.GatewayPort.Name)
+	cfg := &rpc.Config{
+		Port:                port,
+		CertFlag:            cert,
+		KeyFlag:             key,
+		GatewayPort:         gatewayPort,
+		GatewayEnableWrites: ctx.GlobalBool(utils.GatewayEnableWrites.Name),
+		SubscriptionBuf:     100,
+		BeaconDB:            b.db,
+		ChainService:        chainService,
+		OperationService:    operationService,
+		P2P:                 p2pService,
+	}
+	// Only set the proof-of-work chain when it runs, a nil *powchain.Web3Service
+	// stored in the interface would not compare equal to nil.
+	if enablePOWChain {
+		cfg.POWChainService = web3Service
+	}
+	rpcService := rpc.NewRPCService(context.TODO(), cfg)
 
-	return b.services.RegisterService(rpcService)
+	return b.services.RegisterService(rpcService, chainService, operationService, p2pService, web3Service)
 }
 
 func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
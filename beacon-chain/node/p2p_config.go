@@ -22,6 +22,9 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_BEACON_STATE_HASH_ANNOUNCE:          &pb.BeaconStateHashAnnounce{},
 	pb.Topic_BEACON_STATE_REQUEST:                &pb.BeaconStateRequest{},
 	pb.Topic_BEACON_STATE_RESPONSE:               &pb.BeaconStateResponse{},
+	pb.Topic_PROPOSER_SLASHING_RESPONSE:          &pb.ProposerSlashingResponse{},
+	pb.Topic_ATTESTER_SLASHING_RESPONSE:          &pb.AttesterSlashingResponse{},
+	pb.Topic_EXIT_REQUEST:                        &pb.Exit{},
 }
 
 func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
@@ -0,0 +1,178 @@
+package operations
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// attestationPool aggregates the received attestations. Attestations are grouped by
+// the root of their attestation data, and every group holds aggregates of attestations
+// whose aggregation bitfields do not overlap. The pool holds at most maxSize aggregates.
+type attestationPool struct {
+	lock       sync.RWMutex
+	aggregates map[[32]byte][]*pb.Attestation
+	size       int
+	maxSize    int
+}
+
+func newAttestationPool(maxSize int) *attestationPool {
+	return &attestationPool{
+		aggregates: make(map[[32]byte][]*pb.Attestation),
+		maxSize:    maxSize,
+	}
+}
+
+// add merges the attestation into the first aggregate of the same attestation data
+// it does not overlap with, or starts a new aggregate if it overlaps with all of them.
+// Attestations from attesters who are all part of an aggregate already are ignored,
+// in which case add returns false. Starting a new aggregate fails if the pool is full.
+func (p *attestationPool) add(attestation *pb.Attestation) (bool, error) {
+	if attestation.Data == nil {
+		return false, errors.New("attestation has no attestation data")
+	}
+	root, err := hashutil.HashProto(attestation.Data)
+	if err != nil {
+		return false, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	aggregates := p.aggregates[root]
+	for _, aggregate := range aggregates {
+		if containsBits(aggregate.AggregationBitfield, attestation.AggregationBitfield) {
+			return false, nil
+		}
+	}
+	for i, aggregate := range aggregates {
+		if !bitutil.BitfieldsOverlap(aggregate.AggregationBitfield, attestation.AggregationBitfield) {
+			aggregates[i] = mergeAttestations(aggregate, attestation)
+			return true, nil
+		}
+	}
+	if p.size >= p.maxSize {
+		return false, fmt.Errorf("attestation pool is full with %d aggregates", p.size)
+	}
+	p.aggregates[root] = append(aggregates, proto.Clone(attestation).(*pb.Attestation))
+	p.size++
+	return true, nil
+}
+
+// bestAggregates returns the aggregate with the most attesters of every slot and shard
+// which can be included in a block at the given slot. The aggregates closest to
+// expiring come first.
+func (p *attestationPool) bestAggregates(slot uint64) []*pb.Attestation {
+	type slotShard struct {
+		slot  uint64
+		shard uint64
+	}
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	best := make(map[slotShard]*pb.Attestation)
+	for _, aggregates := range p.aggregates {
+		for _, aggregate := range aggregates {
+			if !includable(aggregate.Data, slot) {
+				continue
+			}
+			key := slotShard{slot: aggregate.Data.Slot, shard: aggregate.Data.Shard}
+			current, ok := best[key]
+			if !ok || bitutil.BitSetCount(aggregate.AggregationBitfield) > bitutil.BitSetCount(current.AggregationBitfield) {
+				best[key] = aggregate
+			}
+		}
+	}
+
+	attestations := make([]*pb.Attestation, 0, len(best))
+	for _, aggregate := range best {
+		attestations = append(attestations, aggregate)
+	}
+	sort.Slice(attestations, func(i, j int) bool {
+		if attestations[i].Data.Slot != attestations[j].Data.Slot {
+			return attestations[i].Data.Slot < attestations[j].Data.Slot
+		}
+		return attestations[i].Data.Shard < attestations[j].Data.Shard
+	})
+	return attestations
+}
+
+// removeIncluded removes the aggregates whose attesters are all part of an attestation
+// of the same attestation data included in a block.
+func (p *attestationPool) removeIncluded(attestation *pb.Attestation) error {
+	if attestation.Data == nil {
+		return errors.New("attestation has no attestation data")
+	}
+	root, err := hashutil.HashProto(attestation.Data)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var remaining []*pb.Attestation
+	for _, aggregate := range p.aggregates[root] {
+		if !containsBits(attestation.AggregationBitfield, aggregate.AggregationBitfield) {
+			remaining = append(remaining, aggregate)
+		}
+	}
+	p.size -= len(p.aggregates[root]) - len(remaining)
+	if len(remaining) == 0 {
+		delete(p.aggregates, root)
+		return nil
+	}
+	p.aggregates[root] = remaining
+	return nil
+}
+
+// prune removes the aggregates which can no longer be included in a block at the
+// given slot or any later one.
+func (p *attestationPool) prune(slot uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for root, aggregates := range p.aggregates {
+		if aggregates[0].Data.Slot+params.BeaconConfig().EpochLength < slot {
+			delete(p.aggregates, root)
+			p.size -= len(aggregates)
+		}
+	}
+}
+
+// includable reports whether an attestation with the given data can be included in
+// a block at the slot, at least MinAttestationInclusionDelay slots after the attested
+// slot and at most an epoch after it.
+func includable(data *pb.AttestationData, slot uint64) bool {
+	return data.Slot+params.BeaconConfig().MinAttestationInclusionDelay <= slot &&
+		slot <= data.Slot+params.BeaconConfig().EpochLength
+}
+
+// containsBits reports whether every bit set in the bitfield is set in the aggregate.
+func containsBits(aggregate []byte, bitfield []byte) bool {
+	for i, b := range bitfield {
+		if i >= len(aggregate) {
+			if b != 0 {
+				return false
+			}
+			continue
+		}
+		if b&^aggregate[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeAttestations(aggregate *pb.Attestation, attestation *pb.Attestation) *pb.Attestation {
+	return &pb.Attestation{
+		Data:                aggregate.Data,
+		AggregationBitfield: bitutil.MergeBitfields(aggregate.AggregationBitfield, attestation.AggregationBitfield),
+		CustodyBitfield:     bitutil.MergeBitfields(aggregate.CustodyBitfield, attestation.CustodyBitfield),
+		// TODO(#1366): Use BLS to aggregate the signatures of both attestations.
+		AggregateSignature: aggregate.AggregateSignature,
+	}
+}
@@ -0,0 +1,174 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestAttestationPool_AggregatesNonOverlappingAttestations(t *testing.T) {
+	pool := newAttestationPool(maxPooledAttestations)
+	data := &pb.AttestationData{Slot: 5, Shard: 1}
+	tests := []struct {
+		bitfield   []byte
+		aggregated bool
+		aggregates [][]byte
+	}{
+		{bitfield: []byte{128}, aggregated: true, aggregates: [][]byte{{128}}},
+		{bitfield: []byte{64}, aggregated: true, aggregates: [][]byte{{192}}},
+		// Attesters already part of an aggregate.
+		{bitfield: []byte{128}, aggregated: false, aggregates: [][]byte{{192}}},
+		{bitfield: []byte{192}, aggregated: false, aggregates: [][]byte{{192}}},
+		// Overlapping attestations start a new aggregate.
+		{bitfield: []byte{160}, aggregated: true, aggregates: [][]byte{{192}, {160}}},
+		{bitfield: []byte{16}, aggregated: true, aggregates: [][]byte{{208}, {160}}},
+		{bitfield: []byte{65}, aggregated: true, aggregates: [][]byte{{208}, {225}}},
+	}
+	for i, tt := range tests {
+		aggregated, err := pool.add(&pb.Attestation{Data: data, AggregationBitfield: tt.bitfield, CustodyBitfield: tt.bitfield})
+		if err != nil {
+			t.Fatalf("Could not add attestation %d: %v", i, err)
+		}
+		if aggregated != tt.aggregated {
+			t.Errorf("Expected attestation %d aggregated to be %v, received %v", i, tt.aggregated, aggregated)
+		}
+		var aggregates [][]byte
+		for _, group := range pool.aggregates {
+			for _, aggregate := range group {
+				if !bytes.Equal(aggregate.AggregationBitfield, aggregate.CustodyBitfield) {
+					t.Errorf("Expected custody bitfield %08b to be merged as %08b",
+						aggregate.CustodyBitfield, aggregate.AggregationBitfield)
+				}
+				aggregates = append(aggregates, aggregate.AggregationBitfield)
+			}
+		}
+		if len(aggregates) != len(tt.aggregates) {
+			t.Fatalf("Expected aggregates %08b after attestation %d, received %08b", tt.aggregates, i, aggregates)
+		}
+		for j := range aggregates {
+			if !bytes.Equal(aggregates[j], tt.aggregates[j]) {
+				t.Errorf("Expected aggregates %08b after attestation %d, received %08b", tt.aggregates, i, aggregates)
+			}
+		}
+	}
+
+	if _, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: 5, Shard: 2}, AggregationBitfield: []byte{128}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(pool.aggregates) != 2 {
+		t.Errorf("Expected attestations of different data to be aggregated separately, received %d groups", len(pool.aggregates))
+	}
+	if _, err := pool.add(&pb.Attestation{}); err == nil {
+		t.Error("Expected error when adding an attestation without data")
+	}
+}
+
+func TestAttestationPool_BestAggregates(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	c := params.BeaconConfig()
+	c.EpochLength = 8
+	c.MinAttestationInclusionDelay = 2
+	c.MaxAttestations = 3
+	params.OverrideBeaconConfig(c)
+
+	pool := newAttestationPool(maxPooledAttestations)
+	attestations := []*pb.Attestation{
+		// Competing aggregates of slot 10 shard 0, the one with most attesters wins.
+		{Data: &pb.AttestationData{Slot: 10, Shard: 0, ShardBlockRootHash32: []byte{'a'}}, AggregationBitfield: []byte{128}},
+		{Data: &pb.AttestationData{Slot: 10, Shard: 0, ShardBlockRootHash32: []byte{'b'}}, AggregationBitfield: []byte{96}},
+		{Data: &pb.AttestationData{Slot: 10, Shard: 0, ShardBlockRootHash32: []byte{'a'}}, AggregationBitfield: []byte{1}},
+		{Data: &pb.AttestationData{Slot: 10, Shard: 0, ShardBlockRootHash32: []byte{'a'}}, AggregationBitfield: []byte{2}},
+		{Data: &pb.AttestationData{Slot: 10, Shard: 1}, AggregationBitfield: []byte{128}},
+		{Data: &pb.AttestationData{Slot: 9, Shard: 2}, AggregationBitfield: []byte{128}},
+		{Data: &pb.AttestationData{Slot: 9, Shard: 1}, AggregationBitfield: []byte{128}},
+		// Too new to be included at slot 12.
+		{Data: &pb.AttestationData{Slot: 11, Shard: 2}, AggregationBitfield: []byte{128}},
+		// Too old to be included at slot 12.
+		{Data: &pb.AttestationData{Slot: 3, Shard: 2}, AggregationBitfield: []byte{128}},
+	}
+	for _, attestation := range attestations {
+		if _, err := pool.add(attestation); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewOperationService(context.Background(), &Config{})
+	service.attestationPool = pool
+	best := service.AggregatedAttestations(12)
+	want := []struct {
+		slot     uint64
+		shard    uint64
+		bitfield []byte
+	}{
+		{slot: 9, shard: 1, bitfield: []byte{128}},
+		{slot: 9, shard: 2, bitfield: []byte{128}},
+		{slot: 10, shard: 0, bitfield: []byte{131}},
+	}
+	if len(best) != len(want) {
+		t.Fatalf("Expected %d aggregates, received %d", len(want), len(best))
+	}
+	for i := range want {
+		if best[i].Data.Slot != want[i].slot || best[i].Data.Shard != want[i].shard ||
+			!bytes.Equal(best[i].AggregationBitfield, want[i].bitfield) {
+			t.Errorf("Expected aggregate %d of slot %d shard %d with bitfield %08b, received slot %d shard %d with bitfield %08b",
+				i, want[i].slot, want[i].shard, want[i].bitfield,
+				best[i].Data.Slot, best[i].Data.Shard, best[i].AggregationBitfield)
+		}
+	}
+
+	c.MaxAttestations = 128
+	params.OverrideBeaconConfig(c)
+	if best := pool.bestAggregates(12); len(best) != 4 {
+		t.Errorf("Expected 4 aggregates includable at slot 12, received %d", len(best))
+	}
+}
+
+func TestAttestationPool_Prune(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	c := params.BeaconConfig()
+	c.EpochLength = 8
+	params.OverrideBeaconConfig(c)
+
+	pool := newAttestationPool(maxPooledAttestations)
+	for _, slot := range []uint64{2, 3, 4} {
+		if _, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: slot}, AggregationBitfield: []byte{1}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pool.prune(11)
+	if len(pool.aggregates) != 2 {
+		t.Fatalf("Expected the attestations of slot 3 and 4 to be kept, received %d", len(pool.aggregates))
+	}
+	for _, aggregates := range pool.aggregates {
+		if aggregates[0].Data.Slot == 2 {
+			t.Error("Expected the attestation of slot 2 to be pruned")
+		}
+	}
+}
+
+func TestAttestationPool_RejectsAggregatesWhenFull(t *testing.T) {
+	pool := newAttestationPool(2)
+	for _, slot := range []uint64{2, 3} {
+		if _, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: slot}, AggregationBitfield: []byte{1}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: 4}, AggregationBitfield: []byte{1}}); err == nil {
+		t.Error("Expected error when adding an aggregate to a full pool")
+	}
+	// Attestations merged into an aggregate do not take more room.
+	added, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: 2}, AggregationBitfield: []byte{2}})
+	if err != nil || !added {
+		t.Errorf("Expected attestation to be aggregated into a full pool, received %v", err)
+	}
+
+	pool.prune(3 + params.BeaconConfig().EpochLength)
+	if _, err := pool.add(&pb.Attestation{Data: &pb.AttestationData{Slot: 4}, AggregationBitfield: []byte{1}}); err != nil {
+		t.Errorf("Expected pruning to make room for an aggregate: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package operations
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// operationPool holds the received block operations of one kind, such as exits or
+// slashings, keyed by their hash and kept in the order they were received in. The
+// pool holds at most maxSize operations, the oldest ones are dropped to make room
+// for new ones, and operations are dropped once they are older than the expiry.
+type operationPool struct {
+	lock       sync.RWMutex
+	operations map[[32]byte]*pooledOperation
+	order      [][32]byte
+	maxSize    int
+	expiry     time.Duration
+}
+
+type pooledOperation struct {
+	operation proto.Message
+	received  time.Time
+}
+
+func newOperationPool(maxSize int, expiry time.Duration) *operationPool {
+	return &operationPool{
+		operations: make(map[[32]byte]*pooledOperation),
+		maxSize:    maxSize,
+		expiry:     expiry,
+	}
+}
+
+// add adds the operation to the pool, returning false if it is already in the pool.
+func (p *operationPool) add(operation proto.Message) (bool, error) {
+	hash, err := hashutil.HashProto(operation)
+	if err != nil {
+		return false, err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.operations[hash]; ok {
+		return false, nil
+	}
+	for len(p.order) >= p.maxSize && len(p.order) > 0 {
+		delete(p.operations, p.order[0])
+		p.order = p.order[1:]
+	}
+	p.operations[hash] = &pooledOperation{operation: operation, received: time.Now()}
+	p.order = append(p.order, hash)
+	return true, nil
+}
+
+// remove removes the operation from the pool, if it is in the pool.
+func (p *operationPool) remove(operation proto.Message) error {
+	hash, err := hashutil.HashProto(operation)
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.operations[hash]; !ok {
+		return nil
+	}
+	delete(p.operations, hash)
+	for i, h := range p.order {
+		if h == hash {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// prune removes the operations received longer than the expiry before the given time.
+func (p *operationPool) prune(now time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	// The operations are ordered by the time they were received at.
+	expired := 0
+	for _, hash := range p.order {
+		if now.Sub(p.operations[hash].received) <= p.expiry {
+			break
+		}
+		delete(p.operations, hash)
+		expired++
+	}
+	p.order = p.order[expired:]
+}
+
+// all returns the operations in the pool in the order they were received in.
+func (p *operationPool) all() []proto.Message {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	operations := make([]proto.Message, len(p.order))
+	for i, hash := range p.order {
+		operations[i] = p.operations[hash].operation
+	}
+	return operations
+}
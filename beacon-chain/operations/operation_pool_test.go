@@ -0,0 +1,75 @@
+package operations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestOperationPool_AddRemove(t *testing.T) {
+	pool := newOperationPool(10, time.Minute)
+	exits := []*pb.Exit{{ValidatorIndex: 2}, {ValidatorIndex: 0}, {ValidatorIndex: 1}}
+	for _, exit := range exits {
+		added, err := pool.add(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !added {
+			t.Errorf("Expected exit of validator %d to be added", exit.ValidatorIndex)
+		}
+	}
+	added, err := pool.add(&pb.Exit{ValidatorIndex: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added {
+		t.Error("Expected an exit already in the pool not to be added")
+	}
+
+	if err := pool.remove(&pb.Exit{ValidatorIndex: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.remove(&pb.Exit{ValidatorIndex: 5}); err != nil {
+		t.Fatal(err)
+	}
+	want := []proto.Message{exits[0], exits[2]}
+	received := pool.all()
+	if len(received) != len(want) {
+		t.Fatalf("Expected %d exits, received %d", len(want), len(received))
+	}
+	for i := range want {
+		if !proto.Equal(received[i], want[i]) {
+			t.Errorf("Expected exit %v at index %d, received %v", want[i], i, received[i])
+		}
+	}
+}
+
+func TestOperationPool_DropsOldestWhenFull(t *testing.T) {
+	pool := newOperationPool(2, time.Minute)
+	for i := uint64(0); i < 3; i++ {
+		if _, err := pool.add(&pb.Exit{ValidatorIndex: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exits := pool.all()
+	if len(exits) != 2 || exits[0].(*pb.Exit).ValidatorIndex != 1 || exits[1].(*pb.Exit).ValidatorIndex != 2 {
+		t.Errorf("Expected the exits of validators 1 and 2 to be kept, received %v", exits)
+	}
+}
+
+func TestOperationPool_Prune(t *testing.T) {
+	pool := newOperationPool(10, time.Minute)
+	if _, err := pool.add(&pb.Exit{ValidatorIndex: 0}); err != nil {
+		t.Fatal(err)
+	}
+	pool.prune(time.Now())
+	if len(pool.all()) != 1 {
+		t.Error("Expected an exit received within the expiry to be kept")
+	}
+	pool.prune(time.Now().Add(2 * time.Minute))
+	if len(pool.all()) != 0 {
+		t.Error("Expected an expired exit to be pruned")
+	}
+}
@@ -0,0 +1,189 @@
+package operations
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// PendingOperations returns the pooled operations a block proposed at the given slot
+// on top of the given state can include. Every operation is checked by processing it
+// on a working copy of the state holding the operations selected before it, in the
+// order of the block processing, and at most the maximum number of operations of
+// every kind in a block are selected. Operations which are not valid are dropped from
+// their pool, except exits signed for a later slot which are kept until that slot.
+func (s *Service) PendingOperations(beaconState *pb.BeaconState, slot uint64) (*pb.BeaconBlockBody, error) {
+	if slot <= beaconState.Slot {
+		return nil, fmt.Errorf("can not propose a block at slot %d on top of a state at slot %d", slot, beaconState.Slot)
+	}
+	working := proto.Clone(beaconState).(*pb.BeaconState)
+	// The slots between the state and the proposal are not processed, the operations
+	// are checked against the registry as of the last block.
+	working.Slot = slot
+	body := &pb.BeaconBlockBody{}
+
+	for _, operation := range s.proposerSlashingPool.all() {
+		if uint64(len(body.ProposerSlashings)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		slashing := operation.(*pb.ProposerSlashing)
+		err := errors.New("proposer is not in the registry")
+		if proposerSlashingInRange(working, slashing) {
+			err = processOperation(working, &pb.BeaconBlockBody{
+				ProposerSlashings: []*pb.ProposerSlashing{slashing},
+			}, b.ProcessProposerSlashings)
+		}
+		if err != nil {
+			log.Debugf("Dropping proposer slashing of validator %d: %v", slashing.ProposerIndex, err)
+			if err := s.proposerSlashingPool.remove(slashing); err != nil {
+				return nil, fmt.Errorf("could not remove proposer slashing: %v", err)
+			}
+			continue
+		}
+		body.ProposerSlashings = append(body.ProposerSlashings, slashing)
+	}
+
+	for _, operation := range s.attesterSlashingPool.all() {
+		if uint64(len(body.AttesterSlashings)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		slashing := operation.(*pb.AttesterSlashing)
+		err := errors.New("votes are missing or of validators not in the registry")
+		if attesterSlashingInRange(working, slashing) {
+			err = processOperation(working, &pb.BeaconBlockBody{
+				AttesterSlashings: []*pb.AttesterSlashing{slashing},
+			}, b.ProcessAttesterSlashings)
+		}
+		if err != nil {
+			log.Debugf("Dropping attester slashing: %v", err)
+			if err := s.attesterSlashingPool.remove(slashing); err != nil {
+				return nil, fmt.Errorf("could not remove attester slashing: %v", err)
+			}
+			continue
+		}
+		body.AttesterSlashings = append(body.AttesterSlashings, slashing)
+	}
+
+	// The block processing replaces the latest attestations of the state, which the
+	// other operations are not checked against, so they are restored after every check.
+	latestAttestations := working.LatestAttestations
+	for _, attestation := range s.attestationPool.bestAggregates(slot) {
+		if uint64(len(body.Attestations)) == params.BeaconConfig().MaxAttestations {
+			break
+		}
+		err := errors.New("shard has no crosslink")
+		if attestation.Data.Shard < uint64(len(working.LatestCrosslinks)) {
+			err = processOperation(working, &pb.BeaconBlockBody{
+				Attestations: []*pb.Attestation{attestation},
+			}, b.ProcessBlockAttestations)
+			working.LatestAttestations = latestAttestations
+		}
+		if err != nil {
+			log.Debugf("Dropping attestation of slot %d shard %d: %v",
+				attestation.Data.Slot, attestation.Data.Shard, err)
+			if err := s.attestationPool.removeIncluded(attestation); err != nil {
+				return nil, fmt.Errorf("could not remove attestation: %v", err)
+			}
+			continue
+		}
+		body.Attestations = append(body.Attestations, attestation)
+	}
+
+	for _, operation := range s.exitPool.all() {
+		if uint64(len(body.Exits)) == params.BeaconConfig().MaxExits {
+			break
+		}
+		exit := operation.(*pb.Exit)
+		if exit.Slot > working.Slot {
+			continue
+		}
+		if err := processOperation(working, &pb.BeaconBlockBody{
+			Exits: []*pb.Exit{exit},
+		}, b.ProcessValidatorExits); err != nil {
+			log.Debugf("Dropping exit of validator %d: %v", exit.ValidatorIndex, err)
+			if err := s.exitPool.remove(exit); err != nil {
+				return nil, fmt.Errorf("could not remove exit: %v", err)
+			}
+			continue
+		}
+		body.Exits = append(body.Exits, exit)
+	}
+	return body, nil
+}
+
+// removeIncludedOperations removes the operations included in the block from the
+// pools, the attestations which can no longer be included after its slot and the
+// expired exits and slashings.
+func (s *Service) removeIncludedOperations(block *pb.BeaconBlock) error {
+	if block.Body == nil {
+		return nil
+	}
+	for _, slashing := range block.Body.ProposerSlashings {
+		if err := s.proposerSlashingPool.remove(slashing); err != nil {
+			return fmt.Errorf("could not remove proposer slashing: %v", err)
+		}
+	}
+	for _, slashing := range block.Body.AttesterSlashings {
+		if err := s.attesterSlashingPool.remove(slashing); err != nil {
+			return fmt.Errorf("could not remove attester slashing: %v", err)
+		}
+	}
+	for _, attestation := range block.Body.Attestations {
+		if err := s.attestationPool.removeIncluded(attestation); err != nil {
+			return fmt.Errorf("could not remove attestation: %v", err)
+		}
+	}
+	for _, exit := range block.Body.Exits {
+		if err := s.exitPool.remove(exit); err != nil {
+			return fmt.Errorf("could not remove exit: %v", err)
+		}
+	}
+	s.attestationPool.prune(block.Slot)
+	now := time.Now()
+	s.proposerSlashingPool.prune(now)
+	s.attesterSlashingPool.prune(now)
+	s.exitPool.prune(now)
+	return nil
+}
+
+// processOperation processes a block with the given body on the working state, which
+// the operations of the body change if they are valid. The block processing verifies
+// an operation before it changes the state, so an operation which is not valid leaves
+// the working state unchanged.
+func processOperation(
+	working *pb.BeaconState,
+	body *pb.BeaconBlockBody,
+	process func(*pb.BeaconState, *pb.BeaconBlock, bool) (*pb.BeaconState, error),
+) error {
+	block := &pb.BeaconBlock{Slot: working.Slot, Body: body}
+	_, err := process(working, block, true)
+	return err
+}
+
+// proposerSlashingInRange reports whether the slashing has both proposals and is of
+// a validator in the registry, which the block processing does not check.
+func proposerSlashingInRange(beaconState *pb.BeaconState, slashing *pb.ProposerSlashing) bool {
+	return slashing.ProposalData_1 != nil && slashing.ProposalData_2 != nil &&
+		slashing.ProposerIndex < uint64(len(beaconState.ValidatorRegistry))
+}
+
+// attesterSlashingInRange reports whether the slashing has both votes and is of
+// validators in the registry, which the block processing does not check.
+func attesterSlashingInRange(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing) bool {
+	for _, vote := range []*pb.SlashableVote{slashing.SlashableVote_1, slashing.SlashableVote_2} {
+		if vote == nil || vote.Data == nil {
+			return false
+		}
+		for _, index := range vote.ValidatorIndices {
+			if index >= uint64(len(beaconState.ValidatorRegistry)) {
+				return false
+			}
+		}
+	}
+	return true
+}
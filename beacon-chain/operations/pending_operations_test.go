@@ -0,0 +1,199 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func setupPendingOperationsState() *pb.BeaconState {
+	validators := make([]*pb.Validator, params.BeaconConfig().DepositsForChainStart)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &pb.Validator{
+			ExitEpoch:      params.BeaconConfig().FarFutureEpoch,
+			PenalizedEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxDeposit
+	}
+	var blockRoots [][]byte
+	for i := uint64(0); i < 2*params.BeaconConfig().EpochLength; i++ {
+		blockRoots = append(blockRoots, []byte{byte(i)})
+	}
+	return &pb.BeaconState{
+		Slot:                    69,
+		ValidatorRegistry:       validators,
+		ValidatorBalances:       balances,
+		LatestPenalizedBalances: make([]uint64, params.BeaconConfig().LatestPenalizedExitLength),
+		LatestBlockRootHash32S:  blockRoots,
+		LatestCrosslinks:        []*pb.CrosslinkRecord{{ShardBlockRootHash32: []byte{1}}},
+	}
+}
+
+func proposerSlashing(index uint64, slot1 uint64, slot2 uint64) *pb.ProposerSlashing {
+	return &pb.ProposerSlashing{
+		ProposerIndex:  index,
+		ProposalData_1: &pb.ProposalSignedData{Slot: slot1, BlockRootHash32: []byte{'a'}},
+		ProposalData_2: &pb.ProposalSignedData{Slot: slot2, BlockRootHash32: []byte{'b'}},
+	}
+}
+
+func TestPendingOperations_DropsInvalidOperations(t *testing.T) {
+	beaconState := setupPendingOperationsState()
+	service := NewOperationService(context.Background(), &Config{})
+
+	for _, slashing := range []*pb.ProposerSlashing{
+		proposerSlashing(1, 5, 5),
+		// Proposals of different slots.
+		proposerSlashing(2, 5, 6),
+		// Proposer not in the registry.
+		proposerSlashing(uint64(len(beaconState.ValidatorRegistry)), 5, 5),
+	} {
+		if _, err := service.proposerSlashingPool.add(slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, attestation := range []*pb.Attestation{
+		{Data: &pb.AttestationData{
+			Slot:                      20,
+			JustifiedBlockRootHash32:  beaconState.LatestBlockRootHash32S[0],
+			LatestCrosslinkRootHash32: []byte{1},
+		}, AggregationBitfield: []byte{1}},
+		// Shard without a crosslink.
+		{Data: &pb.AttestationData{Slot: 20, Shard: 1}, AggregationBitfield: []byte{1}},
+		// Attests to a different justified block.
+		{Data: &pb.AttestationData{
+			Slot:                      21,
+			JustifiedBlockRootHash32:  []byte{'b'},
+			LatestCrosslinkRootHash32: []byte{1},
+		}, AggregationBitfield: []byte{1}},
+	} {
+		if _, err := service.attestationPool.add(attestation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, exit := range []*pb.Exit{
+		{ValidatorIndex: 3, Slot: 60},
+		// Exit signed for a later slot.
+		{ValidatorIndex: 4, Slot: 71},
+		// Validator not in the registry.
+		{ValidatorIndex: uint64(len(beaconState.ValidatorRegistry))},
+		// Validator slashed by the proposer slashing.
+		{ValidatorIndex: 1, Slot: 60},
+	} {
+		if _, err := service.exitPool.add(exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	body, err := service.PendingOperations(beaconState, 70)
+	if err != nil {
+		t.Fatalf("Could not get pending operations: %v", err)
+	}
+	if len(body.ProposerSlashings) != 1 || body.ProposerSlashings[0].ProposerIndex != 1 {
+		t.Errorf("Expected the proposer slashing of validator 1, received %v", body.ProposerSlashings)
+	}
+	if len(body.Attestations) != 1 || body.Attestations[0].Data.Slot != 20 || body.Attestations[0].Data.Shard != 0 {
+		t.Errorf("Expected the attestation of slot 20 shard 0, received %v", body.Attestations)
+	}
+	if len(body.Exits) != 1 || body.Exits[0].ValidatorIndex != 3 {
+		t.Errorf("Expected the exit of validator 3, received %v", body.Exits)
+	}
+	if beaconState.Slot != 69 || beaconState.ValidatorRegistry[1].PenalizedEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Error("Expected the given state to be left unchanged")
+	}
+
+	// The invalid operations are dropped, the exit signed for a later slot is kept.
+	if len(service.proposerSlashingPool.all()) != 1 {
+		t.Errorf("Expected only the valid proposer slashing to be kept, received %v", service.proposerSlashingPool.all())
+	}
+	if aggregates := service.attestationPool.bestAggregates(70); len(aggregates) != 1 {
+		t.Errorf("Expected only the valid attestation to be kept, received %v", aggregates)
+	}
+	exits := service.exitPool.all()
+	if len(exits) != 2 || exits[0].(*pb.Exit).ValidatorIndex != 3 || exits[1].(*pb.Exit).ValidatorIndex != 4 {
+		t.Errorf("Expected the exits of validators 3 and 4 to be kept, received %v", exits)
+	}
+}
+
+func TestPendingOperations_RespectsMaxOperations(t *testing.T) {
+	cfg := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&cfg)
+	c := params.BeaconConfig()
+	c.MaxExits = 2
+	params.OverrideBeaconConfig(c)
+
+	beaconState := setupPendingOperationsState()
+	service := NewOperationService(context.Background(), &Config{})
+	for i := uint64(0); i < 3; i++ {
+		if _, err := service.exitPool.add(&pb.Exit{ValidatorIndex: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	body, err := service.PendingOperations(beaconState, 70)
+	if err != nil {
+		t.Fatalf("Could not get pending operations: %v", err)
+	}
+	if len(body.Exits) != 2 || body.Exits[0].ValidatorIndex != 0 || body.Exits[1].ValidatorIndex != 1 {
+		t.Errorf("Expected the first 2 exits received, received %v", body.Exits)
+	}
+}
+
+func TestPendingOperations_RejectsPastSlot(t *testing.T) {
+	service := NewOperationService(context.Background(), &Config{})
+	if _, err := service.PendingOperations(&pb.BeaconState{Slot: 5}, 5); err == nil {
+		t.Error("Expected error when proposing at the slot of the state")
+	}
+}
+
+func TestRemoveIncludedOperations(t *testing.T) {
+	service := NewOperationService(context.Background(), &Config{})
+	data := &pb.AttestationData{Slot: 5}
+	included := &pb.Attestation{Data: data, AggregationBitfield: []byte{192}}
+	slashing := proposerSlashing(1, 5, 5)
+	exit := &pb.Exit{ValidatorIndex: 2}
+	for _, attestation := range []*pb.Attestation{
+		{Data: data, AggregationBitfield: []byte{128}},
+		{Data: data, AggregationBitfield: []byte{64}},
+		{Data: data, AggregationBitfield: []byte{160}},
+	} {
+		if _, err := service.attestationPool.add(attestation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := service.proposerSlashingPool.add(slashing); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.exitPool.add(exit); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.exitPool.add(&pb.Exit{ValidatorIndex: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	block := &pb.BeaconBlock{
+		Slot: 10,
+		Body: &pb.BeaconBlockBody{
+			Attestations:      []*pb.Attestation{included},
+			ProposerSlashings: []*pb.ProposerSlashing{slashing},
+			Exits:             []*pb.Exit{exit},
+		},
+	}
+	if err := service.removeIncludedOperations(block); err != nil {
+		t.Fatalf("Could not remove included operations: %v", err)
+	}
+
+	aggregates := service.attestationPool.bestAggregates(9)
+	if len(aggregates) != 1 || aggregates[0].AggregationBitfield[0] != 160 {
+		t.Errorf("Expected only the aggregate with an attester not included to be kept, received %v", aggregates)
+	}
+	if len(service.proposerSlashingPool.all()) != 0 {
+		t.Error("Expected the included proposer slashing to be removed")
+	}
+	exits := service.exitPool.all()
+	if len(exits) != 1 || exits[0].(*pb.Exit).ValidatorIndex != 3 {
+		t.Errorf("Expected only the exit of validator 3 to be kept, received %v", exits)
+	}
+}
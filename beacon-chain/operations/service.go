@@ -3,48 +3,92 @@ package operations
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
 var log = logrus.WithField("prefix", "operation")
 
+const (
+	// maxPooledAttestations is the number of attestation aggregates kept in the pool.
+	maxPooledAttestations = 4096
+	// maxPooledExits is the number of exits kept in the pool.
+	maxPooledExits = 1024
+	// maxPooledSlashings is the number of slashings of every kind kept in the pools.
+	maxPooledSlashings = 256
+	// operationExpiryEpochs is the number of epochs exits and slashings are kept in the
+	// pools for, if they are not included in a block before.
+	operationExpiryEpochs = 16
+)
+
+type chainService interface {
+	CanonicalBlockFeed() *event.Feed
+}
+
 // Service represents a service that handles the internal
 // logic of beacon block operations.
 type Service struct {
-	ctx                    context.Context
-	cancel                 context.CancelFunc
-	beaconDB               *db.BeaconDB
-	incomingExitFeed       *event.Feed
-	incomingValidatorExits chan *pb.Exit
-	incomingAttFeed        *event.Feed
-	incomingAtt            chan *pb.Attestation
-	error                  error
+	ctx                          context.Context
+	cancel                       context.CancelFunc
+	beaconDB                     *db.BeaconDB
+	chainService                 chainService
+	incomingExitFeed             *event.Feed
+	incomingValidatorExits       chan *pb.Exit
+	incomingAttFeed              *event.Feed
+	incomingAtt                  chan *pb.Attestation
+	incomingProposerSlashingFeed *event.Feed
+	incomingProposerSlashings    chan *pb.ProposerSlashing
+	incomingAttesterSlashingFeed *event.Feed
+	incomingAttesterSlashings    chan *pb.AttesterSlashing
+	canonicalBlockChan           chan *pb.BeaconBlock
+	attestationPool              *attestationPool
+	exitPool                     *operationPool
+	proposerSlashingPool         *operationPool
+	attesterSlashingPool         *operationPool
+	error                        error
 }
 
 // Config options for the service.
 type Config struct {
-	BeaconDB       *db.BeaconDB
-	ReceiveExitBuf int
-	ReceiveAttBuf  int
+	BeaconDB           *db.BeaconDB
+	ChainService       chainService
+	ReceiveExitBuf     int
+	ReceiveAttBuf      int
+	ReceiveSlashingBuf int
+	ReceiveBlockBuf    int
 }
 
 // NewOperationService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewOperationService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	expiry := time.Duration(operationExpiryEpochs*params.BeaconConfig().EpochLength*params.BeaconConfig().SlotDuration) * time.Second
 	return &Service{
-		ctx:                    ctx,
-		cancel:                 cancel,
-		beaconDB:               cfg.BeaconDB,
-		incomingExitFeed:       new(event.Feed),
-		incomingValidatorExits: make(chan *pb.Exit, cfg.ReceiveExitBuf),
-		incomingAttFeed:        new(event.Feed),
-		incomingAtt:            make(chan *pb.Attestation, cfg.ReceiveAttBuf),
+		ctx:                          ctx,
+		cancel:                       cancel,
+		beaconDB:                     cfg.BeaconDB,
+		incomingExitFeed:             new(event.Feed),
+		incomingValidatorExits:       make(chan *pb.Exit, cfg.ReceiveExitBuf),
+		incomingAttFeed:              new(event.Feed),
+		incomingAtt:                  make(chan *pb.Attestation, cfg.ReceiveAttBuf),
+		incomingProposerSlashingFeed: new(event.Feed),
+		incomingProposerSlashings:    make(chan *pb.ProposerSlashing, cfg.ReceiveSlashingBuf),
+		incomingAttesterSlashingFeed: new(event.Feed),
+		incomingAttesterSlashings:    make(chan *pb.AttesterSlashing, cfg.ReceiveSlashingBuf),
+		chainService:                 cfg.ChainService,
+		canonicalBlockChan:           make(chan *pb.BeaconBlock, cfg.ReceiveBlockBuf),
+		attestationPool:              newAttestationPool(maxPooledAttestations),
+		exitPool:                     newOperationPool(maxPooledExits, expiry),
+		proposerSlashingPool:         newOperationPool(maxPooledSlashings, expiry),
+		attesterSlashingPool:         newOperationPool(maxPooledSlashings, expiry),
 	}
 }
 
@@ -52,6 +96,7 @@ func NewOperationService(ctx context.Context, cfg *Config) *Service {
 func (s *Service) Start() {
 	log.Info("Starting service")
 	go s.saveOperations()
+	go s.removeOperations()
 }
 
 // Stop the beacon block operation service's main event loop
@@ -82,18 +127,55 @@ func (s *Service) IncomingAttFeed() *event.Feed {
 	return s.incomingAttFeed
 }
 
+// IncomingProposerSlashingFeed returns a feed that any service can send incoming proposer slashings into.
+// The beacon block operation service will subscribe to this feed in order to pool them for block inclusion.
+func (s *Service) IncomingProposerSlashingFeed() *event.Feed {
+	return s.incomingProposerSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send incoming attester slashings into.
+// The beacon block operation service will subscribe to this feed in order to pool them for block inclusion.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Feed {
+	return s.incomingAttesterSlashingFeed
+}
+
+// AggregatedAttestations returns the aggregated attestations a block proposed at the
+// given slot should include, the one with the most attesters of every slot and shard,
+// up to the maximum number of attestations in a block.
+func (s *Service) AggregatedAttestations(slot uint64) []*pb.Attestation {
+	attestations := s.attestationPool.bestAggregates(slot)
+	if maxAttestations := params.BeaconConfig().MaxAttestations; uint64(len(attestations)) > maxAttestations {
+		attestations = attestations[:maxAttestations]
+	}
+	return attestations
+}
+
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
-	// TODO(1438): Add rest of operations (slashings, attestation, exists...etc)
 	incomingSub := s.incomingExitFeed.Subscribe(s.incomingValidatorExits)
 	defer incomingSub.Unsubscribe()
+	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
+	defer incomingAttSub.Unsubscribe()
+	incomingProposerSlashingSub := s.incomingProposerSlashingFeed.Subscribe(s.incomingProposerSlashings)
+	defer incomingProposerSlashingSub.Unsubscribe()
+	incomingAttesterSlashingSub := s.incomingAttesterSlashingFeed.Subscribe(s.incomingAttesterSlashings)
+	defer incomingAttesterSlashingSub.Unsubscribe()
 
 	for {
 		select {
 		case <-incomingSub.Err():
 			log.Debug("Subscriber closed, exiting goroutine")
 			return
+		case <-incomingAttSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-incomingProposerSlashingSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-incomingAttesterSlashingSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
 		case <-s.ctx.Done():
 			log.Debug("Beacon block operations service context closed, exiting goroutine")
 			return
@@ -109,18 +191,91 @@ func (s *Service) saveOperations() {
 				continue
 			}
 			log.Debugf("Exit request %#x saved in db", hash)
+			if _, err := s.exitPool.add(exit); err != nil {
+				log.Errorf("Could not pool exit request: %v", err)
+			}
 		case attestation := <-s.incomingAtt:
 			hash, err := hashutil.HashProto(attestation)
 			if err != nil {
 				log.Errorf("Could not hash attestation proto: %v", err)
 				continue
 			}
+			if err := s.checkAttestationSlot(attestation); err != nil {
+				log.Debugf("Ignoring attestation %#x: %v", hash, err)
+				continue
+			}
 			if err := s.beaconDB.SaveAttestation(attestation); err != nil {
 				log.Errorf("Could not save attestation: %v", err)
 				continue
 			}
 			log.Debugf("Attestation %#x saved in db", hash)
+			aggregated, err := s.attestationPool.add(attestation)
+			if err != nil {
+				log.Errorf("Could not aggregate attestation: %v", err)
+				continue
+			}
+			if aggregated {
+				log.Debugf("Attestation %#x aggregated", hash)
+			}
+		case slashing := <-s.incomingProposerSlashings:
+			added, err := s.proposerSlashingPool.add(slashing)
+			if err != nil {
+				log.Errorf("Could not pool proposer slashing: %v", err)
+				continue
+			}
+			if added {
+				log.Debugf("Proposer slashing of validator %d pooled", slashing.ProposerIndex)
+			}
+		case slashing := <-s.incomingAttesterSlashings:
+			added, err := s.attesterSlashingPool.add(slashing)
+			if err != nil {
+				log.Errorf("Could not pool attester slashing: %v", err)
+				continue
+			}
+			if added {
+				log.Debug("Attester slashing pooled")
+			}
 		}
 
 	}
 }
+
+// checkAttestationSlot rejects attestations for slots more than an epoch after the
+// head of the chain, which could otherwise fill the pool with attestations that can
+// not be included in a block any time soon.
+func (s *Service) checkAttestationSlot(attestation *pb.Attestation) error {
+	if attestation.Data == nil {
+		return errors.New("attestation has no attestation data")
+	}
+	head, err := s.beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not get chain head: %v", err)
+	}
+	if attestation.Data.Slot > head.Slot+params.BeaconConfig().EpochLength {
+		return fmt.Errorf("attestation slot %d is more than an epoch after the head slot %d",
+			attestation.Data.Slot, head.Slot)
+	}
+	return nil
+}
+
+// removeOperations removes the operations included in canonical blocks from
+// the pools, so they are not proposed again.
+func (s *Service) removeOperations() {
+	canonicalBlockSub := s.chainService.CanonicalBlockFeed().Subscribe(s.canonicalBlockChan)
+	defer canonicalBlockSub.Unsubscribe()
+
+	for {
+		select {
+		case <-canonicalBlockSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Beacon block operations service context closed, exiting goroutine")
+			return
+		case block := <-s.canonicalBlockChan:
+			if err := s.removeIncludedOperations(block); err != nil {
+				log.Errorf("Could not remove operations included in block at slot %d: %v", block.Slot, err)
+			}
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -81,6 +82,9 @@ func TestIncomingAttestation_Ok(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
+	if err := beaconDB.InitializeState(0, nil); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
 	service := NewOperationService(context.Background(), &Config{BeaconDB: beaconDB})
 
 	exitRoutine := make(chan bool)
@@ -91,7 +95,7 @@ func TestIncomingAttestation_Ok(t *testing.T) {
 	attestation := &pb.Attestation{
 		AggregationBitfield: []byte{'A'},
 		Data: &pb.AttestationData{
-			Slot: 100,
+			Slot: 10,
 		}}
 	hash, err := hashutil.HashProto(attestation)
 	if err != nil {
@@ -105,3 +109,39 @@ func TestIncomingAttestation_Ok(t *testing.T) {
 	want := fmt.Sprintf("Attestation %#x saved in db", hash)
 	testutil.AssertLogsContain(t, hook, want)
 }
+
+func TestIncomingAttestation_RejectsFutureSlots(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	if err := beaconDB.InitializeState(0, nil); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
+	service := NewOperationService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	exitRoutine := make(chan bool)
+	go func() {
+		service.saveOperations()
+		<-exitRoutine
+	}()
+	inRange := &pb.Attestation{
+		AggregationBitfield: []byte{'A'},
+		Data:                &pb.AttestationData{Slot: 1},
+	}
+	future := &pb.Attestation{
+		AggregationBitfield: []byte{'A'},
+		Data:                &pb.AttestationData{Slot: params.BeaconConfig().EpochLength + 1},
+	}
+	service.incomingAtt <- inRange
+	service.incomingAtt <- future
+	service.cancel()
+	exitRoutine <- true
+
+	testutil.AssertLogsContain(t, hook, "is more than an epoch after the head slot 0")
+	if aggregates := service.attestationPool.bestAggregates(5); len(aggregates) != 1 {
+		t.Errorf("Expected the pooled attestation to be kept, received %v", aggregates)
+	}
+	if aggregates := service.attestationPool.bestAggregates(future.Data.Slot + 1); len(aggregates) != 0 {
+		t.Errorf("Expected the future attestation to be rejected, received %v", aggregates)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
@@ -20,18 +21,34 @@ import (
 type BeaconServer struct {
 	beaconDB            *db.BeaconDB
 	ctx                 context.Context
+	chainService        chainService
 	powChainService     powChainService
 	operationService    operationService
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
+	chainEventBuf       int
 }
 
 // WaitForChainStart queries the logs of the Deposit Contract in order to verify the beacon chain
 // has started its runtime and validators begin their responsibilities. If it has not, it then
 // subscribes to an event stream triggered by the powchain service whenever the ChainStart log does
-// occur in the Deposit Contract on ETH 1.0.
+// occur in the Deposit Contract on ETH 1.0. A node running without a proof-of-work chain has
+// started from its genesis state, whose genesis time is sent right away.
 func (bs *BeaconServer) WaitForChainStart(req *ptypes.Empty, stream pb.BeaconService_WaitForChainStartServer) error {
+	if bs.powChainService == nil {
+		beaconState, err := bs.beaconDB.State()
+		if err != nil {
+			return fmt.Errorf("could not fetch beacon state: %v", err)
+		}
+		if beaconState == nil {
+			return errors.New("no proof-of-work chain and no genesis state to start from")
+		}
+		return stream.Send(&pb.ChainStartResponse{
+			Started:     true,
+			GenesisTime: beaconState.GenesisTime,
+		})
+	}
 	ok, genesisTime, err := bs.powChainService.HasChainStartLogOccurred()
 	if err != nil {
 		return fmt.Errorf("could not determine if ChainStart log has occurred: %v", err)
@@ -106,6 +123,9 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 // PendingDeposits returns a list of pending deposits that are ready for
 // inclusion in the next beacon block.
 func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositsResponse, error) {
+	if bs.powChainService == nil {
+		return &pb.PendingDepositsResponse{}, nil
+	}
 	bNum := bs.powChainService.LatestBlockNumber()
 
 	if bNum == nil {
@@ -117,3 +137,115 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 
 	return &pb.PendingDepositsResponse{PendingDeposits: bs.beaconDB.PendingDeposits(ctx, bNum)}, nil
 }
+
+// ChainConfig returns the beacon chain and deposit contract configs used by the node.
+func (bs *BeaconServer) ChainConfig(ctx context.Context, _ *ptypes.Empty) (*pb.ChainConfigResponse, error) {
+	return &pb.ChainConfigResponse{
+		BeaconChainConfig:     chainConfigEntries(params.ConfigEntries(params.BeaconConfig())),
+		DepositContractConfig: chainConfigEntries(params.ConfigEntries(params.ContractConfig())),
+	}, nil
+}
+
+// chainConfigEntries converts config entries into their RPC representation, sorted by key.
+func chainConfigEntries(entries map[string]string) []*pb.ChainConfigEntry {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([]*pb.ChainConfigEntry, len(keys))
+	for i, key := range keys {
+		res[i] = &pb.ChainConfigEntry{Key: key, Value: entries[key]}
+	}
+	return res
+}
+
+// StreamChainEvents streams events observed on the canonical chain, such as new heads, reorgs,
+// justified and finalized epoch changes, validator activations and exits, and slashings.
+// Only the event types listed in the request are sent, or every type if none are listed.
+// Canonical blocks are buffered per stream; if a client is too slow to keep up the oldest
+// buffered blocks are dropped and the number of dropped blocks is reported in the next event.
+func (bs *BeaconServer) StreamChainEvents(req *pb.ChainEventsRequest, stream pb.BeaconService_StreamChainEventsServer) error {
+	filter := newChainEventFilter(req.EventTypes)
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not get canonical head block: %v", err)
+	}
+	beaconState, err := bs.beaconDB.State()
+	if err != nil {
+		return fmt.Errorf("could not get beacon state: %v", err)
+	}
+	tracker, err := newChainEventTracker(head, beaconState, bs.beaconDB.Block)
+	if err != nil {
+		return err
+	}
+
+	// The block feed blocks its sender until every subscriber received the block, so
+	// blocks are moved into a bounded queue right away to never stall the chain service.
+	// The state is read as soon as the block is received, which is when the chain service
+	// has just saved it as the state of the new head.
+	queue := newBlockQueue(bs.chainEventBuf)
+	blockChan := make(chan *pbp2p.BeaconBlock, 1)
+	sub := bs.chainService.CanonicalBlockFeed().Subscribe(blockChan)
+	defer sub.Unsubscribe()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case block := <-blockChan:
+				beaconState, err := bs.beaconDB.State()
+				if err != nil {
+					log.Errorf("Could not get beacon state of head block: %v", err)
+					beaconState = nil
+				}
+				if beaconState != nil && beaconState.Slot != block.Slot {
+					// A later head already replaced the state produced by this block.
+					beaconState = nil
+				}
+				queue.push(block, beaconState)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Dropped blocks are reported in the next event sent to the client, even if the
+	// events of the following blocks are filtered out.
+	var dropped uint64
+	for {
+		select {
+		case <-queue.notify:
+			updates, newlyDropped := queue.popAll()
+			if newlyDropped > 0 {
+				log.WithField("droppedBlocks", newlyDropped).Warn("Chain event stream client is too slow, dropping blocks")
+			}
+			dropped += newlyDropped
+			for _, update := range updates {
+				events, err := tracker.process(update.block, update.state)
+				if err != nil {
+					return err
+				}
+				for _, event := range events {
+					if !filter.allows(event.Type) {
+						continue
+					}
+					event.DroppedBlocks = dropped
+					dropped = 0
+					if err := stream.Send(event); err != nil {
+						return err
+					}
+				}
+			}
+		case <-sub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return nil
+		case <-stream.Context().Done():
+			log.Debug("RPC stream closed, exiting goroutine")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/big"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -74,6 +76,30 @@ func TestWaitForChainStart_ContextClosed(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "RPC context closed, exiting goroutine")
 }
 
+func TestWaitForChainStart_NoPOWChain(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	if err := db.InitializeState(uint64(time.Unix(12, 0).Unix()), nil); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
+	beaconServer := &BeaconServer{
+		ctx:      context.Background(),
+		beaconDB: db,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconService_WaitForChainStartServer(ctrl)
+	mockStream.EXPECT().Send(
+		&pb.ChainStartResponse{
+			Started:     true,
+			GenesisTime: uint64(time.Unix(12, 0).Unix()),
+		},
+	).Return(nil)
+	if err := beaconServer.WaitForChainStart(&ptypes.Empty{}, mockStream); err != nil {
+		t.Errorf("Could not call RPC method: %v", err)
+	}
+}
+
 func TestWaitForChainStart_AlreadyStarted(t *testing.T) {
 	beaconServer := &BeaconServer{
 		ctx: context.Background(),
@@ -275,3 +301,85 @@ func TestPendingDeposits_ReturnsDepositsOutsideEth1FollowWindow(t *testing.T) {
 		)
 	}
 }
+
+func TestStreamChainEvents_SendsRequestedEvents(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	mockChain := newMockChainService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(genesis, &pbp2p.BeaconState{}); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	beaconServer := &BeaconServer{
+		ctx:          ctx,
+		beaconDB:     beaconDB,
+		chainService: mockChain,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	received := make(chan *pb.ChainEvent, 1)
+	mockStream := internal.NewMockBeaconService_StreamChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(event *pb.ChainEvent) {
+		received <- event
+	}).Return(nil)
+
+	exitRoutine := make(chan bool)
+	req := &pb.ChainEventsRequest{EventTypes: []pb.ChainEventType{pb.ChainEventType_JUSTIFIED}}
+	go func(tt *testing.T) {
+		if err := beaconServer.StreamChainEvents(req, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		<-exitRoutine
+	}(t)
+
+	// Wait for the stream to subscribe to canonical blocks before updating the head.
+	for mockChain.blockFeed.Send(genesis) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	block := &pbp2p.BeaconBlock{Slot: 1}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(block, &pbp2p.BeaconState{Slot: 1, JustifiedEpoch: 1}); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	mockChain.blockFeed.Send(block)
+
+	event := <-received
+	if event.Type != pb.ChainEventType_JUSTIFIED || event.Epoch != 1 {
+		t.Errorf("Expected justified event for epoch 1, received %v", event)
+	}
+	cancel()
+	exitRoutine <- true
+}
+
+func TestChainConfig(t *testing.T) {
+	beaconServer := &BeaconServer{}
+	res, err := beaconServer.ChainConfig(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get chain config: %v", err)
+	}
+	var epochLength string
+	for i, entry := range res.BeaconChainConfig {
+		if i > 0 && res.BeaconChainConfig[i-1].Key >= entry.Key {
+			t.Errorf("Expected entries sorted by key, received %s after %s", entry.Key, res.BeaconChainConfig[i-1].Key)
+		}
+		if entry.Key == "epoch_length" {
+			epochLength = entry.Value
+		}
+	}
+	if epochLength != strconv.FormatUint(params.BeaconConfig().EpochLength, 10) {
+		t.Errorf("Expected epoch length %d, received %s", params.BeaconConfig().EpochLength, epochLength)
+	}
+	if len(res.DepositContractConfig) != 3 {
+		t.Errorf("Expected 3 deposit contract config entries, received %d", len(res.DepositContractConfig))
+	}
+}
@@ -0,0 +1,280 @@
+package rpc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// defaultChainEventQueueSize is the number of canonical blocks buffered for a
+// single chain event stream before the oldest ones start getting dropped.
+const defaultChainEventQueueSize = 64
+
+// chainEventFilter determines which event types a client subscribed to.
+type chainEventFilter map[pb.ChainEventType]bool
+
+func newChainEventFilter(eventTypes []pb.ChainEventType) chainEventFilter {
+	if len(eventTypes) == 0 {
+		return nil
+	}
+	filter := make(chainEventFilter, len(eventTypes))
+	for _, t := range eventTypes {
+		filter[t] = true
+	}
+	return filter
+}
+
+// allows returns true if the event type was requested, an empty
+// filter allows every event type.
+func (f chainEventFilter) allows(t pb.ChainEventType) bool {
+	if len(f) == 0 {
+		return true
+	}
+	return f[t]
+}
+
+// headUpdate is a canonical head block along with the state it produced, or a nil
+// state if a later head had already replaced it when the block was observed.
+type headUpdate struct {
+	block *pbp2p.BeaconBlock
+	state *pbp2p.BeaconState
+}
+
+// blockQueue is a bounded queue of canonical blocks waiting to be turned into
+// chain events. It never blocks the producer: once full, the oldest block is
+// discarded so a slow client cannot stall the chain service's block feed.
+type blockQueue struct {
+	lock    sync.Mutex
+	updates []*headUpdate
+	limit   int
+	dropped uint64
+	notify  chan struct{}
+}
+
+func newBlockQueue(limit int) *blockQueue {
+	if limit <= 0 {
+		limit = defaultChainEventQueueSize
+	}
+	return &blockQueue{
+		limit:  limit,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// push adds a block and its state to the queue, dropping the oldest block if the queue is full.
+func (q *blockQueue) push(block *pbp2p.BeaconBlock, beaconState *pbp2p.BeaconState) {
+	q.lock.Lock()
+	if len(q.updates) >= q.limit {
+		q.updates = q.updates[1:]
+		q.dropped++
+	}
+	q.updates = append(q.updates, &headUpdate{block: block, state: beaconState})
+	q.lock.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// popAll empties the queue, returning its blocks along with the number of
+// blocks dropped since the previous call.
+func (q *blockQueue) popAll() ([]*headUpdate, uint64) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	updates, dropped := q.updates, q.dropped
+	q.updates = nil
+	q.dropped = 0
+	return updates, dropped
+}
+
+// chainEventTracker remembers the last observed canonical head, finality checkpoints
+// and validator registry epochs in order to derive chain events from new heads.
+type chainEventTracker struct {
+	headRoot         [32]byte
+	headSlot         uint64
+	blockByRoot      func([32]byte) (*pbp2p.BeaconBlock, error)
+	justifiedEpoch   uint64
+	finalizedEpoch   uint64
+	activationEpochs []uint64
+	exitEpochs       []uint64
+}
+
+// newChainEventTracker initializes a tracker from the current canonical head and state
+// so that only changes happening after the subscription are reported. Blocks are looked
+// up by root to tell whether a new head descends from the previous one.
+func newChainEventTracker(
+	head *pbp2p.BeaconBlock,
+	beaconState *pbp2p.BeaconState,
+	blockByRoot func([32]byte) (*pbp2p.BeaconBlock, error),
+) (*chainEventTracker, error) {
+	t := &chainEventTracker{blockByRoot: blockByRoot}
+	if head != nil {
+		root, err := hashutil.HashBeaconBlock(head)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash head block: %v", err)
+		}
+		t.headRoot = root
+		t.headSlot = head.Slot
+	}
+	if beaconState != nil {
+		t.justifiedEpoch = beaconState.JustifiedEpoch
+		t.finalizedEpoch = beaconState.FinalizedEpoch
+		t.activationEpochs = make([]uint64, len(beaconState.ValidatorRegistry))
+		t.exitEpochs = make([]uint64, len(beaconState.ValidatorRegistry))
+		for i, v := range beaconState.ValidatorRegistry {
+			t.activationEpochs[i] = v.ActivationEpoch
+			t.exitEpochs[i] = v.ExitEpoch
+		}
+	}
+	return t, nil
+}
+
+// process derives the chain events caused by a new canonical head block and its
+// resulting state, and updates the tracker accordingly.
+func (t *chainEventTracker) process(block *pbp2p.BeaconBlock, beaconState *pbp2p.BeaconState) ([]*pb.ChainEvent, error) {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	var events []*pb.ChainEvent
+	epoch := helpers.SlotToEpoch(block.Slot)
+	previousRoot := t.headRoot
+
+	// TODO(#674): Report the common ancestor once the chain service handles reorgs.
+	descends, err := t.descendsFromHead(root, block)
+	if err != nil {
+		return nil, err
+	}
+	if !descends {
+		events = append(events, &pb.ChainEvent{
+			Type:                   pb.ChainEventType_REORG,
+			Slot:                   block.Slot,
+			Epoch:                  epoch,
+			BlockRootHash32:        root[:],
+			PreviousHeadRootHash32: previousRoot[:],
+		})
+	}
+	events = append(events, &pb.ChainEvent{
+		Type:                   pb.ChainEventType_HEAD,
+		Slot:                   block.Slot,
+		Epoch:                  epoch,
+		BlockRootHash32:        root[:],
+		PreviousHeadRootHash32: previousRoot[:],
+	})
+	t.headRoot = root
+	t.headSlot = block.Slot
+
+	if block.Body != nil {
+		for _, slashing := range block.Body.ProposerSlashings {
+			events = append(events, &pb.ChainEvent{
+				Type:             pb.ChainEventType_PROPOSER_SLASHING,
+				Slot:             block.Slot,
+				Epoch:            epoch,
+				BlockRootHash32:  root[:],
+				ValidatorIndex:   slashing.ProposerIndex,
+				ProposerSlashing: slashing,
+			})
+		}
+		for _, slashing := range block.Body.AttesterSlashings {
+			events = append(events, &pb.ChainEvent{
+				Type:             pb.ChainEventType_ATTESTER_SLASHING,
+				Slot:             block.Slot,
+				Epoch:            epoch,
+				BlockRootHash32:  root[:],
+				AttesterSlashing: slashing,
+			})
+		}
+	}
+
+	if beaconState == nil {
+		return events, nil
+	}
+	if beaconState.JustifiedEpoch != t.justifiedEpoch {
+		t.justifiedEpoch = beaconState.JustifiedEpoch
+		events = append(events, &pb.ChainEvent{
+			Type:            pb.ChainEventType_JUSTIFIED,
+			Slot:            beaconState.Slot,
+			Epoch:           beaconState.JustifiedEpoch,
+			BlockRootHash32: root[:],
+		})
+	}
+	if beaconState.FinalizedEpoch != t.finalizedEpoch {
+		t.finalizedEpoch = beaconState.FinalizedEpoch
+		events = append(events, &pb.ChainEvent{
+			Type:            pb.ChainEventType_FINALIZED,
+			Slot:            beaconState.Slot,
+			Epoch:           beaconState.FinalizedEpoch,
+			BlockRootHash32: root[:],
+		})
+	}
+
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	for i, v := range beaconState.ValidatorRegistry {
+		// Validators which were added to the registry since the last update
+		// have not been activated nor exited from the tracker's point of view.
+		if i >= len(t.activationEpochs) {
+			t.activationEpochs = append(t.activationEpochs, farFutureEpoch)
+			t.exitEpochs = append(t.exitEpochs, farFutureEpoch)
+		}
+		if v.ActivationEpoch != t.activationEpochs[i] && v.ActivationEpoch != farFutureEpoch {
+			events = append(events, &pb.ChainEvent{
+				Type:            pb.ChainEventType_VALIDATOR_ACTIVATED,
+				Slot:            beaconState.Slot,
+				Epoch:           v.ActivationEpoch,
+				BlockRootHash32: root[:],
+				ValidatorIndex:  uint64(i),
+				PublicKey:       v.Pubkey,
+			})
+		}
+		if v.ExitEpoch != t.exitEpochs[i] && v.ExitEpoch != farFutureEpoch {
+			events = append(events, &pb.ChainEvent{
+				Type:            pb.ChainEventType_VALIDATOR_EXITED,
+				Slot:            beaconState.Slot,
+				Epoch:           v.ExitEpoch,
+				BlockRootHash32: root[:],
+				ValidatorIndex:  uint64(i),
+				PublicKey:       v.Pubkey,
+			})
+		}
+		t.activationEpochs[i] = v.ActivationEpoch
+		t.exitEpochs[i] = v.ExitEpoch
+	}
+	return events, nil
+}
+
+// descendsFromHead returns true if the block is the tracked head or one of its
+// descendants, walking back through the ancestors of the block which were not
+// observed, such as blocks dropped from the queue of a slow stream.
+func (t *chainEventTracker) descendsFromHead(root [32]byte, block *pbp2p.BeaconBlock) (bool, error) {
+	if t.headRoot == [32]byte{} || root == t.headRoot {
+		return true, nil
+	}
+	ancestor := block
+	for ancestor.Slot > t.headSlot {
+		parentRoot := bytesutil.ToBytes32(ancestor.ParentRootHash32)
+		if parentRoot == t.headRoot {
+			return true, nil
+		}
+		if t.blockByRoot == nil {
+			return false, nil
+		}
+		parent, err := t.blockByRoot(parentRoot)
+		if err != nil {
+			return false, fmt.Errorf("could not get ancestor block %#x: %v", parentRoot, err)
+		}
+		if parent == nil {
+			// The ancestry can not be followed further, so there is no evidence
+			// of the head having changed branches.
+			return true, nil
+		}
+		ancestor = parent
+	}
+	return false, nil
+}
@@ -0,0 +1,173 @@
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestChainEventFilter_Allows(t *testing.T) {
+	all := newChainEventFilter(nil)
+	if !all.allows(pb.ChainEventType_REORG) {
+		t.Error("Expected empty filter to allow every event type")
+	}
+	filter := newChainEventFilter([]pb.ChainEventType{pb.ChainEventType_FINALIZED})
+	if !filter.allows(pb.ChainEventType_FINALIZED) {
+		t.Error("Expected filter to allow finalized events")
+	}
+	if filter.allows(pb.ChainEventType_HEAD) {
+		t.Error("Expected filter to reject head events")
+	}
+}
+
+func TestBlockQueue_DropsOldestBlocks(t *testing.T) {
+	q := newBlockQueue(2)
+	for i := uint64(1); i <= 3; i++ {
+		q.push(&pbp2p.BeaconBlock{Slot: i}, nil)
+	}
+	updates, dropped := q.popAll()
+	if dropped != 1 {
+		t.Errorf("Expected 1 dropped block, received %d", dropped)
+	}
+	if len(updates) != 2 || updates[0].block.Slot != 2 || updates[1].block.Slot != 3 {
+		t.Errorf("Expected blocks at slots 2 and 3, received %v", updates)
+	}
+	updates, dropped = q.popAll()
+	if len(updates) != 0 || dropped != 0 {
+		t.Errorf("Expected an empty queue, received %d blocks and %d dropped", len(updates), dropped)
+	}
+}
+
+func TestChainEventTracker_HeadAndReorg(t *testing.T) {
+	genesis := &pbp2p.BeaconBlock{Slot: 0}
+	blocks := make(map[[32]byte]*pbp2p.BeaconBlock)
+	blockByRoot := func(root [32]byte) (*pbp2p.BeaconBlock, error) {
+		return blocks[root], nil
+	}
+	tracker, err := newChainEventTracker(genesis, &pbp2p.BeaconState{}, blockByRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks[genesisRoot] = genesis
+
+	child := &pbp2p.BeaconBlock{Slot: 1, ParentRootHash32: genesisRoot[:]}
+	events, err := tracker.process(child, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != pb.ChainEventType_HEAD {
+		t.Fatalf("Expected a single head event, received %v", events)
+	}
+	if !bytes.Equal(events[0].PreviousHeadRootHash32, genesisRoot[:]) {
+		t.Errorf("Expected previous head %#x, received %#x", genesisRoot, events[0].PreviousHeadRootHash32)
+	}
+
+	fork := &pbp2p.BeaconBlock{Slot: 2, ParentRootHash32: genesisRoot[:]}
+	events, err = tracker.process(fork, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Type != pb.ChainEventType_REORG || events[1].Type != pb.ChainEventType_HEAD {
+		t.Fatalf("Expected reorg and head events, received %v", events)
+	}
+}
+
+func TestChainEventTracker_NoReorgForUnobservedAncestors(t *testing.T) {
+	genesis := &pbp2p.BeaconBlock{Slot: 0}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The block at slot 1 was dropped from the queue of the stream, so the tracker
+	// only observes its child.
+	dropped := &pbp2p.BeaconBlock{Slot: 1, ParentRootHash32: genesisRoot[:]}
+	droppedRoot, err := hashutil.HashBeaconBlock(dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := map[[32]byte]*pbp2p.BeaconBlock{genesisRoot: genesis, droppedRoot: dropped}
+	tracker, err := newChainEventTracker(genesis, &pbp2p.BeaconState{}, func(root [32]byte) (*pbp2p.BeaconBlock, error) {
+		return blocks[root], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := tracker.process(&pbp2p.BeaconBlock{Slot: 2, ParentRootHash32: droppedRoot[:]}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != pb.ChainEventType_HEAD {
+		t.Errorf("Expected a single head event, received %v", events)
+	}
+}
+
+func TestChainEventTracker_StateEvents(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	beaconState := &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{
+			{Pubkey: []byte{'A'}, ActivationEpoch: 0, ExitEpoch: farFuture},
+			{Pubkey: []byte{'B'}, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+		},
+	}
+	tracker, err := newChainEventTracker(nil, beaconState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newState := &pbp2p.BeaconState{
+		Slot:           params.BeaconConfig().EpochLength,
+		JustifiedEpoch: 1,
+		FinalizedEpoch: 0,
+		ValidatorRegistry: []*pbp2p.Validator{
+			{Pubkey: []byte{'A'}, ActivationEpoch: 0, ExitEpoch: 5},
+			{Pubkey: []byte{'B'}, ActivationEpoch: 5, ExitEpoch: farFuture},
+			{Pubkey: []byte{'C'}, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+		},
+	}
+	block := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().EpochLength,
+		Body: &pbp2p.BeaconBlockBody{
+			ProposerSlashings: []*pbp2p.ProposerSlashing{{ProposerIndex: 1}},
+		},
+	}
+	events, err := tracker.process(block, newState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wanted := []pb.ChainEventType{
+		pb.ChainEventType_HEAD,
+		pb.ChainEventType_PROPOSER_SLASHING,
+		pb.ChainEventType_JUSTIFIED,
+		pb.ChainEventType_VALIDATOR_EXITED,
+		pb.ChainEventType_VALIDATOR_ACTIVATED,
+	}
+	if len(events) != len(wanted) {
+		t.Fatalf("Expected %d events, received %v", len(wanted), events)
+	}
+	for i, event := range events {
+		if event.Type != wanted[i] {
+			t.Errorf("Expected event %d to be %v, received %v", i, wanted[i], event.Type)
+		}
+	}
+	if events[3].ValidatorIndex != 0 || events[4].ValidatorIndex != 1 {
+		t.Errorf("Unexpected validator indices in events: %v", events)
+	}
+
+	events, err = tracker.process(&pbp2p.BeaconBlock{Slot: block.Slot + 1}, newState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Errorf("Expected only reorg and head events for an unchanged state, received %v", events)
+	}
+}
@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxCachedSchedules is the number of epoch schedules kept in memory, enough for
+// the current and next epoch of the canonical chain and of a competing fork.
+const maxCachedSchedules = 4
+
+// scheduleKey identifies the inputs of an epoch's shuffling, the proposer and
+// committee schedule of an epoch only changes when one of them does.
+type scheduleKey struct {
+	epoch               uint64
+	seed                [32]byte
+	startShard          uint64
+	calculationEpoch    uint64
+	registryUpdateEpoch uint64
+	registrySize        int
+}
+
+// dutySchedule is the proposer and committee schedule of every active validator
+// for a single epoch, keyed by validator index.
+type dutySchedule struct {
+	epoch  uint64
+	duties map[uint64]*pb.Assignment
+}
+
+// assignment returns the assignment of the validator at the given index, which is
+// empty for validators which are not active during the epoch.
+func (s *dutySchedule) assignment(index uint64, pubKey []byte) *pb.Assignment {
+	assignment := &pb.Assignment{PublicKey: pubKey}
+	if duty, ok := s.duties[index]; ok {
+		assignment.Shard = duty.Shard
+		assignment.AttesterSlot = duty.AttesterSlot
+		assignment.ProposerSlot = duty.ProposerSlot
+	}
+	return assignment
+}
+
+// scheduleCache computes the duty schedule of an epoch once and shares it between
+// every validator requesting its assignments, instead of reshuffling the registry
+// for each of them.
+type scheduleCache struct {
+	lock      sync.Mutex
+	schedules map[scheduleKey]*dutySchedule
+	keys      []scheduleKey
+}
+
+func newScheduleCache() *scheduleCache {
+	return &scheduleCache{
+		schedules: make(map[scheduleKey]*dutySchedule),
+	}
+}
+
+// schedule returns the duty schedule of the given epoch as seen from the given state,
+// computing it if it is not cached yet. The lock is held during the computation so
+// concurrent requests at the start of an epoch only shuffle the registry once.
+func (c *scheduleCache) schedule(beaconState *pbp2p.BeaconState, epoch uint64) (*dutySchedule, error) {
+	key := newScheduleKey(beaconState, epoch)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if schedule, ok := c.schedules[key]; ok {
+		return schedule, nil
+	}
+	schedule, err := computeDutySchedule(beaconState, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.keys) >= maxCachedSchedules {
+		delete(c.schedules, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.schedules[key] = schedule
+	c.keys = append(c.keys, key)
+	return schedule, nil
+}
+
+func newScheduleKey(beaconState *pbp2p.BeaconState, epoch uint64) scheduleKey {
+	key := scheduleKey{
+		epoch:               epoch,
+		registryUpdateEpoch: beaconState.ValidatorRegistryUpdateEpoch,
+		registrySize:        len(beaconState.ValidatorRegistry),
+	}
+	if epoch < helpers.CurrentEpoch(beaconState) {
+		key.seed = bytesutil.ToBytes32(beaconState.PreviousEpochSeedHash32)
+		key.startShard = beaconState.PreviousEpochStartShard
+		key.calculationEpoch = beaconState.PreviousCalculationEpoch
+	} else {
+		// The next epoch's shuffling is derived from the current epoch's parameters.
+		key.seed = bytesutil.ToBytes32(beaconState.CurrentEpochSeedHash32)
+		key.startShard = beaconState.CurrentEpochStartShard
+		key.calculationEpoch = beaconState.CurrentCalculationEpoch
+	}
+	return key
+}
+
+// computeDutySchedule determines the attester slot, shard and proposer slot of every
+// active validator during the given epoch, which must be within the previous and next
+// epoch of the state. Proposers are derived from the slot's committees the same way
+// validators.BeaconProposerIdx does, to avoid shuffling the registry twice per slot.
+func computeDutySchedule(beaconState *pbp2p.BeaconState, epoch uint64) (*dutySchedule, error) {
+	schedule := &dutySchedule{
+		epoch:  epoch,
+		duties: make(map[uint64]*pb.Assignment),
+	}
+	duty := func(index uint64) *pb.Assignment {
+		if _, ok := schedule.duties[index]; !ok {
+			schedule.duties[index] = &pb.Assignment{}
+		}
+		return schedule.duties[index]
+	}
+	startSlot := helpers.StartSlot(epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().EpochLength; slot++ {
+		crosslinkCommittees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			return nil, fmt.Errorf("could not get committees at slot %d: %v", slot, err)
+		}
+		// Slots without a first committee member have no proposer, which happens
+		// when there are less active validators than slots in an epoch.
+		if len(crosslinkCommittees) > 0 && len(crosslinkCommittees[0].Committee) > 0 {
+			firstCommittee := crosslinkCommittees[0].Committee
+			duty(firstCommittee[slot%uint64(len(firstCommittee))]).ProposerSlot = slot
+		}
+		for _, committee := range crosslinkCommittees {
+			for _, index := range committee.Committee {
+				duty(index).AttesterSlot = slot
+				duty(index).Shard = committee.Shard
+			}
+		}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestComputeDutySchedule_MatchesCommittees(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	numValidators := int(params.BeaconConfig().EpochLength * 2)
+	beaconState := setupQueryServerState(t, beaconDB, numValidators)
+
+	schedule, err := computeDutySchedule(beaconState, 0)
+	if err != nil {
+		t.Fatalf("Could not compute duty schedule: %v", err)
+	}
+	if len(schedule.duties) != numValidators {
+		t.Errorf("Expected a duty for each of the %d active validators, received %d", numValidators, len(schedule.duties))
+	}
+	for slot := uint64(0); slot < params.BeaconConfig().EpochLength; slot++ {
+		proposer, err := v.BeaconProposerIdx(beaconState, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schedule.duties[proposer].ProposerSlot < slot {
+			t.Errorf("Expected validator %d to propose at slot %d or later, received %d",
+				proposer, slot, schedule.duties[proposer].ProposerSlot)
+		}
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, committee := range committees {
+			for _, index := range committee.Committee {
+				duty := schedule.duties[index]
+				if duty.AttesterSlot != slot || duty.Shard != committee.Shard {
+					t.Errorf("Expected validator %d to attest at slot %d to shard %d, received %v",
+						index, slot, committee.Shard, duty)
+				}
+			}
+		}
+	}
+}
+
+func TestScheduleCache_ReusesScheduleOfSameShuffling(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	beaconState := setupQueryServerState(t, beaconDB, 8)
+	cache := newScheduleCache()
+
+	first, err := cache.schedule(beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Blocks within the epoch do not change the shuffling.
+	beaconState.Slot++
+	second, err := cache.schedule(beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("Expected the cached schedule to be reused")
+	}
+
+	beaconState = proto.Clone(beaconState).(*pbp2p.BeaconState)
+	beaconState.CurrentEpochSeedHash32 = []byte{'a'}
+	third, err := cache.schedule(beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == third {
+		t.Error("Expected a new schedule once the epoch seed changed")
+	}
+
+	for i := 0; i < maxCachedSchedules+2; i++ {
+		beaconState.CurrentEpochStartShard = uint64(i)
+		if _, err := cache.schedule(beaconState, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(cache.schedules) != maxCachedSchedules || len(cache.keys) != maxCachedSchedules {
+		t.Errorf("Expected %d cached schedules, received %d", maxCachedSchedules, len(cache.schedules))
+	}
+}
@@ -0,0 +1,591 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// gatewayRoute defines how a single gateway endpoint decodes its request
+// and calls into the corresponding RPC server method.
+type gatewayRoute struct {
+	// write is set for the methods which change the state of the node or broadcast
+	// to the network, which are only served if the gateway enables them.
+	write   bool
+	request func() proto.Message
+	unary   func(ctx context.Context, req proto.Message) (proto.Message, error)
+	stream  func(req proto.Message, stream *gatewayStream) error
+}
+
+// gateway exposes the beacon node RPC services as JSON over HTTP. Requests are
+// decoded from the JSON body (or the URL query for GET requests) and responses
+// are encoded as JSON objects, with byte fields hex encoded and 64-bit integers
+// encoded as strings. Streaming RPCs respond with newline delimited JSON.
+// The gateway does not authenticate its clients, so only read-only methods are
+// served unless write methods are explicitly enabled.
+type gateway struct {
+	server *http.Server
+	routes map[string]*gatewayRoute
+}
+
+// newGateway creates a JSON/HTTP gateway listening on the given address which
+// routes requests to the given RPC servers. Methods proposing blocks, attestations
+// or exits are only routed if enableWrites is set.
+func newGateway(
+	addr string,
+	enableWrites bool,
+	beaconServer pb.BeaconServiceServer,
+	validatorServer pb.ValidatorServiceServer,
+	proposerServer pb.ProposerServiceServer,
+	attesterServer pb.AttesterServiceServer,
+	queryServer pb.QueryServiceServer,
+) *gateway {
+	empty := func() proto.Message { return &ptypes.Empty{} }
+	g := &gateway{
+		routes: map[string]*gatewayRoute{
+			"/v1/beacon/wait_for_chain_start": {
+				request: empty,
+				stream: func(req proto.Message, stream *gatewayStream) error {
+					return beaconServer.WaitForChainStart(req.(*ptypes.Empty), &chainStartGatewayStream{stream})
+				},
+			},
+			"/v1/beacon/canonical_head": {
+				request: empty,
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return beaconServer.CanonicalHead(ctx, req.(*ptypes.Empty))
+				},
+			},
+			"/v1/beacon/latest_attestation": {
+				request: empty,
+				stream: func(req proto.Message, stream *gatewayStream) error {
+					return beaconServer.LatestAttestation(req.(*ptypes.Empty), &attestationGatewayStream{stream})
+				},
+			},
+			"/v1/beacon/pending_deposits": {
+				request: empty,
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return beaconServer.PendingDeposits(ctx, req.(*ptypes.Empty))
+				},
+			},
+			"/v1/beacon/eth1_data": {
+				request: empty,
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return beaconServer.Eth1Data(ctx, req.(*ptypes.Empty))
+				},
+			},
+			"/v1/beacon/chain_config": {
+				request: empty,
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return beaconServer.ChainConfig(ctx, req.(*ptypes.Empty))
+				},
+			},
+			"/v1/beacon/chain_events": {
+				request: func() proto.Message { return &pb.ChainEventsRequest{} },
+				stream: func(req proto.Message, stream *gatewayStream) error {
+					return beaconServer.StreamChainEvents(req.(*pb.ChainEventsRequest), &chainEventGatewayStream{stream})
+				},
+			},
+			"/v1/validator/validator_index": {
+				request: func() proto.Message { return &pb.ValidatorIndexRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return validatorServer.ValidatorIndex(ctx, req.(*pb.ValidatorIndexRequest))
+				},
+			},
+			"/v1/validator/validator_epoch_assignments": {
+				request: func() proto.Message { return &pb.ValidatorEpochAssignmentsRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return validatorServer.ValidatorEpochAssignments(ctx, req.(*pb.ValidatorEpochAssignmentsRequest))
+				},
+			},
+			"/v1/validator/validator_status": {
+				request: func() proto.Message { return &pb.ValidatorStatusRequest{} },
+				stream: func(req proto.Message, stream *gatewayStream) error {
+					return validatorServer.ValidatorStatus(req.(*pb.ValidatorStatusRequest), &validatorStatusGatewayStream{stream})
+				},
+			},
+			"/v1/validator/validator_performance": {
+				request: func() proto.Message { return &pb.ValidatorPerformanceRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return validatorServer.ValidatorPerformance(ctx, req.(*pb.ValidatorPerformanceRequest))
+				},
+			},
+			"/v1/proposer/proposer_index": {
+				request: func() proto.Message { return &pb.ProposerIndexRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return proposerServer.ProposerIndex(ctx, req.(*pb.ProposerIndexRequest))
+				},
+			},
+			"/v1/proposer/propose_block": {
+				write:   true,
+				request: func() proto.Message { return &pbp2p.BeaconBlock{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return proposerServer.ProposeBlock(ctx, req.(*pbp2p.BeaconBlock))
+				},
+			},
+			"/v1/proposer/compute_state_root": {
+				request: func() proto.Message { return &pbp2p.BeaconBlock{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return proposerServer.ComputeStateRoot(ctx, req.(*pbp2p.BeaconBlock))
+				},
+			},
+			"/v1/proposer/pending_operations": {
+				request: func() proto.Message { return &pb.PendingOperationsRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return proposerServer.PendingOperations(ctx, req.(*pb.PendingOperationsRequest))
+				},
+			},
+			"/v1/proposer/propose_exit": {
+				write:   true,
+				request: func() proto.Message { return &pbp2p.Exit{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return proposerServer.ProposeExit(ctx, req.(*pbp2p.Exit))
+				},
+			},
+			"/v1/attester/attest_head": {
+				write:   true,
+				request: func() proto.Message { return &pbp2p.Attestation{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return attesterServer.AttestHead(ctx, req.(*pbp2p.Attestation))
+				},
+			},
+			"/v1/attester/crosslink_committees_at_slot": {
+				request: func() proto.Message { return &pb.CrosslinkCommitteeRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return attesterServer.CrosslinkCommitteesAtSlot(ctx, req.(*pb.CrosslinkCommitteeRequest))
+				},
+			},
+			"/v1/attester/attestation_info_at_slot": {
+				request: func() proto.Message { return &pb.AttestationInfoRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return attesterServer.AttestationInfoAtSlot(ctx, req.(*pb.AttestationInfoRequest))
+				},
+			},
+			"/v1/query/block_by_root": {
+				request: func() proto.Message { return &pb.BlockByRootRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.BlockByRoot(ctx, req.(*pb.BlockByRootRequest))
+				},
+			},
+			"/v1/query/blocks_by_slot_range": {
+				request: func() proto.Message { return &pb.BlocksBySlotRangeRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.BlocksBySlotRange(ctx, req.(*pb.BlocksBySlotRangeRequest))
+				},
+			},
+			"/v1/query/state_by_root": {
+				request: func() proto.Message { return &pb.StateByRootRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.StateByRoot(ctx, req.(*pb.StateByRootRequest))
+				},
+			},
+			"/v1/query/validator_by_index": {
+				request: func() proto.Message { return &pb.ValidatorByIndexRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.ValidatorByIndex(ctx, req.(*pb.ValidatorByIndexRequest))
+				},
+			},
+			"/v1/query/validator_by_public_key": {
+				request: func() proto.Message { return &pb.ValidatorIndexRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.ValidatorByPublicKey(ctx, req.(*pb.ValidatorIndexRequest))
+				},
+			},
+			"/v1/query/validator_balances": {
+				request: func() proto.Message { return &pb.EpochRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.ValidatorBalances(ctx, req.(*pb.EpochRequest))
+				},
+			},
+			"/v1/query/committees": {
+				request: func() proto.Message { return &pb.EpochRequest{} },
+				unary: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+					return queryServer.Committees(ctx, req.(*pb.EpochRequest))
+				},
+			},
+		},
+	}
+	if !enableWrites {
+		for path, route := range g.routes {
+			if route.write {
+				delete(g.routes, path)
+			}
+		}
+	}
+	g.server = &http.Server{
+		Addr:    addr,
+		Handler: g,
+	}
+	return g
+}
+
+// ServeHTTP routes a request to its RPC method and writes the JSON encoded response.
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, ok := g.routes[r.URL.Path]
+	if !ok {
+		writeGatewayError(w, status.Errorf(codes.NotFound, "no RPC method for path %s", r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeGatewayError(w, status.Errorf(codes.Unimplemented, "method %s is not supported", r.Method))
+		return
+	}
+
+	req := route.request()
+	if err := decodeGatewayRequest(r, req); err != nil {
+		writeGatewayError(w, status.Errorf(codes.InvalidArgument, "could not decode request: %v", err))
+		return
+	}
+
+	if route.stream != nil {
+		stream := &gatewayStream{ctx: r.Context(), w: w}
+		if err := route.stream(req, stream); err != nil {
+			if !stream.started {
+				writeGatewayError(w, err)
+				return
+			}
+			log.Debugf("Gateway stream %s closed with error: %v", r.URL.Path, err)
+		}
+		return
+	}
+
+	res, err := route.unary(r.Context(), req)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(encodeGatewayValue(reflect.ValueOf(res))); err != nil {
+		log.Errorf("Could not write gateway response: %v", err)
+	}
+}
+
+// gatewayStream implements grpc.ServerStream on top of an HTTP response, writing every
+// sent message as a single line of JSON and flushing it right away.
+type gatewayStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *gatewayStream) SetHeader(metadata.MD) error  { return nil }
+func (s *gatewayStream) SendHeader(metadata.MD) error { return nil }
+func (s *gatewayStream) SetTrailer(metadata.MD)       {}
+func (s *gatewayStream) Context() context.Context     { return s.ctx }
+func (s *gatewayStream) RecvMsg(m interface{}) error  { return io.EOF }
+
+// SendMsg writes a message to the HTTP response as newline delimited JSON.
+func (s *gatewayStream) SendMsg(m interface{}) error {
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.started = true
+	}
+	if err := json.NewEncoder(s.w).Encode(encodeGatewayValue(reflect.ValueOf(m))); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+type chainStartGatewayStream struct{ *gatewayStream }
+
+func (s *chainStartGatewayStream) Send(m *pb.ChainStartResponse) error { return s.SendMsg(m) }
+
+type attestationGatewayStream struct{ *gatewayStream }
+
+func (s *attestationGatewayStream) Send(m *pbp2p.Attestation) error { return s.SendMsg(m) }
+
+type chainEventGatewayStream struct{ *gatewayStream }
+
+func (s *chainEventGatewayStream) Send(m *pb.ChainEvent) error { return s.SendMsg(m) }
+
+type validatorStatusGatewayStream struct{ *gatewayStream }
+
+func (s *validatorStatusGatewayStream) Send(m *pb.ValidatorStatusResponse) error { return s.SendMsg(m) }
+
+// writeGatewayError writes an error as a JSON object, using the HTTP status
+// corresponding to the error's gRPC status code.
+func writeGatewayError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromCode(st.Code()))
+	res := map[string]interface{}{
+		"code":  st.Code().String(),
+		"error": st.Message(),
+	}
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Errorf("Could not write gateway error: %v", err)
+	}
+}
+
+// httpStatusFromCode maps a gRPC status code to its closest HTTP status. Errors returned
+// by the RPC servers without a status code are reported as internal server errors.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// decodeGatewayRequest fills a request message from the JSON body of a POST request,
+// or from the URL query parameters of a GET request.
+func decodeGatewayRequest(r *http.Request, req proto.Message) error {
+	var fields map[string]interface{}
+	if r.Method == http.MethodGet {
+		fields = make(map[string]interface{})
+		for key, values := range r.URL.Query() {
+			if len(values) == 1 {
+				fields[key] = values[0]
+				continue
+			}
+			list := make([]interface{}, len(values))
+			for i, v := range values {
+				list[i] = v
+			}
+			fields[key] = list
+		}
+	} else {
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(&fields); err != nil && err != io.EOF {
+			return err
+		}
+	}
+	return decodeGatewayValue(fields, reflect.ValueOf(req).Elem(), "")
+}
+
+// encodeGatewayValue converts a protobuf message into a value which encodes to
+// the gateway's JSON representation.
+func encodeGatewayValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeGatewayValue(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			name := gatewayFieldName(v.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			fields[name] = encodeGatewayValue(v.Field(i))
+		}
+		return fields
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return "0x" + hex.EncodeToString(v.Bytes())
+		}
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list[i] = encodeGatewayValue(v.Index(i))
+		}
+		return list
+	case reflect.Int32:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+		return v.Int()
+	case reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// decodeGatewayValue sets v from a decoded JSON value. The enum argument holds the
+// protobuf enum name of v, if any, so enums may be given by name.
+func decodeGatewayValue(data interface{}, v reflect.Value, enum string) error {
+	if data == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeGatewayValue(data, v.Elem(), enum)
+	case reflect.Struct:
+		fields, ok := data.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected object, received %v", data)
+		}
+		known := make(map[string]bool)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := gatewayFieldName(field)
+			if name == "" {
+				continue
+			}
+			known[name] = true
+			if err := decodeGatewayValue(fields[name], v.Field(i), gatewayEnumName(field)); err != nil {
+				return fmt.Errorf("invalid field %s: %v", name, err)
+			}
+		}
+		for name := range fields {
+			if !known[name] {
+				return fmt.Errorf("unknown field %s", name)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := data.(string)
+			if !ok {
+				return fmt.Errorf("expected hex string, received %v", data)
+			}
+			b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+			if err != nil {
+				return err
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		list, ok := data.([]interface{})
+		if !ok {
+			// A single query parameter may be given for a repeated field.
+			list = []interface{}{data}
+		}
+		slice := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := decodeGatewayValue(item, slice.Index(i), enum); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Bool:
+		switch b := data.(type) {
+		case bool:
+			v.SetBool(b)
+		case string:
+			parsed, err := strconv.ParseBool(b)
+			if err != nil {
+				return err
+			}
+			v.SetBool(parsed)
+		default:
+			return fmt.Errorf("expected boolean, received %v", data)
+		}
+		return nil
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return fmt.Errorf("expected string, received %v", data)
+		}
+		v.SetString(s)
+		return nil
+	case reflect.Int32, reflect.Int64:
+		s := fmt.Sprint(data)
+		if enum != "" {
+			if value, ok := proto.EnumValueMap(enum)[s]; ok {
+				v.SetInt(int64(value))
+				return nil
+			}
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(fmt.Sprint(data), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+}
+
+// gatewayFieldName returns the JSON name of a generated protobuf struct field,
+// or an empty string for the internal XXX_ fields.
+func gatewayFieldName(field reflect.StructField) string {
+	if strings.HasPrefix(field.Name, "XXX_") {
+		return ""
+	}
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// gatewayEnumName returns the protobuf enum name of a generated struct field, if any.
+func gatewayEnumName(field reflect.StructField) string {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "enum=") {
+			return strings.TrimPrefix(part, "enum=")
+		}
+	}
+	return ""
+}
+
+// start serves the gateway, using TLS if a certificate and key are provided.
+func (g *gateway) start(cert string, key string) {
+	go func() {
+		var err error
+		if cert != "" && key != "" {
+			err = g.server.ListenAndServeTLS(cert, key)
+		} else {
+			err = g.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("Could not serve JSON/HTTP gateway: %v", err)
+		}
+	}()
+}
+
+// stop gracefully shuts down the gateway, closing any open streams after a timeout.
+func (g *gateway) stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := g.server.Shutdown(ctx); err != nil {
+		return g.server.Close()
+	}
+	return nil
+}
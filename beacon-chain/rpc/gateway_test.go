@@ -0,0 +1,173 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGateway_QueryParametersAndHexEncoding(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	setupQueryServerState(t, beaconDB, 2)
+	g := newGateway(":0", false, nil, nil, nil, nil, &QueryServer{beaconDB: beaconDB})
+
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/query/validator_by_index?index=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, received %d: %s", rec.Code, rec.Body.String())
+	}
+	res := make(map[string]interface{})
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res["public_key"] != "0x01" {
+		t.Errorf("Expected hex encoded public key 0x01, received %v", res["public_key"])
+	}
+	if res["index"] != "1" {
+		t.Errorf("Expected index encoded as string 1, received %v", res["index"])
+	}
+	if res["status"] != pb.ValidatorStatus_ACTIVE.String() {
+		t.Errorf("Expected status %s, received %v", pb.ValidatorStatus_ACTIVE, res["status"])
+	}
+}
+
+func TestGateway_PostBody(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	g := newGateway(":0", false, nil, nil, nil, nil, &QueryServer{beaconDB: beaconDB})
+
+	block := &pbp2p.BeaconBlock{Slot: 3, ParentRootHash32: []byte{'a'}}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := fmt.Sprintf(`{"block_root_hash32": "%#x"}`, root)
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/query/block_by_root", strings.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, received %d: %s", rec.Code, rec.Body.String())
+	}
+	res := make(map[string]interface{})
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res["slot"] != "3" || res["parent_root_hash32"] != "0x61" {
+		t.Errorf("Received unexpected block: %v", res)
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	g := newGateway(":0", false, nil, nil, nil, nil, &QueryServer{beaconDB: beaconDB})
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		code   int
+	}{
+		{method: http.MethodGet, path: "/v1/unknown", code: http.StatusNotFound},
+		{method: http.MethodDelete, path: "/v1/query/block_by_root", code: http.StatusNotImplemented},
+		{method: http.MethodPost, path: "/v1/query/block_by_root", body: `{"block_root_hash32": "0xzz"}`, code: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/query/block_by_root", body: `{"unknown": 1}`, code: http.StatusBadRequest},
+		{method: http.MethodPost, path: "/v1/query/block_by_root", body: `{"block_root_hash32": "0x01"}`, code: http.StatusNotFound},
+		{method: http.MethodPost, path: "/v1/query/validator_balances", body: `{"epoch": "1"}`, code: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		g.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+		if rec.Code != tt.code {
+			t.Errorf("%s %s: expected status %d, received %d", tt.method, tt.path, tt.code, rec.Code)
+		}
+		res := make(map[string]interface{})
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("Could not decode error response: %v", err)
+		}
+		if res["error"] == "" {
+			t.Errorf("%s %s: expected error message in response", tt.method, tt.path)
+		}
+	}
+}
+
+func TestGateway_EncodeDecodeRoundTrip(t *testing.T) {
+	want := &pb.ChainEventsRequest{
+		EventTypes: []pb.ChainEventType{pb.ChainEventType_REORG, pb.ChainEventType_FINALIZED},
+	}
+	enc, err := json.Marshal(encodeGatewayValue(reflect.ValueOf(want)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(enc), "REORG") {
+		t.Errorf("Expected enums to be encoded by name, received %s", enc)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(enc)))
+	got := &pb.ChainEventsRequest{}
+	if err := decodeGatewayRequest(r, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(want, got) {
+		t.Errorf("Expected %v, received %v", want, got)
+	}
+}
+
+func TestGatewayStream_WritesDelimitedJSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	stream := &chainEventGatewayStream{&gatewayStream{ctx: context.Background(), w: rec}}
+	for i := uint64(1); i <= 2; i++ {
+		if err := stream.Send(&pb.ChainEvent{Slot: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, received %d", len(lines))
+	}
+	if rec.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Errorf("Unexpected content type %s", rec.Header().Get("Content-Type"))
+	}
+}
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	if code := httpStatusFromCode(codes.NotFound); code != http.StatusNotFound {
+		t.Errorf("Expected status 404, received %d", code)
+	}
+	if code := httpStatusFromCode(codes.Unknown); code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, received %d", code)
+	}
+}
+
+func TestGateway_WriteMethodsDisabledByDefault(t *testing.T) {
+	g := newGateway(":0", false, nil, nil, nil, nil, nil)
+	for _, path := range []string{"/v1/proposer/propose_block", "/v1/proposer/propose_exit", "/v1/attester/attest_head"} {
+		rec := httptest.NewRecorder()
+		g.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected %s to be disabled, received status %d", path, rec.Code)
+		}
+	}
+	if _, ok := g.routes["/v1/query/block_by_root"]; !ok {
+		t.Error("Expected read-only methods to be served")
+	}
+
+	g = newGateway(":0", true, nil, nil, nil, nil, nil)
+	if _, ok := g.routes["/v1/proposer/propose_exit"]; !ok {
+		t.Error("Expected write methods to be served once enabled")
+	}
+}
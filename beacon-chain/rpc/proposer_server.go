@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -21,6 +23,8 @@ type ProposerServer struct {
 	beaconDB           *db.BeaconDB
 	chainService       chainService
 	powChainService    powChainService
+	operationService   operationService
+	p2p                p2pAPI
 	canonicalStateChan chan *pbp2p.BeaconState
 }
 
@@ -90,3 +94,48 @@ func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.Beaco
 		StateRoot: beaconStateHash[:],
 	}, nil
 }
+
+// PendingOperations returns the pooled attestations, slashings and exits which a block
+// proposed at the requested slot on top of the current head can include.
+func (ps *ProposerServer) PendingOperations(ctx context.Context, req *pb.PendingOperationsRequest) (*pb.PendingOperationsResponse, error) {
+	beaconState, err := ps.beaconDB.State()
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	body, err := ps.operationService.PendingOperations(beaconState, req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending operations: %v", err)
+	}
+	return &pb.PendingOperationsResponse{
+		Attestations:      body.Attestations,
+		ProposerSlashings: body.ProposerSlashings,
+		AttesterSlashings: body.AttesterSlashings,
+		Exits:             body.Exits,
+	}, nil
+}
+
+// ProposeExit verifies a validator's exit against the current head state, relays it to
+// the operations service to be pooled for inclusion in a block, and broadcasts it to
+// the network. It returns the earliest epoch the validator can exit at, which is only a
+// lower bound: the exit is delayed when the exits queued before it exceed the churn
+// limit of the registry, which is only known once the exit is processed.
+func (ps *ProposerServer) ProposeExit(ctx context.Context, exit *pbp2p.Exit) (*pb.ProposeExitResponse, error) {
+	beaconState, err := ps.beaconDB.State()
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	if err := blocks.VerifyExit(beaconState, exit, true /* verify signatures */); err != nil {
+		return nil, fmt.Errorf("invalid exit: %v", err)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	log.WithField("exitHash", fmt.Sprintf("%#x", h)).Debugf("Exit received via RPC")
+	ps.operationService.IncomingExitFeed().Send(exit)
+	ps.p2p.Broadcast(exit)
+	return &pb.ProposeExitResponse{
+		ExitHash:  h[:],
+		ExitEpoch: helpers.EntryExitEffectEpoch(helpers.CurrentEpoch(beaconState)),
+	}, nil
+}
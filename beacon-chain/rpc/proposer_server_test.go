@@ -6,10 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -124,3 +127,78 @@ func TestComputeStateRoot(t *testing.T) {
 
 	_, _ = proposerServer.ComputeStateRoot(context.Background(), req)
 }
+
+func TestPendingOperations(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	if err := db.SaveState(&pbp2p.BeaconState{Slot: 10}); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	proposerServer := &ProposerServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+	}
+
+	res, err := proposerServer.PendingOperations(context.Background(), &pb.PendingOperationsRequest{Slot: 11})
+	if err != nil {
+		t.Fatalf("Could not get pending operations: %v", err)
+	}
+	if len(res.Exits) != 1 || res.Exits[0].Slot != 10 {
+		t.Errorf("Expected the operations pending on top of the state at slot 10, received %v", res.Exits)
+	}
+	if _, err := proposerServer.PendingOperations(context.Background(), &pb.PendingOperationsRequest{Slot: 10}); err == nil {
+		t.Error("Expected error when requesting operations for the slot of the head state")
+	}
+}
+
+func TestProposeExit(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconState := &pbp2p.BeaconState{
+		Slot: 2 * params.BeaconConfig().EpochLength,
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+	}
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	p2p := &mockP2P{}
+	proposerServer := &ProposerServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+		p2p:              p2p,
+	}
+
+	exit := &pbp2p.Exit{
+		Slot:           beaconState.Slot,
+		ValidatorIndex: 1,
+	}
+	res, err := proposerServer.ProposeExit(context.Background(), exit)
+	if err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	wantEpoch := helpers.EntryExitEffectEpoch(helpers.CurrentEpoch(beaconState))
+	if res.ExitEpoch != wantEpoch {
+		t.Errorf("Expected exit epoch %d, received %d", wantEpoch, res.ExitEpoch)
+	}
+	if len(p2p.broadcasted) != 1 || !proto.Equal(p2p.broadcasted[0], exit) {
+		t.Errorf("Expected exit %v to be broadcasted, received %v", exit, p2p.broadcasted)
+	}
+
+	invalidExits := []*pbp2p.Exit{
+		{Slot: beaconState.Slot, ValidatorIndex: 2},
+		{Slot: beaconState.Slot + 1, ValidatorIndex: 1},
+	}
+	for _, invalid := range invalidExits {
+		if _, err := proposerServer.ProposeExit(context.Background(), invalid); err == nil {
+			t.Errorf("Expected error when proposing invalid exit %v", invalid)
+		}
+	}
+	if len(p2p.broadcasted) != 1 {
+		t.Errorf("Expected invalid exits not to be broadcasted, received %v", p2p.broadcasted)
+	}
+}
@@ -0,0 +1,225 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSlotRange is the maximum number of slots which can be requested
+// at once through BlocksBySlotRange.
+const maxSlotRange = 1024
+
+// QueryServer defines a server implementation of the gRPC Query service,
+// providing RPC endpoints for looking up blocks, states, validators and
+// committees stored by the beacon node.
+type QueryServer struct {
+	beaconDB *db.BeaconDB
+}
+
+// BlockByRoot returns the block with the given block root.
+func (qs *QueryServer) BlockByRoot(ctx context.Context, req *pb.BlockByRootRequest) (*pbp2p.BeaconBlock, error) {
+	block, err := qs.beaconDB.Block(bytesutil.ToBytes32(req.BlockRootHash32))
+	if err != nil {
+		return nil, fmt.Errorf("could not get block: %v", err)
+	}
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "block with root %#x not found", req.BlockRootHash32)
+	}
+	return block, nil
+}
+
+// BlocksBySlotRange returns the canonical blocks between the start and end slots, inclusive.
+// Skipped slots are not part of the response, and the end slot is capped at the head slot.
+func (qs *QueryServer) BlocksBySlotRange(ctx context.Context, req *pb.BlocksBySlotRangeRequest) (*pb.BlocksResponse, error) {
+	if req.EndSlot < req.StartSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "end slot %d is lower than start slot %d", req.EndSlot, req.StartSlot)
+	}
+	if req.EndSlot-req.StartSlot >= maxSlotRange {
+		return nil, status.Errorf(codes.InvalidArgument, "requested %d slots, cannot request more than %d slots at once",
+			req.EndSlot-req.StartSlot+1, maxSlotRange)
+	}
+	head, err := qs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get chain head: %v", err)
+	}
+	if req.StartSlot > head.Slot {
+		return nil, status.Errorf(codes.OutOfRange, "start slot %d is after the head slot %d", req.StartSlot, head.Slot)
+	}
+	endSlot := req.EndSlot
+	if endSlot > head.Slot {
+		endSlot = head.Slot
+	}
+	var blocks []*pbp2p.BeaconBlock
+	for slot := req.StartSlot; slot <= endSlot; slot++ {
+		block, err := qs.beaconDB.BlockBySlot(slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not get block at slot %d: %v", slot, err)
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	return &pb.BlocksResponse{Blocks: blocks}, nil
+}
+
+// StateByRoot returns the beacon state with the given state root, looking up the
+// canonical state first and the stored unfinalized block states otherwise.
+func (qs *QueryServer) StateByRoot(ctx context.Context, req *pb.StateByRootRequest) (*pbp2p.BeaconState, error) {
+	stateRoot := bytesutil.ToBytes32(req.StateRootHash32)
+	beaconState, err := qs.canonicalState()
+	if err != nil {
+		return nil, err
+	}
+	// TODO(#1074): Replace with tree-hashing algorithm.
+	canonicalRoot, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash beacon state: %v", err)
+	}
+	if canonicalRoot == stateRoot {
+		return beaconState, nil
+	}
+	beaconState, err = qs.beaconDB.UnfinalizedBlockState(stateRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state: %v", err)
+	}
+	if beaconState == nil {
+		return nil, status.Errorf(codes.NotFound, "state with root %#x not found", req.StateRootHash32)
+	}
+	return beaconState, nil
+}
+
+// ValidatorByIndex returns the registry record, status and balance of the
+// validator at the given index.
+func (qs *QueryServer) ValidatorByIndex(ctx context.Context, req *pb.ValidatorByIndexRequest) (*pb.ValidatorInfo, error) {
+	beaconState, err := qs.canonicalState()
+	if err != nil {
+		return nil, err
+	}
+	if req.Index >= uint64(len(beaconState.ValidatorRegistry)) {
+		return nil, status.Errorf(codes.NotFound, "validator index %d out of range, registry size is %d",
+			req.Index, len(beaconState.ValidatorRegistry))
+	}
+	return validatorInfo(beaconState, req.Index), nil
+}
+
+// ValidatorByPublicKey returns the registry record, status and balance of the
+// validator with the given public key.
+func (qs *QueryServer) ValidatorByPublicKey(ctx context.Context, req *pb.ValidatorIndexRequest) (*pb.ValidatorInfo, error) {
+	beaconState, err := qs.canonicalState()
+	if err != nil {
+		return nil, err
+	}
+	index, err := v.ValidatorIdx(req.PublicKey, beaconState.ValidatorRegistry)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator index: %v", err)
+	}
+	return validatorInfo(beaconState, index), nil
+}
+
+// ValidatorBalances returns the balances of every validator in the registry. Only
+// the balances of the current epoch are available as historical states are not kept.
+func (qs *QueryServer) ValidatorBalances(ctx context.Context, req *pb.EpochRequest) (*pb.ValidatorBalancesResponse, error) {
+	beaconState, err := qs.canonicalState()
+	if err != nil {
+		return nil, err
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	if req.Epoch != currentEpoch {
+		return nil, fmt.Errorf("balances are only available for the current epoch %d, requested epoch %d",
+			currentEpoch, req.Epoch)
+	}
+	return &pb.ValidatorBalancesResponse{
+		Epoch:    currentEpoch,
+		Balances: beaconState.ValidatorBalances,
+	}, nil
+}
+
+// Committees returns the crosslink committees of every slot in the given epoch,
+// which must be within the previous and next epoch of the canonical state.
+func (qs *QueryServer) Committees(ctx context.Context, req *pb.EpochRequest) (*pb.CommitteesResponse, error) {
+	beaconState, err := qs.canonicalState()
+	if err != nil {
+		return nil, err
+	}
+	var committees []*pb.EpochCommittee
+	startSlot := helpers.StartSlot(req.Epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().EpochLength; slot++ {
+		crosslinkCommittees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			return nil, fmt.Errorf("could not get committees at slot %d: %v", slot, err)
+		}
+		for _, committee := range crosslinkCommittees {
+			committees = append(committees, &pb.EpochCommittee{
+				Slot:      slot,
+				Shard:     committee.Shard,
+				Committee: committee.Committee,
+			})
+		}
+	}
+	return &pb.CommitteesResponse{
+		Epoch:      req.Epoch,
+		Committees: committees,
+	}, nil
+}
+
+// canonicalState fetches the canonical beacon state, which is unavailable
+// until the beacon chain has started.
+func (qs *QueryServer) canonicalState() (*pbp2p.BeaconState, error) {
+	beaconState, err := qs.beaconDB.State()
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	if beaconState == nil {
+		return nil, status.Error(codes.Unavailable, "beacon state is not initialized")
+	}
+	return beaconState, nil
+}
+
+// validatorInfo builds the validator info of the validator at the given index of the state's registry.
+func validatorInfo(beaconState *pbp2p.BeaconState, index uint64) *pb.ValidatorInfo {
+	validator := beaconState.ValidatorRegistry[index]
+	var balance uint64
+	if index < uint64(len(beaconState.ValidatorBalances)) {
+		balance = beaconState.ValidatorBalances[index]
+	}
+	return &pb.ValidatorInfo{
+		Index:                    index,
+		PublicKey:                validator.Pubkey,
+		Status:                   validatorStatus(validator, helpers.CurrentEpoch(beaconState)),
+		Balance:                  balance,
+		ActivationEpoch:          validator.ActivationEpoch,
+		ExitEpoch:                validator.ExitEpoch,
+		WithdrawalEpoch:          validator.WithdrawalEpoch,
+		PenalizedEpoch:           validator.PenalizedEpoch,
+		EstimatedActivationEpoch: v.EstimatedActivationEpoch(beaconState, index),
+	}
+}
+
+// validatorStatus determines the status of a validator at the given epoch.
+func validatorStatus(validator *pbp2p.Validator, epoch uint64) pb.ValidatorStatus {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case validator.PenalizedEpoch <= epoch:
+		return pb.ValidatorStatus_PENALIZED
+	case validator.ExitEpoch <= epoch:
+		return pb.ValidatorStatus_EXITED
+	case validator.ActivationEpoch > epoch:
+		return pb.ValidatorStatus_PENDING_ACTIVATION
+	case validator.ExitEpoch != farFutureEpoch ||
+		validator.StatusFlags == pbp2p.Validator_INITIATED_EXIT:
+		return pb.ValidatorStatus_EXITING
+	default:
+		return pb.ValidatorStatus_ACTIVE
+	}
+}
@@ -0,0 +1,284 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupQueryServerState(t *testing.T, beaconDB *db.BeaconDB, numValidators int) *pbp2p.BeaconState {
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	deposits := make([]*pbp2p.Deposit, numValidators)
+	for i := 0; i < len(deposits); i++ {
+		depositData, err := b.EncodeDepositData(
+			&pbp2p.DepositInput{
+				Pubkey: []byte{byte(i)},
+			},
+			params.BeaconConfig().MaxDeposit,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pbp2p.Deposit{DepositData: depositData}
+	}
+	beaconState, err := state.InitialBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate initial state: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	return beaconState
+}
+
+func TestBlockByRoot(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+
+	block := &pbp2p.BeaconBlock{Slot: 5}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := queryServer.BlockByRoot(context.Background(), &pb.BlockByRootRequest{BlockRootHash32: root[:]})
+	if err != nil {
+		t.Fatalf("Could not get block by root: %v", err)
+	}
+	if res.Slot != block.Slot {
+		t.Errorf("Expected block at slot %d, received slot %d", block.Slot, res.Slot)
+	}
+
+	if _, err := queryServer.BlockByRoot(context.Background(), &pb.BlockByRootRequest{BlockRootHash32: []byte{'a'}}); err == nil {
+		t.Error("Expected error when requesting an unknown block")
+	}
+}
+
+func TestBlocksBySlotRange(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+	setupQueryServerState(t, beaconDB, 1)
+
+	beaconState, err := beaconDB.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Slot 2 is skipped.
+	for _, slot := range []uint64{1, 3} {
+		block := &pbp2p.BeaconBlock{Slot: slot}
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+			t.Fatalf("Could not update chain head: %v", err)
+		}
+	}
+
+	res, err := queryServer.BlocksBySlotRange(context.Background(), &pb.BlocksBySlotRangeRequest{
+		StartSlot: 1,
+		EndSlot:   3,
+	})
+	if err != nil {
+		t.Fatalf("Could not get blocks by slot range: %v", err)
+	}
+	if len(res.Blocks) != 2 || res.Blocks[0].Slot != 1 || res.Blocks[1].Slot != 3 {
+		t.Errorf("Expected blocks at slots 1 and 3, received %v", res.Blocks)
+	}
+
+	if _, err := queryServer.BlocksBySlotRange(context.Background(), &pb.BlocksBySlotRangeRequest{
+		StartSlot: 3,
+		EndSlot:   1,
+	}); err == nil {
+		t.Error("Expected error when the end slot is lower than the start slot")
+	}
+	if _, err := queryServer.BlocksBySlotRange(context.Background(), &pb.BlocksBySlotRangeRequest{
+		StartSlot: 0,
+		EndSlot:   maxSlotRange,
+	}); err == nil {
+		t.Error("Expected error when requesting too many slots")
+	}
+	if _, err := queryServer.BlocksBySlotRange(context.Background(), &pb.BlocksBySlotRangeRequest{
+		StartSlot: math.MaxUint64 - 1,
+		EndSlot:   math.MaxUint64,
+	}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("Expected out of range error for slots after the head, received %v", err)
+	}
+	res, err = queryServer.BlocksBySlotRange(context.Background(), &pb.BlocksBySlotRangeRequest{
+		StartSlot: 3,
+		EndSlot:   3 + maxSlotRange - 1,
+	})
+	if err != nil {
+		t.Fatalf("Could not get blocks by slot range: %v", err)
+	}
+	if len(res.Blocks) != 1 || res.Blocks[0].Slot != 3 {
+		t.Errorf("Expected the range to be capped at the head, received %v", res.Blocks)
+	}
+}
+
+func TestStateByRoot(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+	beaconState := setupQueryServerState(t, beaconDB, 1)
+
+	root, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := queryServer.StateByRoot(context.Background(), &pb.StateByRootRequest{StateRootHash32: root[:]})
+	if err != nil {
+		t.Fatalf("Could not get state by root: %v", err)
+	}
+	if len(res.ValidatorRegistry) != 1 {
+		t.Errorf("Expected state with 1 validator, received %d", len(res.ValidatorRegistry))
+	}
+
+	unfinalized := &pbp2p.BeaconState{Slot: 10}
+	if err := beaconDB.SaveUnfinalizedBlockState(unfinalized); err != nil {
+		t.Fatalf("Could not save unfinalized state: %v", err)
+	}
+	root, err = hashutil.HashProto(unfinalized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = queryServer.StateByRoot(context.Background(), &pb.StateByRootRequest{StateRootHash32: root[:]})
+	if err != nil {
+		t.Fatalf("Could not get state by root: %v", err)
+	}
+	if res.Slot != unfinalized.Slot {
+		t.Errorf("Expected state at slot %d, received slot %d", unfinalized.Slot, res.Slot)
+	}
+
+	if _, err := queryServer.StateByRoot(context.Background(), &pb.StateByRootRequest{StateRootHash32: []byte{'a'}}); err == nil {
+		t.Error("Expected error when requesting an unknown state")
+	}
+}
+
+func TestValidatorByIndexAndPublicKey(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+	beaconState := setupQueryServerState(t, beaconDB, 2)
+
+	res, err := queryServer.ValidatorByIndex(context.Background(), &pb.ValidatorByIndexRequest{Index: 1})
+	if err != nil {
+		t.Fatalf("Could not get validator by index: %v", err)
+	}
+	if res.Index != 1 || res.PublicKey[0] != 1 {
+		t.Errorf("Received wrong validator: %v", res)
+	}
+	if res.Status != pb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected genesis validator to be active, received %v", res.Status)
+	}
+	if res.Balance != beaconState.ValidatorBalances[1] {
+		t.Errorf("Expected balance %d, received %d", beaconState.ValidatorBalances[1], res.Balance)
+	}
+
+	res, err = queryServer.ValidatorByPublicKey(context.Background(), &pb.ValidatorIndexRequest{PublicKey: []byte{0}})
+	if err != nil {
+		t.Fatalf("Could not get validator by public key: %v", err)
+	}
+	if res.Index != 0 {
+		t.Errorf("Expected validator index 0, received %d", res.Index)
+	}
+
+	want := "out of range"
+	if _, err := queryServer.ValidatorByIndex(context.Background(), &pb.ValidatorByIndexRequest{Index: 2}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestValidatorBalances(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+	setupQueryServerState(t, beaconDB, 3)
+
+	res, err := queryServer.ValidatorBalances(context.Background(), &pb.EpochRequest{Epoch: 0})
+	if err != nil {
+		t.Fatalf("Could not get validator balances: %v", err)
+	}
+	if len(res.Balances) != 3 {
+		t.Errorf("Expected 3 balances, received %d", len(res.Balances))
+	}
+	if _, err := queryServer.ValidatorBalances(context.Background(), &pb.EpochRequest{Epoch: 1}); err == nil {
+		t.Error("Expected error when requesting balances of a future epoch")
+	}
+}
+
+func TestCommittees(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	queryServer := &QueryServer{beaconDB: beaconDB}
+	setupQueryServerState(t, beaconDB, 8)
+
+	res, err := queryServer.Committees(context.Background(), &pb.EpochRequest{Epoch: 0})
+	if err != nil {
+		t.Fatalf("Could not get committees: %v", err)
+	}
+	var total int
+	for _, committee := range res.Committees {
+		if committee.Slot >= params.BeaconConfig().EpochLength {
+			t.Errorf("Committee slot %d is outside of epoch 0", committee.Slot)
+		}
+		total += len(committee.Committee)
+	}
+	if total != 8 {
+		t.Errorf("Expected every active validator to be part of a committee, received %d", total)
+	}
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		validator *pbp2p.Validator
+		status    pb.ValidatorStatus
+	}{
+		{
+			validator: &pbp2p.Validator{ActivationEpoch: farFuture, ExitEpoch: farFuture, PenalizedEpoch: farFuture},
+			status:    pb.ValidatorStatus_PENDING_ACTIVATION,
+		},
+		{
+			validator: &pbp2p.Validator{ActivationEpoch: 1, ExitEpoch: farFuture, PenalizedEpoch: farFuture},
+			status:    pb.ValidatorStatus_ACTIVE,
+		},
+		{
+			validator: &pbp2p.Validator{ActivationEpoch: 1, ExitEpoch: 10, PenalizedEpoch: farFuture},
+			status:    pb.ValidatorStatus_EXITING,
+		},
+		{
+			validator: &pbp2p.Validator{ActivationEpoch: 1, ExitEpoch: 3, PenalizedEpoch: farFuture},
+			status:    pb.ValidatorStatus_EXITED,
+		},
+		{
+			validator: &pbp2p.Validator{ActivationEpoch: 1, ExitEpoch: 3, PenalizedEpoch: 2},
+			status:    pb.ValidatorStatus_PENALIZED,
+		},
+	}
+	for _, tt := range tests {
+		if status := validatorStatus(tt.validator, 5); status != tt.status {
+			t.Errorf("Expected status %v for validator %v, received %v", tt.status, tt.validator, status)
+		}
+	}
+}
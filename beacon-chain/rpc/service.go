@@ -8,6 +8,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -37,6 +38,11 @@ type chainService interface {
 type operationService interface {
 	IncomingExitFeed() *event.Feed
 	IncomingAttFeed() *event.Feed
+	PendingOperations(beaconState *pbp2p.BeaconState, slot uint64) (*pbp2p.BeaconBlockBody, error)
+}
+
+type p2pAPI interface {
+	Broadcast(msg proto.Message)
 }
 
 type powChainService interface {
@@ -53,6 +59,7 @@ type Service struct {
 	chainService          chainService
 	powChainService       powChainService
 	operationService      operationService
+	p2p                   p2pAPI
 	port                  string
 	listener              net.Listener
 	withCert              string
@@ -63,18 +70,27 @@ type Service struct {
 	incomingAttestation   chan *pbp2p.Attestation
 	slotAlignmentDuration time.Duration
 	credentialError       error
+	subscriptionBuf       int
+	gatewayPort           string
+	gatewayEnableWrites   bool
+	gateway               *gateway
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Port             string
-	CertFlag         string
-	KeyFlag          string
-	SubscriptionBuf  int
-	BeaconDB         *db.BeaconDB
-	ChainService     chainService
-	POWChainService  powChainService
-	OperationService operationService
+	Port        string
+	CertFlag    string
+	KeyFlag     string
+	GatewayPort string
+	// GatewayEnableWrites serves the methods which propose blocks, attestations and
+	// exits through the gateway, which does not authenticate its clients.
+	GatewayEnableWrites bool
+	SubscriptionBuf     int
+	BeaconDB            *db.BeaconDB
+	ChainService        chainService
+	POWChainService     powChainService
+	OperationService    operationService
+	P2P                 p2pAPI
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -88,6 +104,7 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		chainService:          cfg.ChainService,
 		powChainService:       cfg.POWChainService,
 		operationService:      cfg.OperationService,
+		p2p:                   cfg.P2P,
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
 		withKey:               cfg.KeyFlag,
@@ -95,6 +112,9 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		canonicalBlockChan:    make(chan *pbp2p.BeaconBlock, cfg.SubscriptionBuf),
 		canonicalStateChan:    make(chan *pbp2p.BeaconState, cfg.SubscriptionBuf),
 		incomingAttestation:   make(chan *pbp2p.Attestation, cfg.SubscriptionBuf),
+		subscriptionBuf:       cfg.SubscriptionBuf,
+		gatewayPort:           cfg.GatewayPort,
+		gatewayEnableWrites:   cfg.GatewayEnableWrites,
 	}
 }
 
@@ -125,32 +145,57 @@ func (s *Service) Start() {
 	beaconServer := &BeaconServer{
 		beaconDB:            s.beaconDB,
 		ctx:                 s.ctx,
+		chainService:        s.chainService,
 		powChainService:     s.powChainService,
 		operationService:    s.operationService,
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartChan:      make(chan time.Time, 1),
+		chainEventBuf:       s.subscriptionBuf,
 	}
 	proposerServer := &ProposerServer{
 		beaconDB:           s.beaconDB,
 		chainService:       s.chainService,
 		powChainService:    s.powChainService,
+		operationService:   s.operationService,
+		p2p:                s.p2p,
 		canonicalStateChan: s.canonicalStateChan,
 	}
 	attesterServer := &AttesterServer{
 		operationService: s.operationService,
 	}
 	validatorServer := &ValidatorServer{
+		ctx:           s.ctx,
+		beaconDB:      s.beaconDB,
+		chainService:  s.chainService,
+		scheduleCache: newScheduleCache(),
+	}
+	queryServer := &QueryServer{
 		beaconDB: s.beaconDB,
 	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
 	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
 	pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
 	pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
+	pb.RegisterQueryServiceServer(s.grpcServer, queryServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
+	if s.gatewayPort != "" {
+		s.gateway = newGateway(
+			fmt.Sprintf(":%s", s.gatewayPort),
+			s.gatewayEnableWrites,
+			beaconServer,
+			validatorServer,
+			proposerServer,
+			attesterServer,
+			queryServer,
+		)
+		s.gateway.start(s.withCert, s.withKey)
+		log.Infof("JSON/HTTP gateway listening on port :%s", s.gatewayPort)
+	}
+
 	go func() {
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
@@ -168,6 +213,11 @@ func (s *Service) Stop() error {
 		s.grpcServer.GracefulStop()
 		log.Debug("Initiated graceful stop of gRPC server")
 	}
+	if s.gateway != nil {
+		if err := s.gateway.stop(); err != nil {
+			log.Errorf("Could not stop JSON/HTTP gateway: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -37,6 +39,23 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) PendingOperations(beaconState *pbp2p.BeaconState, slot uint64) (*pbp2p.BeaconBlockBody, error) {
+	if slot <= beaconState.Slot {
+		return nil, fmt.Errorf("slot %d is not after state slot %d", slot, beaconState.Slot)
+	}
+	return &pbp2p.BeaconBlockBody{
+		Exits: []*pbp2p.Exit{{Slot: beaconState.Slot}},
+	}, nil
+}
+
+type mockP2P struct {
+	broadcasted []proto.Message
+}
+
+func (mp *mockP2P) Broadcast(msg proto.Message) {
+	mp.broadcasted = append(mp.broadcasted, msg)
+}
+
 type mockChainService struct {
 	blockFeed       *event.Feed
 	stateFeed       *event.Feed
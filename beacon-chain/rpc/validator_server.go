@@ -1,14 +1,17 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
-	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // ValidatorServer defines a server implementation of the gRPC Validator service,
@@ -16,7 +19,10 @@ import (
 // and shards in which particular validators need to perform their responsibilities,
 // and more.
 type ValidatorServer struct {
-	beaconDB *db.BeaconDB
+	ctx           context.Context
+	beaconDB      *db.BeaconDB
+	chainService  chainService
+	scheduleCache *scheduleCache
 }
 
 // ValidatorIndex is called by a validator to get its index location that corresponds
@@ -36,55 +42,195 @@ func (vs *ValidatorServer) ValidatorIndex(ctx context.Context, req *pb.Validator
 	return &pb.ValidatorIndexResponse{Index: index}, nil
 }
 
-// ValidatorEpochAssignments fetches an assignment object for a validator by public key
-// such as the slot the validator needs to attest in during the epoch as well as a slot
-// in which the validator may need to propose during the epoch in addition to the assigned shard.
+// ValidatorEpochAssignments fetches the assignments of the validators with the given
+// public keys, such as the slot each validator needs to attest in during the epoch as well
+// as a slot in which the validator may need to propose during the epoch in addition to the
+// assigned shard. Assignments of the following epoch are included as a lookahead when available.
+// The schedule of an epoch is computed once and cached for every subsequent request.
 func (vs *ValidatorServer) ValidatorEpochAssignments(
 	ctx context.Context,
 	req *pb.ValidatorEpochAssignmentsRequest,
 ) (*pb.ValidatorEpochAssignmentsResponse, error) {
-	if len(req.PublicKey) != 48 {
-		return nil, fmt.Errorf("expected 48 byte public key, received %d", len(req.PublicKey))
+	for _, pubKey := range req.PublicKeys {
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("expected 48 byte public key, received %d", len(pubKey))
+		}
 	}
 	beaconState, err := vs.beaconDB.State()
 	if err != nil {
 		return nil, fmt.Errorf("could not get beacon state: %v", err)
 	}
-	validatorIndex, err := v.ValidatorIdx(req.PublicKey, beaconState.ValidatorRegistry)
+	indices := make([]uint64, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		indices[i], err = v.ValidatorIdx(pubKey, beaconState.ValidatorRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("could not get active validator index: %v", err)
+		}
+	}
+
+	epoch := helpers.SlotToEpoch(req.EpochStart)
+	schedule, err := vs.scheduleCache.schedule(beaconState, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("could not get active validator index: %v", err)
+		return nil, fmt.Errorf("could not get schedule of epoch %d: %v", epoch, err)
+	}
+	res := &pb.ValidatorEpochAssignmentsResponse{
+		Assignments: make([]*pb.Assignment, len(indices)),
+	}
+	for i, index := range indices {
+		res.Assignments[i] = schedule.assignment(index, req.PublicKeys[i])
 	}
-	var shard uint64
-	var attesterSlot uint64
-	var proposerSlot uint64
+	// The lookahead is only known up to the next epoch of the canonical state.
+	if epoch+1 > helpers.NextEpoch(beaconState) {
+		return res, nil
+	}
+	nextSchedule, err := vs.scheduleCache.schedule(beaconState, epoch+1)
+	if err != nil {
+		return nil, fmt.Errorf("could not get schedule of epoch %d: %v", epoch+1, err)
+	}
+	res.NextEpochAssignments = make([]*pb.Assignment, len(indices))
+	for i, index := range indices {
+		res.NextEpochAssignments[i] = nextSchedule.assignment(index, req.PublicKeys[i])
+	}
+	return res, nil
+}
 
-	for slot := req.EpochStart; slot < req.EpochStart+params.BeaconConfig().EpochLength; slot++ {
-		crossLinkCommittees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
-		if err != nil {
-			return nil, err
+// ValidatorStatus streams the status of the requested validators, from their deposit
+// being seen by the beacon node through activation until they exit or get penalized.
+// The current statuses are sent right away and a new response is sent every time
+// a canonical block changes the status of one of the validators.
+func (vs *ValidatorServer) ValidatorStatus(req *pb.ValidatorStatusRequest, stream pb.ValidatorService_ValidatorStatusServer) error {
+	// The block feed blocks its sender until every subscriber received the block, so
+	// blocks only mark the statuses as outdated in order to never stall the chain service.
+	outdated := make(chan struct{}, 1)
+	blockChan := make(chan *pbp2p.BeaconBlock, 1)
+	sub := vs.chainService.CanonicalBlockFeed().Subscribe(blockChan)
+	defer sub.Unsubscribe()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-blockChan:
+				select {
+				case outdated <- struct{}{}:
+				default:
+				}
+			case <-done:
+				return
+			}
 		}
-		proposerIndex, err := v.BeaconProposerIdx(beaconState, slot)
+	}()
+
+	var previous *pb.ValidatorStatusResponse
+	for {
+		res, err := vs.validatorStatuses(stream.Context(), req.PublicKeys)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if proposerIndex == validatorIndex {
-			proposerSlot = slot
+		if !proto.Equal(previous, res) {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			previous = res
 		}
-		for _, committee := range crossLinkCommittees {
-			for _, idx := range committee.Committee {
-				if idx == validatorIndex {
-					attesterSlot = slot
-					shard = committee.Shard
-				}
+		select {
+		case <-outdated:
+		case <-sub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return nil
+		case <-stream.Context().Done():
+			log.Debug("RPC stream closed, exiting goroutine")
+			return nil
+		case <-vs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
+
+// validatorStatuses determines the status of each of the given validators. Validators
+// which are not part of the registry yet are reported as deposited if their deposit
+// was seen in the deposit contract, and with an unknown status otherwise.
+func (vs *ValidatorServer) validatorStatuses(ctx context.Context, pubKeys [][]byte) (*pb.ValidatorStatusResponse, error) {
+	beaconState, err := vs.beaconDB.State()
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	var registry []*pbp2p.Validator
+	var epoch uint64
+	if beaconState != nil {
+		registry = beaconState.ValidatorRegistry
+		epoch = helpers.CurrentEpoch(beaconState)
+	}
+	var pendingDeposits []*pbp2p.Deposit
+	res := &pb.ValidatorStatusResponse{
+		Epoch:      epoch,
+		Validators: make([]*pb.ValidatorInfo, len(pubKeys)),
+	}
+	for i, pubKey := range pubKeys {
+		if index, err := v.ValidatorIdx(pubKey, registry); err == nil {
+			res.Validators[i] = validatorInfo(beaconState, index)
+			continue
+		}
+		if pendingDeposits == nil {
+			pendingDeposits = vs.beaconDB.PendingDeposits(ctx, nil)
+		}
+		status := pb.ValidatorStatus_UNKNOWN_STATUS
+		for _, deposit := range pendingDeposits {
+			depositInput, err := blocks.DecodeDepositInput(deposit.DepositData)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode deposit input: %v", err)
+			}
+			if bytes.Equal(depositInput.Pubkey, pubKey) {
+				status = pb.ValidatorStatus_DEPOSITED
+				break
 			}
 		}
+		res.Validators[i] = &pb.ValidatorInfo{
+			PublicKey: pubKey,
+			Status:    status,
+		}
+	}
+	return res, nil
+}
+
+// ValidatorPerformance returns the balance changes the requested validators received
+// from each kind of reward and penalty applied at the transition to the epoch, or those
+// of every validator whose balance changed when no public keys are requested.
+func (vs *ValidatorServer) ValidatorPerformance(ctx context.Context, req *pb.ValidatorPerformanceRequest) (*pbp2p.EpochRewardReport, error) {
+	report, err := vs.beaconDB.RewardReport(req.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get reward report: %v", err)
+	}
+	if report == nil {
+		return nil, fmt.Errorf("no reward report for epoch %d, reports are only recorded with --enable-reward-reports", req.Epoch)
+	}
+	if len(req.PublicKeys) == 0 {
+		return report, nil
+	}
+
+	beaconState, err := vs.beaconDB.State()
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	deltas := make(map[uint64]*pbp2p.ValidatorBalanceDeltas, len(report.ValidatorDeltas))
+	for _, delta := range report.ValidatorDeltas {
+		deltas[delta.ValidatorIndex] = delta
+	}
+	res := &pbp2p.EpochRewardReport{
+		Epoch:           report.Epoch,
+		ValidatorDeltas: make([]*pbp2p.ValidatorBalanceDeltas, len(req.PublicKeys)),
+	}
+	for i, pubKey := range req.PublicKeys {
+		index, err := v.ValidatorIdx(pubKey, beaconState.ValidatorRegistry)
+		if err != nil {
+			return nil, fmt.Errorf("could not get validator index: %v", err)
+		}
+		// Validators missing from the report had no balance change during the epoch.
+		res.ValidatorDeltas[i] = &pbp2p.ValidatorBalanceDeltas{ValidatorIndex: index}
+		if delta, ok := deltas[index]; ok {
+			res.ValidatorDeltas[i] = delta
+		}
 	}
-	return &pb.ValidatorEpochAssignmentsResponse{
-		Assignment: &pb.Assignment{
-			PublicKey:    req.PublicKey,
-			Shard:        shard,
-			AttesterSlot: attesterSlot,
-			ProposerSlot: proposerSlot,
-		},
-	}, nil
+	return res, nil
 }
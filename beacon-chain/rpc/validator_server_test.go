@@ -2,11 +2,15 @@ package rpc
 
 import (
 	"context"
+	"math/big"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -94,13 +98,14 @@ func TestValidatorEpochAssignments(t *testing.T) {
 	}
 
 	validatorServer := &ValidatorServer{
-		beaconDB: db,
+		beaconDB:      db,
+		scheduleCache: newScheduleCache(),
 	}
 	var pubKey [48]byte
 	copy(pubKey[:], []byte("0"))
 	req := &pb.ValidatorEpochAssignmentsRequest{
 		EpochStart: 0,
-		PublicKey:  pubKey[:],
+		PublicKeys: [][]byte{pubKey[:]},
 	}
 	res, err := validatorServer.ValidatorEpochAssignments(context.Background(), req)
 	if err != nil {
@@ -108,18 +113,251 @@ func TestValidatorEpochAssignments(t *testing.T) {
 	}
 	// With initial shuffling of default 16384 validators, the validator corresponding to
 	// public key 0 should correspond to an attester slot of 2 at shard 5.
-	if res.Assignment.Shard != 5 {
+	if res.Assignments[0].Shard != 5 {
 		t.Errorf(
 			"Expected validator with pubkey %#x to be assigned to shard 5, received %d",
-			req.PublicKey,
-			res.Assignment.Shard,
+			pubKey,
+			res.Assignments[0].Shard,
 		)
 	}
-	if res.Assignment.AttesterSlot != 2 {
+	if res.Assignments[0].AttesterSlot != 2 {
 		t.Errorf(
 			"Expected validator with pubkey %#x to be assigned as attester of slot 2, received %d",
-			req.PublicKey,
-			res.Assignment.AttesterSlot,
+			pubKey,
+			res.Assignments[0].AttesterSlot,
+		)
+	}
+}
+
+func TestValidatorEpochAssignments_MultipleKeysWithLookahead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	pubKeys := make([][]byte, params.BeaconConfig().EpochLength*2)
+	deposits := make([]*pbp2p.Deposit, len(pubKeys))
+	for i := 0; i < len(deposits); i++ {
+		var pubKey [48]byte
+		copy(pubKey[:], []byte(strconv.Itoa(i)))
+		pubKeys[i] = pubKey[:]
+		depositData, err := b.EncodeDepositData(
+			&pbp2p.DepositInput{Pubkey: pubKey[:]},
+			params.BeaconConfig().MaxDeposit,
+			time.Now().Unix(),
 		)
+		if err != nil {
+			t.Fatalf("Could not encode initial block deposits: %v", err)
+		}
+		deposits[i] = &pbp2p.Deposit{DepositData: depositData}
+	}
+	beaconState, err := state.InitialBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate initial state: %v", err)
+	}
+	if err := db.UpdateChainHead(genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	validatorServer := &ValidatorServer{
+		beaconDB:      db,
+		scheduleCache: newScheduleCache(),
+	}
+	req := &pb.ValidatorEpochAssignmentsRequest{
+		EpochStart: 0,
+		PublicKeys: [][]byte{pubKeys[3], pubKeys[11]},
+	}
+	res, err := validatorServer.ValidatorEpochAssignments(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not get validator assignments: %v", err)
+	}
+	if len(res.Assignments) != 2 || len(res.NextEpochAssignments) != 2 {
+		t.Fatalf("Expected 2 assignments for both epochs, received %d and %d",
+			len(res.Assignments), len(res.NextEpochAssignments))
+	}
+	for i, index := range []uint64{3, 11} {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, res.Assignments[i].AttesterSlot, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var found bool
+		for _, committee := range committees {
+			for _, idx := range committee.Committee {
+				found = found || idx == index
+			}
+		}
+		if !found {
+			t.Errorf("Validator %d is not part of a committee at its attester slot %d", index, res.Assignments[i].AttesterSlot)
+		}
+		nextEpochSlot := res.NextEpochAssignments[i].AttesterSlot
+		if helpers.SlotToEpoch(nextEpochSlot) != 1 {
+			t.Errorf("Expected next epoch attester slot to be in epoch 1, received slot %d", nextEpochSlot)
+		}
+	}
+
+	// The lookahead past the next epoch of the state is unknown.
+	req.EpochStart = params.BeaconConfig().EpochLength
+	res, err = validatorServer.ValidatorEpochAssignments(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not get validator assignments: %v", err)
+	}
+	if len(res.NextEpochAssignments) != 0 {
+		t.Errorf("Expected no lookahead beyond the next epoch, received %v", res.NextEpochAssignments)
+	}
+
+	req.PublicKeys = append(req.PublicKeys, []byte{'a'})
+	if _, err := validatorServer.ValidatorEpochAssignments(context.Background(), req); err == nil {
+		t.Error("Expected error when requesting assignments of an invalid public key")
+	}
+}
+
+func TestValidatorStatus_StreamsStatusChanges(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	mockChain := newMockChainService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconState := setupQueryServerState(t, beaconDB, 1)
+
+	depositData, err := b.EncodeDepositData(
+		&pbp2p.DepositInput{Pubkey: []byte{5}},
+		params.BeaconConfig().MaxDeposit,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		t.Fatalf("Could not encode deposit input: %v", err)
+	}
+	beaconDB.InsertPendingDeposit(ctx, &pbp2p.Deposit{DepositData: depositData}, big.NewInt(0))
+
+	validatorServer := &ValidatorServer{
+		ctx:          ctx,
+		beaconDB:     beaconDB,
+		chainService: mockChain,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	received := make(chan *pb.ValidatorStatusResponse, 2)
+	mockStream := internal.NewMockValidatorService_ValidatorStatusServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(res *pb.ValidatorStatusResponse) {
+		received <- res
+	}).Return(nil).Times(2)
+
+	exitRoutine := make(chan bool)
+	req := &pb.ValidatorStatusRequest{PublicKeys: [][]byte{{0}, {5}, {6}}}
+	go func(tt *testing.T) {
+		if err := validatorServer.ValidatorStatus(req, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		<-exitRoutine
+	}(t)
+
+	res := <-received
+	want := []pb.ValidatorStatus{
+		pb.ValidatorStatus_ACTIVE,
+		pb.ValidatorStatus_DEPOSITED,
+		pb.ValidatorStatus_UNKNOWN_STATUS,
+	}
+	for i, validator := range res.Validators {
+		if validator.Status != want[i] {
+			t.Errorf("Expected validator %#x to have status %v, received %v", validator.PublicKey, want[i], validator.Status)
+		}
+	}
+
+	// Blocks which do not change any status are not reported.
+	for mockChain.blockFeed.Send(&pbp2p.BeaconBlock{}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	beaconState = proto.Clone(beaconState).(*pbp2p.BeaconState)
+	beaconState.ValidatorRegistry = append(beaconState.ValidatorRegistry, &pbp2p.Validator{
+		Pubkey:          []byte{5},
+		ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+		ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+		PenalizedEpoch:  params.BeaconConfig().FarFutureEpoch,
+	})
+	beaconState.ValidatorBalances = append(beaconState.ValidatorBalances, params.BeaconConfig().MaxDeposit)
+	block := &pbp2p.BeaconBlock{Slot: 1}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	mockChain.blockFeed.Send(block)
+
+	res = <-received
+	pending := res.Validators[1]
+	if pending.Status != pb.ValidatorStatus_PENDING_ACTIVATION || pending.Index != 1 {
+		t.Errorf("Expected validator 1 to be pending activation, received %v", pending)
+	}
+	if pending.EstimatedActivationEpoch != helpers.EntryExitEffectEpoch(0) {
+		t.Errorf("Expected estimated activation epoch %d, received %d",
+			helpers.EntryExitEffectEpoch(0), pending.EstimatedActivationEpoch)
+	}
+	cancel()
+	exitRoutine <- true
+}
+
+func TestValidatorPerformance(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	beaconState := &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{
+			{Pubkey: []byte{'A'}}, {Pubkey: []byte{'B'}}, {Pubkey: []byte{'C'}},
+		},
+	}
+	if err := db.UpdateChainHead(genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	report := &pbp2p.EpochRewardReport{
+		Epoch: 2,
+		ValidatorDeltas: []*pbp2p.ValidatorBalanceDeltas{
+			{ValidatorIndex: 0, Source: 5, Target: 5, Head: 5},
+			{ValidatorIndex: 2, Source: -5, Penalties: -50},
+		},
+	}
+	if err := db.SaveRewardReport(report); err != nil {
+		t.Fatalf("Could not save reward report: %v", err)
+	}
+
+	validatorServer := &ValidatorServer{
+		beaconDB: db,
+	}
+	res, err := validatorServer.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{Epoch: 2})
+	if err != nil {
+		t.Fatalf("Could not get validator performance: %v", err)
+	}
+	if !proto.Equal(res, report) {
+		t.Errorf("Expected the report of every validator %v, received %v", report, res)
+	}
+
+	res, err = validatorServer.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{
+		Epoch:      2,
+		PublicKeys: [][]byte{{'C'}, {'B'}},
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator performance: %v", err)
+	}
+	want := &pbp2p.EpochRewardReport{
+		Epoch: 2,
+		ValidatorDeltas: []*pbp2p.ValidatorBalanceDeltas{
+			report.ValidatorDeltas[1],
+			{ValidatorIndex: 1},
+		},
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Expected the report of the requested validators %v, received %v", want, res)
+	}
+
+	if _, err := validatorServer.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{Epoch: 3}); err == nil {
+		t.Error("Expected error when the epoch has no reward report")
 	}
 }
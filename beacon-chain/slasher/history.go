@@ -0,0 +1,219 @@
+package slasher
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// proposal is a block proposal seen by the slasher, with the data a proposer
+// slashing is made of.
+type proposal struct {
+	data      *pb.ProposalSignedData
+	signature []byte
+}
+
+// proposalKey identifies the proposals of a proposer at a slot.
+type proposalKey struct {
+	proposerIndex uint64
+	slot          uint64
+}
+
+// proposalHistory indexes the first proposal seen of every proposer at every slot.
+type proposalHistory struct {
+	proposals map[proposalKey]*proposal
+}
+
+func newProposalHistory() *proposalHistory {
+	return &proposalHistory{
+		proposals: make(map[proposalKey]*proposal),
+	}
+}
+
+// add indexes the proposal and returns a proposer slashing if the proposer already
+// proposed a different block at the same slot.
+func (h *proposalHistory) add(proposerIndex uint64, p *proposal) *pb.ProposerSlashing {
+	key := proposalKey{proposerIndex: proposerIndex, slot: p.data.Slot}
+	seen, ok := h.proposals[key]
+	if !ok {
+		h.proposals[key] = p
+		return nil
+	}
+	if bytes.Equal(seen.data.BlockRootHash32, p.data.BlockRootHash32) {
+		return nil
+	}
+	return &pb.ProposerSlashing{
+		ProposerIndex:       proposerIndex,
+		ProposalData_1:      seen.data,
+		ProposalSignature_1: seen.signature,
+		ProposalData_2:      p.data,
+		ProposalSignature_2: p.signature,
+	}
+}
+
+// prune removes the proposals made before the given slot.
+func (h *proposalHistory) prune(slot uint64) {
+	for key := range h.proposals {
+		if key.slot < slot {
+			delete(h.proposals, key)
+		}
+	}
+}
+
+// vote is an attestation seen by the slasher, with the data a slashable vote is made of.
+type vote struct {
+	data             *pb.AttestationData
+	validatorIndices []uint64
+	signature        []byte
+}
+
+func (v *vote) sourceEpoch() uint64 {
+	return v.data.JustifiedSlot / params.BeaconConfig().EpochLength
+}
+
+func (v *vote) targetEpoch() uint64 {
+	return helpers.SlotToEpoch(v.data.Slot)
+}
+
+// validatorVotes holds the votes of a validator by target epoch, and its min and max
+// spans by epoch. The min span of epoch e is the smallest distance from e to the target
+// of a vote with a source after e, and its max span the largest distance from e to the
+// target of a vote with a source before e. A new vote with source s and target t
+// surrounds a previous vote if minSpans[s] < t - s, and is surrounded by one if
+// maxSpans[s] > t - s, so neither check needs to go through the previous votes.
+type validatorVotes struct {
+	votes    map[uint64]*vote
+	minSpans map[uint64]uint64
+	maxSpans map[uint64]uint64
+}
+
+// attestationHistory indexes the votes of every validator, from the earliest
+// epoch that has not been pruned.
+type attestationHistory struct {
+	validators    map[uint64]*validatorVotes
+	earliestEpoch uint64
+}
+
+func newAttestationHistory() *attestationHistory {
+	return &attestationHistory{
+		validators: make(map[uint64]*validatorVotes),
+	}
+}
+
+// add indexes the vote of the validator and returns an attester slashing if the vote
+// is a double vote with a previous vote of the validator, or if it surrounds one or is
+// surrounded by one. The slashing votes are in the order the slashing conditions are
+// checked with, a surrounding vote coming first.
+func (h *attestationHistory) add(validatorIndex uint64, v *vote) *pb.AttesterSlashing {
+	source := v.sourceEpoch()
+	target := v.targetEpoch()
+	if target < source || target < h.earliestEpoch {
+		return nil
+	}
+
+	votes, ok := h.validators[validatorIndex]
+	if !ok {
+		votes = &validatorVotes{
+			votes:    make(map[uint64]*vote),
+			minSpans: make(map[uint64]uint64),
+			maxSpans: make(map[uint64]uint64),
+		}
+		h.validators[validatorIndex] = votes
+	}
+
+	if seen, ok := votes.votes[target]; ok {
+		if proto.Equal(seen.data, v.data) {
+			return nil
+		}
+		return attesterSlashing(seen, v)
+	}
+	if span, ok := votes.minSpans[source]; ok && span < target-source {
+		if surrounded, ok := votes.votes[source+span]; ok {
+			return attesterSlashing(v, surrounded)
+		}
+	}
+	if span, ok := votes.maxSpans[source]; ok && span > target-source {
+		if surrounding, ok := votes.votes[source+span]; ok {
+			return attesterSlashing(surrounding, v)
+		}
+	}
+
+	votes.votes[target] = v
+	votes.updateSpans(source, target, h.earliestEpoch)
+	return nil
+}
+
+// updateSpans lowers the min spans of the epochs from the earliest epoch up to the source
+// of a new vote, and raises the max spans of the epochs between its source and target.
+// The updates stop at the first epoch whose span is already at least as tight, as the
+// spans of the epochs further away are then too.
+func (v *validatorVotes) updateSpans(source uint64, target uint64, earliestEpoch uint64) {
+	for epoch := source; epoch > earliestEpoch; epoch-- {
+		span, ok := v.minSpans[epoch-1]
+		if ok && span <= target-(epoch-1) {
+			break
+		}
+		v.minSpans[epoch-1] = target - (epoch - 1)
+	}
+	epoch := source + 1
+	if epoch < earliestEpoch {
+		epoch = earliestEpoch
+	}
+	for ; epoch < target; epoch++ {
+		span, ok := v.maxSpans[epoch]
+		if ok && span >= target-epoch {
+			break
+		}
+		v.maxSpans[epoch] = target - epoch
+	}
+}
+
+// prune removes the votes targeting, and the spans of, the epochs before the given epoch.
+func (h *attestationHistory) prune(epoch uint64) {
+	if epoch <= h.earliestEpoch {
+		return
+	}
+	h.earliestEpoch = epoch
+	for index, votes := range h.validators {
+		for target := range votes.votes {
+			if target < epoch {
+				delete(votes.votes, target)
+			}
+		}
+		for e := range votes.minSpans {
+			if e < epoch {
+				delete(votes.minSpans, e)
+			}
+		}
+		for e := range votes.maxSpans {
+			if e < epoch {
+				delete(votes.maxSpans, e)
+			}
+		}
+		if len(votes.votes) == 0 {
+			delete(h.validators, index)
+		}
+	}
+}
+
+func attesterSlashing(vote1 *vote, vote2 *vote) *pb.AttesterSlashing {
+	return &pb.AttesterSlashing{
+		SlashableVote_1: slashableVote(vote1),
+		SlashableVote_2: slashableVote(vote2),
+	}
+}
+
+// slashableVote converts the vote to a slashable vote. Custody bits are not used
+// until phase 1, so the custody bitfield is empty.
+func slashableVote(v *vote) *pb.SlashableVote {
+	return &pb.SlashableVote{
+		ValidatorIndices:   v.validatorIndices,
+		CustodyBitfield:    make([]byte, mathutil.CeilDiv8(len(v.validatorIndices))),
+		Data:               v.data,
+		AggregateSignature: v.signature,
+	}
+}
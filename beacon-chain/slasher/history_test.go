@@ -0,0 +1,225 @@
+package slasher
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func newProposal(slot uint64, root byte) *proposal {
+	return &proposal{
+		data: &pb.ProposalSignedData{
+			Slot:            slot,
+			Shard:           params.BeaconConfig().BeaconChainShardNumber,
+			BlockRootHash32: []byte{root},
+		},
+		signature: []byte{root},
+	}
+}
+
+// newVote returns a vote with the given source and target epochs.
+func newVote(source uint64, target uint64, shard uint64) *vote {
+	epochLength := params.BeaconConfig().EpochLength
+	return &vote{
+		data: &pb.AttestationData{
+			JustifiedSlot: source * epochLength,
+			Slot:          target * epochLength,
+			Shard:         shard,
+		},
+		validatorIndices: []uint64{1},
+	}
+}
+
+func TestProposalHistory_DetectsDoubleProposal(t *testing.T) {
+	history := newProposalHistory()
+	first := newProposal(10, 'a')
+	if slashing := history.add(1, first); slashing != nil {
+		t.Fatalf("Expected no slashing for a first proposal, received %v", slashing)
+	}
+	if slashing := history.add(1, newProposal(10, 'a')); slashing != nil {
+		t.Errorf("Expected no slashing for the same proposal, received %v", slashing)
+	}
+	if slashing := history.add(2, newProposal(10, 'b')); slashing != nil {
+		t.Errorf("Expected no slashing for a proposal of another proposer, received %v", slashing)
+	}
+	if slashing := history.add(1, newProposal(11, 'b')); slashing != nil {
+		t.Errorf("Expected no slashing for a proposal at another slot, received %v", slashing)
+	}
+
+	second := newProposal(10, 'b')
+	slashing := history.add(1, second)
+	if slashing == nil {
+		t.Fatal("Expected a slashing for a double proposal")
+	}
+	if slashing.ProposerIndex != 1 {
+		t.Errorf("Expected proposer index 1, received %d", slashing.ProposerIndex)
+	}
+	if !proto.Equal(slashing.ProposalData_1, first.data) || !proto.Equal(slashing.ProposalData_2, second.data) {
+		t.Errorf("Expected slashing of proposals %v and %v, received %v", first.data, second.data, slashing)
+	}
+}
+
+func TestProposalHistory_Prune(t *testing.T) {
+	history := newProposalHistory()
+	history.add(1, newProposal(10, 'a'))
+	history.add(1, newProposal(20, 'a'))
+	history.prune(15)
+
+	if slashing := history.add(1, newProposal(10, 'b')); slashing != nil {
+		t.Errorf("Expected pruned proposal not to be slashed, received %v", slashing)
+	}
+	if slashing := history.add(1, newProposal(20, 'b')); slashing == nil {
+		t.Error("Expected a slashing for a double proposal after the pruned slot")
+	}
+}
+
+func TestAttestationHistory_DetectsSlashableVotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []*vote
+		vote     *vote
+		// The indices in previous of the votes expected in the slashing, -1 standing
+		// for the new vote, or nil if no slashing is expected.
+		slashing []int
+	}{
+		{
+			name:     "same vote",
+			previous: []*vote{newVote(1, 3, 0)},
+			vote:     newVote(1, 3, 0),
+		},
+		{
+			name:     "double vote",
+			previous: []*vote{newVote(1, 3, 0)},
+			vote:     newVote(1, 3, 1),
+			slashing: []int{0, -1},
+		},
+		{
+			name:     "double vote with another source",
+			previous: []*vote{newVote(1, 3, 0)},
+			vote:     newVote(2, 3, 0),
+			slashing: []int{0, -1},
+		},
+		{
+			name:     "surrounding vote",
+			previous: []*vote{newVote(4, 5, 0)},
+			vote:     newVote(2, 8, 0),
+			slashing: []int{-1, 0},
+		},
+		{
+			name:     "surrounded vote",
+			previous: []*vote{newVote(2, 8, 0)},
+			vote:     newVote(4, 5, 0),
+			slashing: []int{0, -1},
+		},
+		{
+			name:     "surrounding vote with the closest target",
+			previous: []*vote{newVote(6, 7, 0), newVote(3, 4, 0)},
+			vote:     newVote(2, 8, 0),
+			slashing: []int{-1, 1},
+		},
+		{
+			name:     "surrounded vote with the furthest target",
+			previous: []*vote{newVote(1, 6, 0), newVote(3, 9, 0)},
+			vote:     newVote(4, 5, 0),
+			slashing: []int{1, -1},
+		},
+		{
+			name:     "same source",
+			previous: []*vote{newVote(2, 4, 0)},
+			vote:     newVote(2, 8, 0),
+		},
+		{
+			name:     "same target as a surrounded source",
+			previous: []*vote{newVote(4, 6, 0)},
+			vote:     newVote(2, 4, 0),
+		},
+		{
+			name:     "consecutive votes",
+			previous: []*vote{newVote(0, 1, 0), newVote(1, 2, 0), newVote(2, 3, 0)},
+			vote:     newVote(3, 4, 0),
+		},
+	}
+	for _, tt := range tests {
+		history := newAttestationHistory()
+		for _, v := range tt.previous {
+			if slashing := history.add(1, v); slashing != nil {
+				t.Fatalf("%s: expected no slashing for previous vote %v, received %v", tt.name, v.data, slashing)
+			}
+		}
+		slashing := history.add(1, tt.vote)
+		if tt.slashing == nil {
+			if slashing != nil {
+				t.Errorf("%s: expected no slashing, received %v", tt.name, slashing)
+			}
+			continue
+		}
+		if slashing == nil {
+			t.Errorf("%s: expected a slashing", tt.name)
+			continue
+		}
+		want := make([]*pb.AttestationData, 2)
+		for i, index := range tt.slashing {
+			if index < 0 {
+				want[i] = tt.vote.data
+			} else {
+				want[i] = tt.previous[index].data
+			}
+		}
+		if !proto.Equal(slashing.SlashableVote_1.Data, want[0]) || !proto.Equal(slashing.SlashableVote_2.Data, want[1]) {
+			t.Errorf("%s: expected slashing of votes %v and %v, received %v", tt.name, want[0], want[1], slashing)
+		}
+	}
+}
+
+func TestAttestationHistory_SlashableVoteFormat(t *testing.T) {
+	history := newAttestationHistory()
+	v := newVote(1, 3, 0)
+	v.validatorIndices = []uint64{1, 4, 9, 10, 12, 20, 21, 30, 31}
+	v.signature = []byte{'s'}
+	history.add(1, v)
+
+	slashing := history.add(1, newVote(1, 3, 1))
+	if slashing == nil {
+		t.Fatal("Expected a slashing for a double vote")
+	}
+	vote1 := slashing.SlashableVote_1
+	if len(vote1.ValidatorIndices) != len(v.validatorIndices) {
+		t.Errorf("Expected validator indices %v, received %v", v.validatorIndices, vote1.ValidatorIndices)
+	}
+	if len(vote1.CustodyBitfield) != 2 {
+		t.Errorf("Expected a custody bitfield of 2 bytes, received %d", len(vote1.CustodyBitfield))
+	}
+	for _, b := range vote1.CustodyBitfield {
+		if b != 0 {
+			t.Errorf("Expected an empty custody bitfield, received %08b", vote1.CustodyBitfield)
+		}
+	}
+	if string(vote1.AggregateSignature) != "s" {
+		t.Errorf("Expected the aggregate signature of the vote, received %#x", vote1.AggregateSignature)
+	}
+}
+
+func TestAttestationHistory_Prune(t *testing.T) {
+	history := newAttestationHistory()
+	history.add(1, newVote(1, 3, 0))
+	history.add(2, newVote(4, 5, 0))
+	history.prune(4)
+
+	if _, ok := history.validators[1]; ok {
+		t.Error("Expected validator without votes after the pruned epoch to be removed")
+	}
+	if slashing := history.add(1, newVote(1, 3, 1)); slashing != nil {
+		t.Errorf("Expected no slashing for a vote before the pruned epoch, received %v", slashing)
+	}
+	if slashing := history.add(2, newVote(4, 5, 1)); slashing == nil {
+		t.Error("Expected a slashing for a double vote after the pruned epoch")
+	}
+	if slashing := history.add(2, newVote(4, 9, 0)); slashing != nil {
+		t.Errorf("Expected no slashing, received %v", slashing)
+	}
+	if slashing := history.add(2, newVote(5, 6, 0)); slashing == nil {
+		t.Error("Expected a slashing for a vote surrounded by a vote after the pruned epoch")
+	}
+}
@@ -0,0 +1,260 @@
+// Package slasher defines a service that watches the proposals and attestations seen
+// by the beacon node for slashable offences, and turns them into slashings.
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/signatures"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+type chainService interface {
+	IncomingBlockFeed() *event.Feed
+}
+
+type operationService interface {
+	IncomingAttFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
+}
+
+type p2pAPI interface {
+	Broadcast(msg proto.Message)
+}
+
+// Service represents a service that indexes every block proposal and attestation
+// received by the beacon node, and detects double proposals as well as double and
+// surround votes. The slashings of the offences it detects are sent to the
+// operations service and broadcasted to the network.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	beaconDB          *db.BeaconDB
+	chainService      chainService
+	operationService  operationService
+	p2p               p2pAPI
+	incomingBlockChan chan *pb.BeaconBlock
+	incomingAttChan   chan *pb.Attestation
+	proposals         *proposalHistory
+	attestations      *attestationHistory
+	historyEpochs     uint64
+	lastPrunedEpoch   uint64
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB         *db.BeaconDB
+	ChainService     chainService
+	OperationService operationService
+	P2P              p2pAPI
+	ReceiveBlockBuf  int
+	ReceiveAttBuf    int
+	// HistoryEpochs is the number of epochs of proposals and attestations the
+	// service keeps indexed.
+	HistoryEpochs uint64
+}
+
+// NewSlasherService instantiates a new service instance that will
+// be registered into a running beacon node.
+func NewSlasherService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		beaconDB:          cfg.BeaconDB,
+		chainService:      cfg.ChainService,
+		operationService:  cfg.OperationService,
+		p2p:               cfg.P2P,
+		incomingBlockChan: make(chan *pb.BeaconBlock, cfg.ReceiveBlockBuf),
+		incomingAttChan:   make(chan *pb.Attestation, cfg.ReceiveAttBuf),
+		proposals:         newProposalHistory(),
+		attestations:      newAttestationHistory(),
+		historyEpochs:     cfg.HistoryEpochs,
+	}
+}
+
+// Start the slasher service's main event loop.
+func (s *Service) Start() {
+	log.Info("Starting service")
+	go s.detectSlashings()
+}
+
+// Stop the slasher service's main event loop and associated goroutines.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+// TODO(1201): Add service health checks.
+func (s *Service) Status() error {
+	return nil
+}
+
+// detectSlashings indexes the blocks and attestations received from the sync
+// service, and emits the slashings of the offences it detects.
+func (s *Service) detectSlashings() {
+	blockSub := s.chainService.IncomingBlockFeed().Subscribe(s.incomingBlockChan)
+	defer blockSub.Unsubscribe()
+	attSub := s.operationService.IncomingAttFeed().Subscribe(s.incomingAttChan)
+	defer attSub.Unsubscribe()
+
+	for {
+		select {
+		case <-blockSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-attSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Slasher service context closed, exiting goroutine")
+			return
+		case block := <-s.incomingBlockChan:
+			if err := s.processBlock(block); err != nil {
+				log.Errorf("Could not check block at slot %d for slashable offences: %v", block.Slot, err)
+			}
+		case attestation := <-s.incomingAttChan:
+			beaconState, err := s.beaconDB.State()
+			if err != nil {
+				log.Errorf("Could not fetch beacon state: %v", err)
+				continue
+			}
+			if err := s.processAttestation(beaconState, attestation); err != nil {
+				log.Errorf("Could not check attestation for slashable offences: %v", err)
+			}
+		}
+	}
+}
+
+// processBlock indexes the proposal of the block and the attestations it includes.
+func (s *Service) processBlock(block *pb.BeaconBlock) error {
+	beaconState, err := s.beaconDB.State()
+	if err != nil {
+		return fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	proposerIndex, err := validators.BeaconProposerIdx(beaconState, block.Slot)
+	if err != nil {
+		return fmt.Errorf("could not get proposer index: %v", err)
+	}
+	// The slashing carries the proposals the proposer signed, which commit to the
+	// roots of the blocks without their signatures.
+	data, err := signatures.BlockProposal(block)
+	if err != nil {
+		return err
+	}
+	p := &proposal{
+		data:      data,
+		signature: bytes.Join(block.Signature, nil),
+	}
+	if slashing := s.proposals.add(proposerIndex, p); slashing != nil {
+		hash, err := hashutil.HashProto(slashing)
+		if err != nil {
+			return fmt.Errorf("could not hash proposer slashing: %v", err)
+		}
+		s.emitProposerSlashing(hash, slashing)
+	}
+
+	if block.Body != nil {
+		for _, attestation := range block.Body.Attestations {
+			if err := s.processAttestation(beaconState, attestation); err != nil {
+				return err
+			}
+		}
+	}
+	s.prune(helpers.SlotToEpoch(block.Slot))
+	return nil
+}
+
+// processAttestation indexes the vote of every participant of the attestation.
+func (s *Service) processAttestation(beaconState *pb.BeaconState, attestation *pb.Attestation) error {
+	participants, err := helpers.AttestationParticipants(
+		beaconState,
+		attestation.Data,
+		attestation.AggregationBitfield,
+	)
+	if err != nil {
+		return fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	// Slashable votes list their validators in ascending order.
+	indices := make([]uint64, len(participants))
+	copy(indices, participants)
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	v := &vote{
+		data:             attestation.Data,
+		validatorIndices: indices,
+		signature:        attestation.AggregateSignature,
+	}
+	// Participants of the same aggregated attestation who conflict with the same
+	// previous vote are slashed together, so their slashing is only emitted once.
+	emitted := make(map[[32]byte]bool)
+	for _, index := range indices {
+		slashing := s.attestations.add(index, v)
+		if slashing == nil {
+			continue
+		}
+		hash, err := hashutil.HashProto(slashing)
+		if err != nil {
+			return fmt.Errorf("could not hash attester slashing: %v", err)
+		}
+		if emitted[hash] {
+			continue
+		}
+		emitted[hash] = true
+		s.emitAttesterSlashing(hash, index, slashing)
+	}
+	return nil
+}
+
+// prune drops the proposals and votes which are older than the indexed history
+// once the given epoch is reached.
+func (s *Service) prune(epoch uint64) {
+	if epoch <= s.lastPrunedEpoch || epoch < s.historyEpochs {
+		return
+	}
+	s.lastPrunedEpoch = epoch
+	s.attestations.prune(epoch - s.historyEpochs)
+	s.proposals.prune(helpers.StartSlot(epoch - s.historyEpochs))
+}
+
+// emitProposerSlashing sends the slashing to the operations pool and broadcasts it.
+func (s *Service) emitProposerSlashing(hash [32]byte, slashing *pb.ProposerSlashing) {
+	log.WithFields(logrus.Fields{
+		"proposerIndex": slashing.ProposerIndex,
+		"slot":          slashing.ProposalData_1.Slot,
+	}).Info("Detected double proposal")
+	s.operationService.IncomingProposerSlashingFeed().Send(slashing)
+	s.p2p.Broadcast(&pb.ProposerSlashingResponse{
+		Hash:             hash[:],
+		ProposerSlashing: slashing,
+	})
+}
+
+// emitAttesterSlashing sends the slashing to the operations pool and broadcasts it.
+func (s *Service) emitAttesterSlashing(hash [32]byte, validatorIndex uint64, slashing *pb.AttesterSlashing) {
+	log.WithFields(logrus.Fields{
+		"validatorIndex": validatorIndex,
+		"slot1":          slashing.SlashableVote_1.Data.Slot,
+		"slot2":          slashing.SlashableVote_2.Data.Slot,
+	}).Info("Detected slashable vote")
+	s.operationService.IncomingAttesterSlashingFeed().Send(slashing)
+	s.p2p.Broadcast(&pb.AttesterSlashingResponse{
+		Hash:             hash[:],
+		AttesterSlashing: slashing,
+	})
+}
@@ -0,0 +1,149 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type mockOperationService struct {
+	attFeed              *event.Feed
+	proposerSlashingFeed *event.Feed
+	attesterSlashingFeed *event.Feed
+}
+
+func newMockOperationService() *mockOperationService {
+	return &mockOperationService{
+		attFeed:              new(event.Feed),
+		proposerSlashingFeed: new(event.Feed),
+		attesterSlashingFeed: new(event.Feed),
+	}
+}
+
+func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
+	return ms.attFeed
+}
+
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return ms.proposerSlashingFeed
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return ms.attesterSlashingFeed
+}
+
+type mockP2P struct {
+	broadcasted []proto.Message
+}
+
+func (mp *mockP2P) Broadcast(msg proto.Message) {
+	mp.broadcasted = append(mp.broadcasted, msg)
+}
+
+func TestStop(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slasherService := NewSlasherService(context.Background(), &Config{})
+
+	if err := slasherService.Stop(); err != nil {
+		t.Fatalf("Unable to stop slasher service: %v", err)
+	}
+
+	msg := hook.LastEntry().Message
+	want := "Stopping service"
+	if msg != want {
+		t.Errorf("incorrect log, expected %s, got %s", want, msg)
+	}
+
+	// The context should have been canceled.
+	if slasherService.ctx.Err() != context.Canceled {
+		t.Error("context was not canceled")
+	}
+	hook.Reset()
+}
+
+func TestEmitProposerSlashing_SendsAndBroadcasts(t *testing.T) {
+	hook := logTest.NewGlobal()
+	operationService := newMockOperationService()
+	p2p := &mockP2P{}
+	slasherService := NewSlasherService(context.Background(), &Config{
+		OperationService: operationService,
+		P2P:              p2p,
+	})
+	incoming := make(chan *pb.ProposerSlashing, 1)
+	sub := operationService.proposerSlashingFeed.Subscribe(incoming)
+	defer sub.Unsubscribe()
+
+	slasherService.proposals.add(3, newProposal(10, 'a'))
+	slashing := slasherService.proposals.add(3, newProposal(10, 'b'))
+	hash := [32]byte{'h'}
+	slasherService.emitProposerSlashing(hash, slashing)
+
+	if received := <-incoming; !proto.Equal(received, slashing) {
+		t.Errorf("Expected slashing %v to be sent to the operations service, received %v", slashing, received)
+	}
+	if len(p2p.broadcasted) != 1 {
+		t.Fatalf("Expected 1 broadcasted message, received %d", len(p2p.broadcasted))
+	}
+	response, ok := p2p.broadcasted[0].(*pb.ProposerSlashingResponse)
+	if !ok || !proto.Equal(response.ProposerSlashing, slashing) || string(response.Hash) != string(hash[:]) {
+		t.Errorf("Expected slashing %v to be broadcasted, received %v", slashing, p2p.broadcasted[0])
+	}
+	testutil.AssertLogsContain(t, hook, "Detected double proposal")
+}
+
+func TestEmitAttesterSlashing_SendsAndBroadcasts(t *testing.T) {
+	hook := logTest.NewGlobal()
+	operationService := newMockOperationService()
+	p2p := &mockP2P{}
+	slasherService := NewSlasherService(context.Background(), &Config{
+		OperationService: operationService,
+		P2P:              p2p,
+	})
+	incoming := make(chan *pb.AttesterSlashing, 1)
+	sub := operationService.attesterSlashingFeed.Subscribe(incoming)
+	defer sub.Unsubscribe()
+
+	slasherService.attestations.add(1, newVote(2, 8, 0))
+	slashing := slasherService.attestations.add(1, newVote(4, 5, 0))
+	hash := [32]byte{'h'}
+	slasherService.emitAttesterSlashing(hash, 1, slashing)
+
+	if received := <-incoming; !proto.Equal(received, slashing) {
+		t.Errorf("Expected slashing %v to be sent to the operations service, received %v", slashing, received)
+	}
+	if len(p2p.broadcasted) != 1 {
+		t.Fatalf("Expected 1 broadcasted message, received %d", len(p2p.broadcasted))
+	}
+	response, ok := p2p.broadcasted[0].(*pb.AttesterSlashingResponse)
+	if !ok || !proto.Equal(response.AttesterSlashing, slashing) || string(response.Hash) != string(hash[:]) {
+		t.Errorf("Expected slashing %v to be broadcasted, received %v", slashing, p2p.broadcasted[0])
+	}
+	testutil.AssertLogsContain(t, hook, "Detected slashable vote")
+}
+
+func TestPrune_KeepsHistoryEpochs(t *testing.T) {
+	slasherService := NewSlasherService(context.Background(), &Config{HistoryEpochs: 4})
+	slasherService.attestations.add(1, newVote(1, 3, 0))
+	slasherService.attestations.add(1, newVote(3, 6, 0))
+
+	slasherService.prune(3)
+	if slasherService.attestations.earliestEpoch != 0 {
+		t.Errorf("Expected no pruning before the history is full, earliest epoch is %d",
+			slasherService.attestations.earliestEpoch)
+	}
+	slasherService.prune(8)
+	if slasherService.attestations.earliestEpoch != 4 {
+		t.Errorf("Expected earliest epoch 4, received %d", slasherService.attestations.earliestEpoch)
+	}
+	if _, ok := slasherService.attestations.validators[1].votes[3]; ok {
+		t.Error("Expected vote before the history to be pruned")
+	}
+	if _, ok := slasherService.attestations.validators[1].votes[6]; !ok {
+		t.Error("Expected vote in the history to be kept")
+	}
+}
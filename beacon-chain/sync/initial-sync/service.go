@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -30,6 +31,11 @@ import (
 
 var log = logrus.WithField("prefix", "initial-sync")
 
+const (
+	defaultPendingBlocksSize  = 4096
+	defaultPendingBlockExpiry = 10 * time.Minute
+)
+
 // Config defines the configurable properties of InitialSync.
 //
 type Config struct {
@@ -38,6 +44,8 @@ type Config struct {
 	BlockAnnounceBufferSize int
 	BatchedBlockBufferSize  int
 	StateBufferSize         int
+	PendingBlocksSize       int
+	PendingBlockExpiry      time.Duration
 	BeaconDB                *db.BeaconDB
 	P2P                     p2pAPI
 	SyncService             syncService
@@ -48,6 +56,7 @@ type Config struct {
 // SyncPollingInterval determines how frequently the service checks that initial sync is complete.
 // BlockBufferSize determines that buffer size of the `blockBuf` channel.
 // CrystallizedStateBufferSize determines the buffer size of thhe `crystallizedStateBuf` channel.
+// PendingBlocksSize and PendingBlockExpiry bound the blocks held until their parent is synced.
 func DefaultConfig() *Config {
 	return &Config{
 		SyncPollingInterval:     time.Duration(params.BeaconConfig().SyncPollingInterval) * time.Second,
@@ -55,6 +64,8 @@ func DefaultConfig() *Config {
 		BatchedBlockBufferSize:  100,
 		BlockAnnounceBufferSize: 100,
 		StateBufferSize:         100,
+		PendingBlocksSize:       defaultPendingBlocksSize,
+		PendingBlockExpiry:      defaultPendingBlockExpiry,
 	}
 }
 
@@ -92,7 +103,7 @@ type InitialSync struct {
 	highestObservedSlot    uint64
 	syncPollingInterval    time.Duration
 	initialStateRootHash32 [32]byte
-	inMemoryBlocks         map[uint64]*pb.BeaconBlock
+	pendingBlocks          *blockchain.PendingBlockQueue
 }
 
 // NewInitialSyncService constructs a new InitialSyncService.
@@ -106,6 +117,14 @@ func NewInitialSyncService(ctx context.Context,
 	stateBuf := make(chan p2p.Message, cfg.StateBufferSize)
 	blockAnnounceBuf := make(chan p2p.Message, cfg.BlockAnnounceBufferSize)
 	batchedBlockBuf := make(chan p2p.Message, cfg.BatchedBlockBufferSize)
+	pendingBlocksSize := cfg.PendingBlocksSize
+	if pendingBlocksSize == 0 {
+		pendingBlocksSize = defaultPendingBlocksSize
+	}
+	pendingBlockExpiry := cfg.PendingBlockExpiry
+	if pendingBlockExpiry == 0 {
+		pendingBlockExpiry = defaultPendingBlockExpiry
+	}
 
 	return &InitialSync{
 		ctx:                 ctx,
@@ -121,7 +140,7 @@ func NewInitialSyncService(ctx context.Context,
 		batchedBlockBuf:     batchedBlockBuf,
 		blockAnnounceBuf:    blockAnnounceBuf,
 		syncPollingInterval: cfg.SyncPollingInterval,
-		inMemoryBlocks:      map[uint64]*pb.BeaconBlock{},
+		pendingBlocks:       blockchain.NewPendingBlockQueue(pendingBlocksSize, pendingBlockExpiry),
 	}
 }
 
@@ -246,11 +265,11 @@ func (s *InitialSync) checkInMemoryBlocks() {
 				return
 			}
 
-			if block, ok := s.inMemoryBlocks[0]; ok && s.currentSlot == 0 {
+			if block, ok := s.pendingBlockAtSlot(0); ok && s.currentSlot == 0 {
 				s.processBlock(block, p2p.Peer{})
 			}
 
-			if block, ok := s.inMemoryBlocks[s.currentSlot+1]; ok && s.currentSlot+1 <= s.highestObservedSlot {
+			if block, ok := s.pendingBlockAtSlot(s.currentSlot + 1); ok && s.currentSlot+1 <= s.highestObservedSlot {
 				s.processBlock(block, p2p.Peer{})
 			}
 		}
@@ -279,9 +298,7 @@ func (s *InitialSync) processBlock(block *pb.BeaconBlock, peer p2p.Peer) {
 		if block.Slot != 1 {
 
 			// saves block in memory if it isn't the initial block.
-			if _, ok := s.inMemoryBlocks[block.Slot]; !ok {
-				s.inMemoryBlocks[block.Slot] = block
-			}
+			s.savePendingBlock(block)
 			s.requestNextBlockBySlot(1)
 			return
 		}
@@ -297,9 +314,7 @@ func (s *InitialSync) processBlock(block *pb.BeaconBlock, peer p2p.Peer) {
 	}
 	// if it isn't the block in the next slot it saves it in memory.
 	if block.Slot != (s.currentSlot + 1) {
-		if _, ok := s.inMemoryBlocks[block.Slot]; !ok {
-			s.inMemoryBlocks[block.Slot] = block
-		}
+		s.savePendingBlock(block)
 		return
 	}
 
@@ -310,6 +325,26 @@ func (s *InitialSync) processBlock(block *pb.BeaconBlock, peer p2p.Peer) {
 
 }
 
+// savePendingBlock holds a block in memory until the blocks before it are synced,
+// unless a block is already held for its slot.
+func (s *InitialSync) savePendingBlock(block *pb.BeaconBlock) {
+	if _, ok := s.pendingBlockAtSlot(block.Slot); ok {
+		return
+	}
+	if err := s.pendingBlocks.Insert(block); err != nil {
+		log.Debugf("Could not hold block with slot %d in memory: %v", block.Slot, err)
+	}
+}
+
+// pendingBlockAtSlot returns the block held in memory for the slot.
+func (s *InitialSync) pendingBlockAtSlot(slot uint64) (*pb.BeaconBlock, bool) {
+	blocks := s.pendingBlocks.BlocksAtSlot(slot)
+	if len(blocks) == 0 {
+		return nil, false
+	}
+	return blocks[0], true
+}
+
 // processBatchedBlocks processes all the received blocks from
 // the p2p message.
 func (s *InitialSync) processBatchedBlocks(msg p2p.Message) {
@@ -355,7 +390,7 @@ func (s *InitialSync) setBlockForInitialSync(block *pb.BeaconBlock) error {
 // requestNextBlock broadcasts a request for a block with the entered slotnumber.
 func (s *InitialSync) requestNextBlockBySlot(slotNumber uint64) {
 	log.Debugf("Requesting block %d ", slotNumber)
-	if block, ok := s.inMemoryBlocks[slotNumber]; ok {
+	if block, ok := s.pendingBlockAtSlot(slotNumber); ok {
 		s.processBlock(block, p2p.Peer{})
 		return
 	}
@@ -395,9 +430,7 @@ func (s *InitialSync) validateAndSaveNextBlock(block *pb.BeaconBlock) error {
 		s.currentSlot = block.Slot
 
 		// delete block from memory
-		if _, ok := s.inMemoryBlocks[block.Slot]; ok {
-			delete(s.inMemoryBlocks, block.Slot)
-		}
+		s.pendingBlocks.Remove(h)
 
 		// Send block to main chain service to be processed
 		s.chainService.IncomingBlockFeed().Send(block)
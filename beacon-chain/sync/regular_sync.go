@@ -4,6 +4,7 @@ package sync
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/gogo/protobuf/proto"
 	att "github.com/prysmaticlabs/prysm/beacon-chain/core/attestations"
@@ -22,11 +23,14 @@ var log = logrus.WithField("prefix", "regular-sync")
 
 type chainService interface {
 	IncomingBlockFeed() *event.Feed
+	BlockRequestFeed() *event.Feed
 }
 
 type operationService interface {
 	IncomingExitFeed() *event.Feed
 	IncomingAttFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 }
 
 type p2pAPI interface {
@@ -61,8 +65,13 @@ type RegularSync struct {
 	blockRequestByHash    chan p2p.Message
 	batchedRequestBuf     chan p2p.Message
 	chainHeadReqBuf       chan p2p.Message
+	missingBlockBuf       chan *pb.BeaconBlockRequest
 	attestationBuf        chan p2p.Message
 	exitBuf               chan p2p.Message
+	proposerSlashingBuf   chan p2p.Message
+	attesterSlashingBuf   chan p2p.Message
+	running               int32
+	done                  chan struct{}
 }
 
 // RegularSyncConfig allows the channel's buffer sizes to be changed.
@@ -74,7 +83,9 @@ type RegularSyncConfig struct {
 	BatchedBufferSize       int
 	AttestationBufferSize   int
 	ExitBufferSize          int
+	SlashingBufferSize      int
 	ChainHeadReqBufferSize  int
+	MissingBlockBufferSize  int
 	ChainService            chainService
 	OperationService        operationService
 	BeaconDB                *db.BeaconDB
@@ -92,6 +103,8 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		ChainHeadReqBufferSize:  100,
 		AttestationBufferSize:   100,
 		ExitBufferSize:          100,
+		SlashingBufferSize:      100,
+		MissingBlockBufferSize:  100,
 	}
 }
 
@@ -113,24 +126,34 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		batchedRequestBuf:     make(chan p2p.Message, cfg.BatchedBufferSize),
 		attestationBuf:        make(chan p2p.Message, cfg.AttestationBufferSize),
 		exitBuf:               make(chan p2p.Message, cfg.ExitBufferSize),
+		proposerSlashingBuf:   make(chan p2p.Message, cfg.SlashingBufferSize),
+		attesterSlashingBuf:   make(chan p2p.Message, cfg.SlashingBufferSize),
 		chainHeadReqBuf:       make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
+		missingBlockBuf:       make(chan *pb.BeaconBlockRequest, cfg.MissingBlockBufferSize),
+		done:                  make(chan struct{}),
 	}
 }
 
 // Start begins the block processing goroutine.
 func (rs *RegularSync) Start() {
+	atomic.StoreInt32(&rs.running, 1)
 	go rs.run()
 }
 
 // ResumeSync resumes normal sync after initial sync is complete.
 func (rs *RegularSync) ResumeSync() {
+	atomic.StoreInt32(&rs.running, 1)
 	go rs.run()
 }
 
-// Stop kills the block processing goroutine, but does not wait until the goroutine exits.
+// Stop kills the block processing goroutine, waiting until the blocks and operations
+// it already received are forwarded and the goroutine exits.
 func (rs *RegularSync) Stop() error {
 	log.Info("Stopping service")
 	rs.cancel()
+	if atomic.CompareAndSwapInt32(&rs.running, 1, 0) {
+		<-rs.done
+	}
 	return nil
 }
 
@@ -149,7 +172,10 @@ func (rs *RegularSync) run() {
 	batchedRequestSub := rs.p2p.Subscribe(&pb.BatchedBeaconBlockRequest{}, rs.batchedRequestBuf)
 	attestationSub := rs.p2p.Subscribe(&pb.Attestation{}, rs.attestationBuf)
 	exitSub := rs.p2p.Subscribe(&pb.Exit{}, rs.exitBuf)
+	proposerSlashingSub := rs.p2p.Subscribe(&pb.ProposerSlashingResponse{}, rs.proposerSlashingBuf)
+	attesterSlashingSub := rs.p2p.Subscribe(&pb.AttesterSlashingResponse{}, rs.attesterSlashingBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
+	missingBlockSub := rs.chainService.BlockRequestFeed().Subscribe(rs.missingBlockBuf)
 
 	defer announceBlockSub.Unsubscribe()
 	defer blockSub.Unsubscribe()
@@ -159,11 +185,16 @@ func (rs *RegularSync) run() {
 	defer chainHeadReqSub.Unsubscribe()
 	defer attestationSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
+	defer proposerSlashingSub.Unsubscribe()
+	defer attesterSlashingSub.Unsubscribe()
+	defer missingBlockSub.Unsubscribe()
 
 	for {
 		select {
 		case <-rs.ctx.Done():
 			log.Debug("Exiting goroutine")
+			rs.drainBuffers()
+			close(rs.done)
 			return
 		case msg := <-rs.announceBlockBuf:
 			rs.receiveBlockAnnounce(msg)
@@ -171,6 +202,10 @@ func (rs *RegularSync) run() {
 			rs.receiveAttestation(msg)
 		case msg := <-rs.exitBuf:
 			rs.receiveExitRequest(msg)
+		case msg := <-rs.proposerSlashingBuf:
+			rs.receiveProposerSlashing(msg)
+		case msg := <-rs.attesterSlashingBuf:
+			rs.receiveAttesterSlashing(msg)
 		case msg := <-rs.blockBuf:
 			rs.receiveBlock(msg)
 		case msg := <-rs.blockRequestBySlot:
@@ -181,6 +216,30 @@ func (rs *RegularSync) run() {
 			rs.handleBatchedBlockRequest(msg)
 		case msg := <-rs.chainHeadReqBuf:
 			rs.handleChainHeadRequest(msg)
+		case request := <-rs.missingBlockBuf:
+			rs.requestMissingBlock(request)
+		}
+	}
+}
+
+// drainBuffers forwards the blocks and operations that were received before the
+// service was stopped, so that they are not lost with the buffered channels.
+// Pending requests from peers are left unanswered.
+func (rs *RegularSync) drainBuffers() {
+	for {
+		select {
+		case msg := <-rs.blockBuf:
+			rs.receiveBlock(msg)
+		case msg := <-rs.attestationBuf:
+			rs.receiveAttestation(msg)
+		case msg := <-rs.exitBuf:
+			rs.receiveExitRequest(msg)
+		case msg := <-rs.proposerSlashingBuf:
+			rs.receiveProposerSlashing(msg)
+		case msg := <-rs.attesterSlashingBuf:
+			rs.receiveAttesterSlashing(msg)
+		default:
+			return
 		}
 	}
 }
@@ -207,6 +266,18 @@ func (rs *RegularSync) receiveBlockAnnounce(msg p2p.Message) {
 	sendBlockRequestSpan.End()
 }
 
+// requestMissingBlock asks the network for a block the chain service needs, such as
+// the parent of a block it received. The block is processed like any other block
+// response once a peer sends it.
+func (rs *RegularSync) requestMissingBlock(request *pb.BeaconBlockRequest) {
+	h := bytesutil.ToBytes32(request.Hash)
+	if rs.db.HasBlock(h) {
+		return
+	}
+	log.WithField("blockHash", fmt.Sprintf("%#x", h)).Debug("Requesting missing block from peers")
+	rs.p2p.Broadcast(request)
+}
+
 // receiveBlock processes a block from the p2p layer.
 func (rs *RegularSync) receiveBlock(msg p2p.Message) {
 	ctx, receiveBlockSpan := trace.StartSpan(msg.Ctx, "RegularSync_receiveBlock")
@@ -306,18 +377,13 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) {
 	a := data
 	h := att.Key(a.Data)
 
-	attestation, err := rs.db.Attestation(h)
-	if err != nil {
-		log.Errorf("Could not check for attestation in DB: %v", err)
-		return
-	}
 	if rs.db.HasAttestation(h) {
 		log.Debugf("Received, skipping attestation #%x", h)
 		return
 	}
 
 	log.WithField("attestationHash", fmt.Sprintf("%#x", h)).Debug("Forwarding attestation to subscribed services")
-	rs.operationsService.IncomingAttFeed().Send(attestation)
+	rs.operationsService.IncomingAttFeed().Send(a)
 }
 
 // receiveExitRequest accepts an broadcasted exit from the p2p layer,
@@ -341,6 +407,32 @@ func (rs *RegularSync) receiveExitRequest(msg p2p.Message) {
 	rs.operationsService.IncomingExitFeed().Send(exit)
 }
 
+// receiveProposerSlashing accepts a broadcasted proposer slashing from the p2p layer
+// and sends it to the operation service, which discards slashings it has already pooled.
+func (rs *RegularSync) receiveProposerSlashing(msg p2p.Message) {
+	response := msg.Data.(*pb.ProposerSlashingResponse)
+	if response.ProposerSlashing == nil {
+		log.Debug("Received proposer slashing message without a slashing")
+		return
+	}
+	log.WithField("slashingHash", fmt.Sprintf("%#x", response.Hash)).
+		Debug("Forwarding proposer slashing to subscribed services")
+	rs.operationsService.IncomingProposerSlashingFeed().Send(response.ProposerSlashing)
+}
+
+// receiveAttesterSlashing accepts a broadcasted attester slashing from the p2p layer
+// and sends it to the operation service, which discards slashings it has already pooled.
+func (rs *RegularSync) receiveAttesterSlashing(msg p2p.Message) {
+	response := msg.Data.(*pb.AttesterSlashingResponse)
+	if response.AttesterSlashing == nil {
+		log.Debug("Received attester slashing message without a slashing")
+		return
+	}
+	log.WithField("slashingHash", fmt.Sprintf("%#x", response.Hash)).
+		Debug("Forwarding attester slashing to subscribed services")
+	rs.operationsService.IncomingAttesterSlashingFeed().Send(response.AttesterSlashing)
+}
+
 func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) {
 	data := msg.Data.(*pb.BeaconBlockRequest)
 
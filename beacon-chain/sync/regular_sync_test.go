@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
@@ -45,6 +46,10 @@ func (ms *mockChainService) IncomingBlockFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockChainService) BlockRequestFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 type mockOperationService struct{}
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -55,6 +60,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 func setupInitialDeposits(t *testing.T) []*pb.Deposit {
 	genesisValidatorRegistry := validators.InitialValidatorRegistry()
 	deposits := make([]*pb.Deposit, len(genesisValidatorRegistry))
@@ -83,6 +96,24 @@ func setupService(t *testing.T, db *db.BeaconDB) *RegularSync {
 	return NewRegularSyncService(context.Background(), cfg)
 }
 
+func TestStatus_SyncingOnceRegularSyncStops(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	rs := setupService(t, db)
+	ss := &Service{RegularSync: rs}
+
+	rs.Start()
+	if err := ss.Status(); err != nil {
+		t.Errorf("Expected healthy status while regular sync runs, received %v", err)
+	}
+	if err := rs.Stop(); err != nil {
+		t.Fatalf("Could not stop regular sync: %v", err)
+	}
+	if level := shared.StatusLevelOf(ss.Status()); level != shared.StatusSyncing {
+		t.Errorf("Expected %v status once regular sync stopped, received %v", shared.StatusSyncing, level)
+	}
+}
+
 func TestProcessBlockHash(t *testing.T) {
 	hook := logTest.NewGlobal()
 
@@ -2,9 +2,12 @@ package sync
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/sirupsen/logrus"
 )
 
@@ -41,6 +44,7 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.ChainService = cfg.ChainService
 	rsCfg.BeaconDB = cfg.BeaconDB
 	rsCfg.P2P = cfg.P2P
+	rsCfg.OperationService = cfg.OperationService
 
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
@@ -76,9 +80,13 @@ func (ss *Service) Stop() error {
 	return ss.RegularSync.Stop()
 }
 
-// Status always returns nil.
+// Status reports the service as syncing while regular sync is not running, which
+// is until initial sync has caught up with the network and once the service stops.
 // TODO(1206): Add service health checks.
 func (ss *Service) Status() error {
+	if atomic.LoadInt32(&ss.RegularSync.running) == 0 {
+		return shared.Syncing(errors.New("initial sync in progress"))
+	}
 	return nil
 }
 
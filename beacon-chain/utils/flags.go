@@ -43,12 +43,52 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// GatewayPort defines the port of the optional JSON/HTTP gateway to the RPC services.
+	GatewayPort = cli.StringFlag{
+		Name:  "gateway-port",
+		Usage: "Serve the beacon node RPC services as JSON over HTTP on this port. The gateway uses the tls-cert and tls-key flags as well, and is disabled if no port is given.",
+	}
+	// GatewayEnableWrites defines a flag to serve the state-changing RPC methods through the gateway.
+	GatewayEnableWrites = cli.BoolFlag{
+		Name:  "gateway-enable-writes",
+		Usage: "Serve the methods proposing blocks, attestations and exits through the JSON/HTTP gateway, which does not authenticate its clients. Only read-only methods are served otherwise.",
+	}
 	// GenesisJSON defines a flag for bootstrapping validators from genesis JSON.
 	// If this flag is not specified, beacon node will bootstrap validators from code from crystallized_state.go.
 	GenesisJSON = cli.StringFlag{
 		Name:  "genesis-json",
 		Usage: "Beacon node will bootstrap genesis state defined in genesis.json",
 	}
+	// GenesisFile defines a flag for a genesis state and block written by the genesis command.
+	GenesisFile = cli.StringFlag{
+		Name:  "genesis-file",
+		Usage: "Start the beacon chain from the genesis state in this file instead of waiting for ChainStart from the deposit contract",
+	}
+	// NumValidatorsFlag defines the number of deterministic validators in a generated genesis state.
+	NumValidatorsFlag = cli.Uint64Flag{
+		Name:  "num-validators",
+		Usage: "Number of validators with deterministic keys in the generated genesis state",
+	}
+	// GenesisTimeFlag defines the genesis time of a generated genesis state.
+	GenesisTimeFlag = cli.Int64Flag{
+		Name:  "genesis-time",
+		Usage: "Unix timestamp of the generated genesis state, defaults to the current time",
+	}
+	// ChainFileFlag defines the file a chain is exported to or imported from by the db command.
+	ChainFileFlag = cli.StringFlag{
+		Name:  "chain-file",
+		Usage: "File the beacon chain is exported to or imported from",
+	}
+	// StartSlotFlag defines the first slot of the chain exported by the db command.
+	StartSlotFlag = cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "First slot of the exported chain, which starts from the latest saved state at or before it",
+	}
+	// EndSlotFlag defines the last slot of the chain exported by the db command.
+	EndSlotFlag = cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Last slot of the exported chain, defaults to the slot of the chain head",
+	}
 	// EnablePOWChain tells the beacon node to use a real web3 endpoint. Disabled by default.
 	EnablePOWChain = cli.BoolFlag{
 		Name:  "enable-powchain",
@@ -59,4 +99,20 @@ var (
 		Name:  "enable-db-cleanup",
 		Usage: "Enable automatic DB cleanup routine",
 	}
+	// EnableSlasher tells the beacon node to watch the blocks and attestations it receives for slashable offences.
+	EnableSlasher = cli.BoolFlag{
+		Name:  "enable-slasher",
+		Usage: "Detect double proposals and double or surround votes, and broadcast the slashings of the offending validators",
+	}
+	// EnableRewardReports tells the beacon node to report the rewards and penalties of every validator at each epoch transition.
+	EnableRewardReports = cli.BoolFlag{
+		Name:  "enable-reward-reports",
+		Usage: "Record the rewards and penalties every validator receives at each epoch transition of the canonical chain, served by the ValidatorPerformance RPC method",
+	}
+	// SlasherHistoryEpochs defines the number of epochs of blocks and attestations the slasher keeps indexed.
+	SlasherHistoryEpochs = cli.Uint64Flag{
+		Name:  "slasher-history-epochs",
+		Usage: "Number of epochs of proposals and attestations the slasher checks new ones against",
+		Value: 256,
+	}
 )
@@ -3,6 +3,7 @@ package utils
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -11,7 +12,22 @@ import (
 
 // ShuffleIndices returns a list of pseudorandomly sampled
 // indices. This is used to shuffle validators on ETH2.0 beacon chain.
+// The list is shuffled in place with the shuffle algorithm of the
+// beacon chain config.
 func ShuffleIndices(seed common.Hash, indicesList []uint64) ([]uint64, error) {
+	switch algorithm := params.BeaconConfig().ShuffleAlgorithm; algorithm {
+	case params.FisherYatesShuffle:
+		return FisherYatesShuffle(seed, indicesList)
+	case params.SwapOrNotShuffle:
+		return SwapOrNotShuffle(seed, indicesList)
+	default:
+		return nil, fmt.Errorf("unknown shuffle algorithm %q", algorithm)
+	}
+}
+
+// FisherYatesShuffle shuffles the list in place with a Fisher-Yates shuffle,
+// sampling randBytes of the seed's hash at a time.
+func FisherYatesShuffle(seed common.Hash, indicesList []uint64) ([]uint64, error) {
 	// Each entropy is consumed from the seed in randBytes chunks.
 	randBytes := params.BeaconConfig().RandBytes
 
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxSwapOrNotListSize is the size of the largest list the swap-or-not shuffle
+// permutes, as defined by the spec.
+const maxSwapOrNotListSize = 1 << 40
+
+// SwapOrNotShuffle shuffles the list in place with the swap-or-not shuffle. The
+// element at index i of the shuffled list is the element at ShuffledIndex(i) of
+// the input list.
+//
+// Spec pseudocode definition:
+//   shuffled_active_validator_indices = [
+//       active_validator_indices[get_permuted_index(i, len(active_validator_indices), seed)]
+//       for i in range(len(active_validator_indices))
+//   ]
+func SwapOrNotShuffle(seed common.Hash, list []uint64) ([]uint64, error) {
+	listSize := uint64(len(list))
+	if listSize > maxSwapOrNotListSize {
+		return nil, fmt.Errorf("list size %d exceeds the swap-or-not maximum %d", listSize, uint64(maxSwapOrNotListSize))
+	}
+	if listSize < 2 {
+		return list, nil
+	}
+	// Every round swaps pairs of elements, so applying the rounds to the list in
+	// reverse order moves each element to the index the rounds map it from.
+	for r := params.BeaconConfig().ShuffleRoundCount; r > 0; r-- {
+		round := byte(r - 1)
+		pivot := roundPivot(seed, round, listSize)
+		var source [32]byte
+		sourceChunk := ^uint64(0)
+		for i := uint64(0); i < listSize; i++ {
+			// The pair is swapped by the round when the bit of its larger index
+			// is set, and only visited from its smaller index.
+			flip := (pivot + listSize - i) % listSize
+			if flip <= i {
+				continue
+			}
+			if flip/256 != sourceChunk {
+				sourceChunk = flip / 256
+				source = roundSource(seed, round, sourceChunk)
+			}
+			if swapBit(source, flip) {
+				list[i], list[flip] = list[flip], list[i]
+			}
+		}
+	}
+	return list, nil
+}
+
+// ShuffledIndex returns the index of the input list the element at the given
+// index of a list shuffled with the swap-or-not shuffle comes from, without
+// shuffling the list.
+//
+// Spec pseudocode definition:
+//   def get_permuted_index(index: int, list_size: int, seed: Bytes32) -> int:
+//       """
+//       Return `p(index)` in a pseudorandom permutation `p` of `0...list_size-1` with ``seed`` as entropy.
+//
+//       Utilizes 'swap or not' shuffling found in
+//       https://link.springer.com/content/pdf/10.1007%2F978-3-642-32009-5_1.pdf
+//       See the 'generalized domain' algorithm on page 3.
+//       """
+//       assert index < list_size
+//       assert list_size <= 2**40
+//
+//       for round in range(SHUFFLE_ROUND_COUNT):
+//           pivot = bytes_to_int(hash(seed + int_to_bytes1(round))[0:8]) % list_size
+//           flip = (pivot - index) % list_size
+//           position = max(index, flip)
+//           source = hash(seed + int_to_bytes1(round) + int_to_bytes4(position // 256))
+//           byte = source[(position % 256) // 8]
+//           bit = (byte >> (position % 8)) % 2
+//           index = flip if bit else index
+//
+//       return index
+func ShuffledIndex(index uint64, listSize uint64, seed common.Hash) (uint64, error) {
+	if err := checkSwapOrNotIndex(index, listSize); err != nil {
+		return 0, err
+	}
+	for r := uint64(0); r < params.BeaconConfig().ShuffleRoundCount; r++ {
+		index = swapOrNot(seed, byte(r), index, listSize)
+	}
+	return index, nil
+}
+
+// UnshuffledIndex returns the index the element at the given index of the input
+// list lands at once the list is shuffled with the swap-or-not shuffle, without
+// shuffling the list. It is the inverse of ShuffledIndex.
+func UnshuffledIndex(index uint64, listSize uint64, seed common.Hash) (uint64, error) {
+	if err := checkSwapOrNotIndex(index, listSize); err != nil {
+		return 0, err
+	}
+	for r := params.BeaconConfig().ShuffleRoundCount; r > 0; r-- {
+		index = swapOrNot(seed, byte(r-1), index, listSize)
+	}
+	return index, nil
+}
+
+func checkSwapOrNotIndex(index uint64, listSize uint64) error {
+	if index >= listSize {
+		return fmt.Errorf("index %d out of bounds of list size %d", index, listSize)
+	}
+	if listSize > maxSwapOrNotListSize {
+		return fmt.Errorf("list size %d exceeds the swap-or-not maximum %d", listSize, uint64(maxSwapOrNotListSize))
+	}
+	return nil
+}
+
+// swapOrNot returns the index the round maps the index to.
+func swapOrNot(seed common.Hash, round byte, index uint64, listSize uint64) uint64 {
+	pivot := roundPivot(seed, round, listSize)
+	flip := (pivot + listSize - index) % listSize
+	position := index
+	if flip > position {
+		position = flip
+	}
+	if swapBit(roundSource(seed, round, position/256), position) {
+		return flip
+	}
+	return index
+}
+
+func roundPivot(seed common.Hash, round byte, listSize uint64) uint64 {
+	hash := hashutil.Hash(append(seed[:], round))
+	return binary.LittleEndian.Uint64(hash[:8]) % listSize
+}
+
+// roundSource returns the hash holding the swap bits of the chunk of 256 positions.
+func roundSource(seed common.Hash, round byte, chunk uint64) [32]byte {
+	buf := make([]byte, 0, len(seed)+5)
+	buf = append(buf, seed[:]...)
+	buf = append(buf, round)
+	buf = append(buf, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(buf[len(seed)+1:], uint32(chunk))
+	return hashutil.Hash(buf)
+}
+
+func swapBit(source [32]byte, position uint64) bool {
+	return (source[(position%256)/8]>>(position%8))&1 == 1
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSwapOrNotShuffle_MatchesShuffledIndex(t *testing.T) {
+	seed := common.BytesToHash([]byte("swap or not"))
+	for _, listSize := range []uint64{0, 1, 2, 3, 10, 255, 256, 257, 1000} {
+		list := make([]uint64, listSize)
+		for i := range list {
+			list[i] = uint64(i) * 2
+		}
+		shuffled, err := SwapOrNotShuffle(seed, append([]uint64{}, list...))
+		if err != nil {
+			t.Fatalf("Could not shuffle list of size %d: %v", listSize, err)
+		}
+		for i := uint64(0); i < listSize; i++ {
+			shuffledIndex, err := ShuffledIndex(i, listSize, seed)
+			if err != nil {
+				t.Fatalf("Could not compute shuffled index: %v", err)
+			}
+			if shuffled[i] != list[shuffledIndex] {
+				t.Fatalf("Expected element %d of list of size %d to be %d, received %d",
+					i, listSize, list[shuffledIndex], shuffled[i])
+			}
+			unshuffledIndex, err := UnshuffledIndex(shuffledIndex, listSize, seed)
+			if err != nil {
+				t.Fatalf("Could not compute unshuffled index: %v", err)
+			}
+			if unshuffledIndex != i {
+				t.Fatalf("Expected element %d of list of size %d to land at %d, received %d",
+					shuffledIndex, listSize, i, unshuffledIndex)
+			}
+		}
+	}
+}
+
+// TestShuffledIndex_SpecVectors checks the shuffled indices against vectors computed
+// by running the get_permuted_index pseudocode of the spec, with Keccak-256 as its
+// hash and 90 rounds, rather than against the output of this package.
+func TestShuffledIndex_SpecVectors(t *testing.T) {
+	keccakSeed := common.HexToHash("0xad9dfdb0099486cc6cef9dd3fa98bd66b3b4ea711926c95d35e9265ec135158a")
+	tests := []struct {
+		seed     common.Hash
+		listSize uint64
+		index    uint64
+		shuffled uint64
+	}{
+		{seed: common.Hash{}, listSize: 1, index: 0, shuffled: 0},
+		{seed: common.Hash{}, listSize: 2, index: 0, shuffled: 0},
+		{seed: common.Hash{}, listSize: 2, index: 1, shuffled: 1},
+		{seed: common.Hash{}, listSize: 10, index: 3, shuffled: 8},
+		{seed: keccakSeed, listSize: 10, index: 3, shuffled: 0},
+		{seed: keccakSeed, listSize: 256, index: 255, shuffled: 14},
+		{seed: keccakSeed, listSize: 257, index: 256, shuffled: 155},
+		{seed: keccakSeed, listSize: 1000, index: 0, shuffled: 695},
+		{seed: keccakSeed, listSize: 1000, index: 999, shuffled: 450},
+		{seed: keccakSeed, listSize: 1000, index: 512, shuffled: 981},
+		{seed: common.Hash{}, listSize: 1<<33 + 1, index: 1 << 33, shuffled: 1017772518},
+		{seed: keccakSeed, listSize: 1<<33 + 1, index: 12345, shuffled: 2828160531},
+	}
+	defaultConfig := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(defaultConfig)
+	cfg := *defaultConfig
+	cfg.ShuffleRoundCount = 90
+	params.OverrideBeaconConfig(&cfg)
+	for _, tt := range tests {
+		shuffled, err := ShuffledIndex(tt.index, tt.listSize, tt.seed)
+		if err != nil {
+			t.Fatalf("Could not compute shuffled index: %v", err)
+		}
+		if shuffled != tt.shuffled {
+			t.Errorf("Expected index %d of list of size %d with seed %#x to be shuffled from %d, received %d",
+				tt.index, tt.listSize, tt.seed, tt.shuffled, shuffled)
+		}
+	}
+}
+
+func TestSwapOrNotShuffle_Permutes(t *testing.T) {
+	list := make([]uint64, 100)
+	for i := range list {
+		list[i] = uint64(i)
+	}
+	shuffled1, err := SwapOrNotShuffle(common.Hash{'a'}, append([]uint64{}, list...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	shuffled2, err := SwapOrNotShuffle(common.Hash{'b'}, append([]uint64{}, list...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(shuffled1, list) || reflect.DeepEqual(shuffled1, shuffled2) {
+		t.Error("Expected lists shuffled with different seeds to differ")
+	}
+	seen := make(map[uint64]bool)
+	for _, index := range shuffled1 {
+		seen[index] = true
+	}
+	if len(seen) != len(list) {
+		t.Errorf("Expected a permutation of the list, received %v", shuffled1)
+	}
+}
+
+func TestShuffledIndex_OutOfBounds(t *testing.T) {
+	if _, err := ShuffledIndex(10, 10, common.Hash{}); err == nil {
+		t.Error("Expected error when the index is out of bounds")
+	}
+	if _, err := UnshuffledIndex(0, maxSwapOrNotListSize+1, common.Hash{}); err == nil {
+		t.Error("Expected error when the list exceeds the maximum size")
+	}
+}
+
+func TestShuffleIndices_SelectsConfiguredAlgorithm(t *testing.T) {
+	defaultConfig := params.BeaconConfig()
+	defer params.OverrideBeaconConfig(defaultConfig)
+	list := []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	seed := common.Hash{'a'}
+
+	cfg := *defaultConfig
+	cfg.ShuffleAlgorithm = params.SwapOrNotShuffle
+	params.OverrideBeaconConfig(&cfg)
+	shuffled, err := ShuffleIndices(seed, append([]uint64{}, list...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := SwapOrNotShuffle(seed, append([]uint64{}, list...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(shuffled, want) {
+		t.Errorf("Expected swap-or-not shuffle %v, received %v", want, shuffled)
+	}
+
+	cfg.ShuffleAlgorithm = "unknown"
+	if _, err := ShuffleIndices(seed, list); err == nil {
+		t.Error("Expected error when shuffling with an unknown algorithm")
+	}
+}
+
+func BenchmarkShuffledIndex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ShuffledIndex(uint64(i)%100000, 100000, common.Hash{'a'}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSwapOrNotShuffle_100kValidators(b *testing.B) {
+	list := make([]uint64, 100000)
+	for i := range list {
+		list[i] = uint64(i)
+	}
+	for i := 0; i < b.N; i++ {
+		if _, err := SwapOrNotShuffle(common.Hash{'a'}, list); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
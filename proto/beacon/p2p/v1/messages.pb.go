@@ -38,6 +38,9 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_PROPOSER_SLASHING_RESPONSE          Topic = 15
+	Topic_ATTESTER_SLASHING_RESPONSE          Topic = 16
+	Topic_EXIT_REQUEST                        Topic = 17
 )
 
 var Topic_name = map[int32]string{
@@ -56,6 +59,9 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "PROPOSER_SLASHING_RESPONSE",
+	16: "ATTESTER_SLASHING_RESPONSE",
+	17: "EXIT_REQUEST",
 }
 var Topic_value = map[string]int32{
 	"UNKNOWN":                             0,
@@ -73,13 +79,16 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"PROPOSER_SLASHING_RESPONSE":          15,
+	"ATTESTER_SLASHING_RESPONSE":          16,
+	"EXIT_REQUEST":                        17,
 }
 
 func (x Topic) String() string {
 	return proto.EnumName(Topic_name, int32(x))
 }
 func (Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{0}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{0}
 }
 
 type BeaconBlockAnnounce struct {
@@ -94,7 +103,7 @@ func (m *BeaconBlockAnnounce) Reset()         { *m = BeaconBlockAnnounce{} }
 func (m *BeaconBlockAnnounce) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlockAnnounce) ProtoMessage()    {}
 func (*BeaconBlockAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{0}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{0}
 }
 func (m *BeaconBlockAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,7 +157,7 @@ func (m *BeaconBlockRequest) Reset()         { *m = BeaconBlockRequest{} }
 func (m *BeaconBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlockRequest) ProtoMessage()    {}
 func (*BeaconBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{1}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{1}
 }
 func (m *BeaconBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +204,7 @@ func (m *BeaconBlockRequestBySlotNumber) Reset()         { *m = BeaconBlockReque
 func (m *BeaconBlockRequestBySlotNumber) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlockRequestBySlotNumber) ProtoMessage()    {}
 func (*BeaconBlockRequestBySlotNumber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{2}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{2}
 }
 func (m *BeaconBlockRequestBySlotNumber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +252,7 @@ func (m *BeaconBlockResponse) Reset()         { *m = BeaconBlockResponse{} }
 func (m *BeaconBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlockResponse) ProtoMessage()    {}
 func (*BeaconBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{3}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{3}
 }
 func (m *BeaconBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +307,7 @@ func (m *BatchedBeaconBlockRequest) Reset()         { *m = BatchedBeaconBlockReq
 func (m *BatchedBeaconBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchedBeaconBlockRequest) ProtoMessage()    {}
 func (*BatchedBeaconBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{4}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{4}
 }
 func (m *BatchedBeaconBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +361,7 @@ func (m *BatchedBeaconBlockResponse) Reset()         { *m = BatchedBeaconBlockRe
 func (m *BatchedBeaconBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchedBeaconBlockResponse) ProtoMessage()    {}
 func (*BatchedBeaconBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{5}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{5}
 }
 func (m *BatchedBeaconBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +407,7 @@ func (m *ChainHeadRequest) Reset()         { *m = ChainHeadRequest{} }
 func (m *ChainHeadRequest) String() string { return proto.CompactTextString(m) }
 func (*ChainHeadRequest) ProtoMessage()    {}
 func (*ChainHeadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{6}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{6}
 }
 func (m *ChainHeadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +449,7 @@ func (m *ChainHeadResponse) Reset()         { *m = ChainHeadResponse{} }
 func (m *ChainHeadResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainHeadResponse) ProtoMessage()    {}
 func (*ChainHeadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{7}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{7}
 }
 func (m *ChainHeadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +510,7 @@ func (m *BeaconStateHashAnnounce) Reset()         { *m = BeaconStateHashAnnounce
 func (m *BeaconStateHashAnnounce) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateHashAnnounce) ProtoMessage()    {}
 func (*BeaconStateHashAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{8}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{8}
 }
 func (m *BeaconStateHashAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +557,7 @@ func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
 func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateRequest) ProtoMessage()    {}
 func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{9}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{9}
 }
 func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +604,7 @@ func (m *BeaconStateResponse) Reset()         { *m = BeaconStateResponse{} }
 func (m *BeaconStateResponse) String() string { return proto.CompactTextString(m) }
 func (*BeaconStateResponse) ProtoMessage()    {}
 func (*BeaconStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{10}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{10}
 }
 func (m *BeaconStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +651,7 @@ func (m *AttestationAnnounce) Reset()         { *m = AttestationAnnounce{} }
 func (m *AttestationAnnounce) String() string { return proto.CompactTextString(m) }
 func (*AttestationAnnounce) ProtoMessage()    {}
 func (*AttestationAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{11}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{11}
 }
 func (m *AttestationAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +698,7 @@ func (m *AttestationRequest) Reset()         { *m = AttestationRequest{} }
 func (m *AttestationRequest) String() string { return proto.CompactTextString(m) }
 func (*AttestationRequest) ProtoMessage()    {}
 func (*AttestationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{12}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{12}
 }
 func (m *AttestationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +746,7 @@ func (m *AttestationResponse) Reset()         { *m = AttestationResponse{} }
 func (m *AttestationResponse) String() string { return proto.CompactTextString(m) }
 func (*AttestationResponse) ProtoMessage()    {}
 func (*AttestationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{13}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{13}
 }
 func (m *AttestationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +800,7 @@ func (m *ProposerSlashingAnnounce) Reset()         { *m = ProposerSlashingAnnoun
 func (m *ProposerSlashingAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingAnnounce) ProtoMessage()    {}
 func (*ProposerSlashingAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{14}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{14}
 }
 func (m *ProposerSlashingAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +847,7 @@ func (m *ProposerSlashingRequest) Reset()         { *m = ProposerSlashingRequest
 func (m *ProposerSlashingRequest) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingRequest) ProtoMessage()    {}
 func (*ProposerSlashingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{15}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{15}
 }
 func (m *ProposerSlashingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,7 +895,7 @@ func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingRespon
 func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingResponse) ProtoMessage()    {}
 func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{16}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{16}
 }
 func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +949,7 @@ func (m *AttesterSlashingAnnounce) Reset()         { *m = AttesterSlashingAnnoun
 func (m *AttesterSlashingAnnounce) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingAnnounce) ProtoMessage()    {}
 func (*AttesterSlashingAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{17}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{17}
 }
 func (m *AttesterSlashingAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +996,7 @@ func (m *AttesterSlashingRequest) Reset()         { *m = AttesterSlashingRequest
 func (m *AttesterSlashingRequest) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingRequest) ProtoMessage()    {}
 func (*AttesterSlashingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{18}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{18}
 }
 func (m *AttesterSlashingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1044,7 @@ func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingRespon
 func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingResponse) ProtoMessage()    {}
 func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{19}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{19}
 }
 func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1098,7 @@ func (m *DepositAnnounce) Reset()         { *m = DepositAnnounce{} }
 func (m *DepositAnnounce) String() string { return proto.CompactTextString(m) }
 func (*DepositAnnounce) ProtoMessage()    {}
 func (*DepositAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{20}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{20}
 }
 func (m *DepositAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1145,7 @@ func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
 func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositRequest) ProtoMessage()    {}
 func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{21}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{21}
 }
 func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,7 +1193,7 @@ func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
 func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
 func (*DepositResponse) ProtoMessage()    {}
 func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{22}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{22}
 }
 func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1247,7 @@ func (m *ExitAnnounce) Reset()         { *m = ExitAnnounce{} }
 func (m *ExitAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ExitAnnounce) ProtoMessage()    {}
 func (*ExitAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{23}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{23}
 }
 func (m *ExitAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1294,7 @@ func (m *ExitRequest) Reset()         { *m = ExitRequest{} }
 func (m *ExitRequest) String() string { return proto.CompactTextString(m) }
 func (*ExitRequest) ProtoMessage()    {}
 func (*ExitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{24}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{24}
 }
 func (m *ExitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1342,7 @@ func (m *ExitResponse) Reset()         { *m = ExitResponse{} }
 func (m *ExitResponse) String() string { return proto.CompactTextString(m) }
 func (*ExitResponse) ProtoMessage()    {}
 func (*ExitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_d2826d8b9392139d, []int{25}
+	return fileDescriptor_messages_e674355c3dd1f230, []int{25}
 }
 func (m *ExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5144,57 +5153,59 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_messages_d2826d8b9392139d)
-}
-
-var fileDescriptor_messages_d2826d8b9392139d = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x52, 0xda, 0x4e,
-	0x14, 0xc7, 0x7f, 0x11, 0x14, 0x3d, 0xa0, 0xbf, 0xb8, 0xb6, 0x8a, 0x8e, 0x22, 0xc6, 0x76, 0x4a,
-	0x3b, 0x23, 0x54, 0x7b, 0xe5, 0x65, 0x82, 0xe9, 0xe0, 0x9f, 0x06, 0x9b, 0x84, 0xe9, 0xf4, 0xa2,
-	0x93, 0x06, 0xd8, 0x31, 0x4c, 0x35, 0x49, 0xd9, 0xc5, 0xd1, 0x07, 0xe8, 0x33, 0xf4, 0x09, 0xfa,
-	0x2e, 0xbd, 0xec, 0x23, 0x74, 0x7c, 0x92, 0x4e, 0x92, 0x0d, 0x04, 0x12, 0x22, 0x9d, 0xe9, 0x1d,
-	0x39, 0xe7, 0x7b, 0xbe, 0xfb, 0xfd, 0xec, 0x9e, 0x0b, 0x40, 0x70, 0xfb, 0x0e, 0x75, 0x6a, 0x6d,
-	0x6c, 0x76, 0x1c, 0xbb, 0xe6, 0x1e, 0xb9, 0xb5, 0xdb, 0xc3, 0xda, 0x0d, 0x26, 0xc4, 0xbc, 0xc2,
-	0xa4, 0xea, 0x37, 0xd1, 0x3a, 0xa6, 0x16, 0xee, 0xe3, 0xc1, 0x4d, 0x35, 0x90, 0x55, 0xdd, 0x23,
-	0xb7, 0x7a, 0x7b, 0xb8, 0xb5, 0x9b, 0x34, 0x4b, 0xef, 0xdd, 0x70, 0x50, 0x38, 0x83, 0x35, 0xc9,
-	0x6f, 0x4a, 0xd7, 0x4e, 0xe7, 0x8b, 0x68, 0xdb, 0xce, 0xc0, 0xee, 0x60, 0x84, 0x20, 0x6b, 0x99,
-	0xc4, 0x2a, 0x72, 0x65, 0xae, 0x52, 0x50, 0xfd, 0xdf, 0x68, 0x17, 0xf2, 0xe4, 0xda, 0xa1, 0x86,
-	0x3d, 0xb8, 0x69, 0xe3, 0x7e, 0x71, 0xae, 0xcc, 0x55, 0xb2, 0x2a, 0x78, 0x25, 0xc5, 0xaf, 0x08,
-	0x15, 0x40, 0x11, 0x2f, 0x15, 0x7f, 0x1d, 0x60, 0x42, 0x93, 0xac, 0x04, 0x11, 0x4a, 0x71, 0xa5,
-	0x74, 0xaf, 0x0d, 0xbd, 0x26, 0x0f, 0xe3, 0x62, 0x87, 0x7d, 0xe7, 0xc6, 0x92, 0xab, 0x98, 0xb8,
-	0x8e, 0x4d, 0x30, 0x3a, 0x86, 0xf9, 0xb6, 0x57, 0xf0, 0x47, 0xf2, 0x47, 0xfb, 0xd5, 0xe4, 0x9b,
-	0xa9, 0x46, 0x67, 0x83, 0x09, 0x24, 0x43, 0xde, 0xa4, 0x14, 0x13, 0x6a, 0xd2, 0x9e, 0x63, 0xfb,
-	0x80, 0x29, 0x06, 0xe2, 0x48, 0xaa, 0x46, 0xe7, 0x84, 0x16, 0x6c, 0x4a, 0x26, 0xed, 0x58, 0xb8,
-	0x9b, 0x70, 0x1b, 0x3b, 0x00, 0x84, 0x9a, 0x7d, 0x6a, 0x78, 0x28, 0x0c, 0x6b, 0xc9, 0xaf, 0x78,
-	0xf0, 0x68, 0x13, 0x16, 0xb1, 0xdd, 0x0d, 0x9a, 0xc1, 0x05, 0xe7, 0xb0, 0xdd, 0xf5, 0x5a, 0x82,
-	0x05, 0x5b, 0x49, 0xb6, 0x0c, 0xfb, 0x0c, 0x56, 0xda, 0x41, 0xd7, 0xf0, 0x61, 0x48, 0x91, 0x2b,
-	0x67, 0x66, 0xe5, 0x5f, 0x66, 0xa3, 0xfe, 0x17, 0x11, 0x10, 0xf0, 0x75, 0xcb, 0xec, 0xd9, 0x0d,
-	0x6c, 0x76, 0x59, 0x6e, 0xe1, 0x16, 0x56, 0x23, 0x35, 0x76, 0x68, 0xd2, 0x96, 0x20, 0xc8, 0x46,
-	0xd2, 0xfb, 0xbf, 0x47, 0x6f, 0x92, 0xf9, 0xdb, 0x37, 0x11, 0x0e, 0x60, 0x23, 0xa8, 0x6a, 0xd4,
-	0xa4, 0xb8, 0x61, 0x12, 0x2b, 0x6d, 0x47, 0x47, 0x2b, 0xe8, 0xcb, 0xd3, 0x56, 0xf0, 0x53, 0xb8,
-	0x3e, 0x4c, 0xc9, 0x90, 0xde, 0x42, 0x21, 0xc8, 0x64, 0x78, 0xcf, 0x89, 0x67, 0xdb, 0xa2, 0xc0,
-	0x22, 0xdf, 0x1e, 0x7d, 0x08, 0x2f, 0x61, 0x2d, 0xb2, 0x20, 0x8f, 0x65, 0x8e, 0xee, 0x52, 0x4a,
-	0x66, 0x77, 0xcc, 0x34, 0xf5, 0x19, 0xfe, 0xd1, 0x2e, 0x57, 0xa1, 0x78, 0xd9, 0x77, 0x5c, 0x87,
-	0xe0, 0xbe, 0x76, 0x6d, 0x12, 0xab, 0x67, 0x5f, 0xa5, 0xb2, 0x1c, 0xc0, 0xc6, 0xa4, 0x3e, 0x0d,
-	0xe8, 0x1b, 0x17, 0xf7, 0x4f, 0xc5, 0x6a, 0xc1, 0xaa, 0xcb, 0xf4, 0x06, 0x61, 0x03, 0x0c, 0xae,
-	0x32, 0x0d, 0x2e, 0x76, 0x00, 0xef, 0x4e, 0x54, 0x3c, 0xcc, 0xe0, 0x0a, 0x66, 0xc7, 0x9c, 0xd4,
-	0x3f, 0x86, 0x19, 0xd7, 0xa7, 0x63, 0x86, 0xfa, 0x99, 0x31, 0x63, 0x07, 0xf0, 0x93, 0x15, 0xe1,
-	0x39, 0xfc, 0x7f, 0x82, 0x5d, 0x87, 0xf4, 0x68, 0x2a, 0xdd, 0x33, 0x58, 0x61, 0xb2, 0x34, 0xa8,
-	0xcf, 0x43, 0xb3, 0x54, 0x94, 0x63, 0xc8, 0x75, 0x03, 0x19, 0x03, 0xd8, 0x9d, 0x06, 0x10, 0xba,
-	0x85, 0x7a, 0x41, 0x80, 0x82, 0x7c, 0xf7, 0x48, 0xd6, 0x3d, 0xc8, 0x7b, 0x9a, 0xb4, 0xa0, 0x7a,
-	0x60, 0x93, 0x9a, 0xf2, 0x35, 0x64, 0xf1, 0xdd, 0x30, 0xe2, 0xf6, 0xb4, 0x88, 0xbe, 0x8f, 0xaf,
-	0x7c, 0xf5, 0x23, 0x03, 0xf3, 0xba, 0xe3, 0xf6, 0x3a, 0x28, 0x0f, 0xb9, 0x96, 0x72, 0xae, 0x34,
-	0x3f, 0x28, 0xfc, 0x7f, 0x68, 0x13, 0x9e, 0x4a, 0xb2, 0x58, 0x6f, 0x2a, 0x86, 0x74, 0xd1, 0xac,
-	0x9f, 0x1b, 0xa2, 0xa2, 0x34, 0x5b, 0x4a, 0x5d, 0xe6, 0x39, 0x54, 0x84, 0x27, 0x63, 0x2d, 0x55,
-	0x7e, 0xdf, 0x92, 0x35, 0x9d, 0x9f, 0x43, 0x2f, 0x60, 0x3f, 0xa9, 0x63, 0x48, 0x1f, 0x0d, 0xed,
-	0xa2, 0xa9, 0x1b, 0x4a, 0xeb, 0x9d, 0x24, 0xab, 0x7c, 0x26, 0xe6, 0xae, 0xca, 0xda, 0x65, 0x53,
-	0xd1, 0x64, 0x3e, 0x8b, 0xca, 0xb0, 0x2d, 0x89, 0x7a, 0xbd, 0x21, 0x9f, 0x18, 0x89, 0xa7, 0xcc,
-	0xa3, 0x3d, 0xd8, 0x99, 0xa2, 0x60, 0x26, 0x0b, 0x68, 0x1d, 0x50, 0xbd, 0x21, 0x9e, 0x2a, 0x46,
-	0x43, 0x16, 0x4f, 0x86, 0xa3, 0x39, 0xb4, 0x01, 0x6b, 0x63, 0x75, 0x36, 0xb0, 0x88, 0x4a, 0xb0,
-	0xc5, 0xbc, 0x34, 0x5d, 0xd4, 0x65, 0xa3, 0x21, 0x6a, 0x8d, 0x11, 0xf3, 0x52, 0x84, 0x39, 0xe8,
-	0x87, 0x96, 0x10, 0x41, 0x09, 0x3b, 0xcc, 0x34, 0xef, 0x0d, 0x89, 0xba, 0x2e, 0x7b, 0xf5, 0xd3,
-	0xa6, 0x32, 0xb2, 0x2b, 0x78, 0x39, 0xa2, 0x9d, 0xd0, 0x6d, 0x79, 0x72, 0x64, 0x68, 0xb6, 0x22,
-	0x15, 0x7e, 0x3e, 0x94, 0xb8, 0x5f, 0x0f, 0x25, 0xee, 0xf7, 0x43, 0x89, 0x6b, 0x2f, 0xf8, 0xff,
-	0x7a, 0xde, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x61, 0x48, 0x20, 0x54, 0x09, 0x00, 0x00,
+	proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_messages_e674355c3dd1f230)
+}
+
+var fileDescriptor_messages_e674355c3dd1f230 = []byte{
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x52, 0xda, 0x5c,
+	0x18, 0xfe, 0x22, 0x28, 0xfa, 0x82, 0x1a, 0x0f, 0xdf, 0xa7, 0xe8, 0x28, 0x62, 0xfc, 0x3a, 0xa5,
+	0x9d, 0x11, 0xaa, 0x5d, 0xb9, 0x4c, 0xf0, 0xb4, 0x41, 0x6d, 0x42, 0x93, 0x30, 0x6d, 0x17, 0x9d,
+	0x34, 0xc0, 0x19, 0xc3, 0x54, 0x93, 0x94, 0x13, 0x1c, 0xbd, 0x80, 0x5e, 0x43, 0x6f, 0xa9, 0xcb,
+	0x5e, 0x42, 0xc7, 0x9b, 0xe8, 0xb6, 0x93, 0x1f, 0x20, 0x40, 0x88, 0x74, 0xa6, 0x3b, 0xf2, 0x3e,
+	0x3f, 0xe7, 0x79, 0xce, 0x79, 0x17, 0x00, 0xe7, 0xf4, 0x6c, 0xd7, 0xae, 0xb6, 0x88, 0xd1, 0xb6,
+	0xad, 0xaa, 0x73, 0xe2, 0x54, 0x6f, 0x8f, 0xab, 0x37, 0x84, 0x52, 0xe3, 0x8a, 0xd0, 0x8a, 0x0f,
+	0xa2, 0x4d, 0xe2, 0x9a, 0xa4, 0x47, 0xfa, 0x37, 0x95, 0x80, 0x56, 0x71, 0x4e, 0x9c, 0xca, 0xed,
+	0xf1, 0xce, 0x7e, 0x9c, 0xd6, 0xbd, 0x77, 0x06, 0x42, 0xee, 0x1c, 0xf2, 0x82, 0x0f, 0x0a, 0xd7,
+	0x76, 0xfb, 0x33, 0x6f, 0x59, 0x76, 0xdf, 0x6a, 0x13, 0x84, 0x20, 0x6d, 0x1a, 0xd4, 0x2c, 0x30,
+	0x25, 0xa6, 0x9c, 0x53, 0xfc, 0xdf, 0x68, 0x1f, 0xb2, 0xf4, 0xda, 0x76, 0x75, 0xab, 0x7f, 0xd3,
+	0x22, 0xbd, 0xc2, 0x42, 0x89, 0x29, 0xa7, 0x15, 0xf0, 0x46, 0x92, 0x3f, 0xe1, 0xca, 0x80, 0x22,
+	0x5e, 0x0a, 0xf9, 0xd2, 0x27, 0xd4, 0x8d, 0xb3, 0xe2, 0x78, 0x28, 0x4e, 0x33, 0x85, 0x7b, 0x75,
+	0xe8, 0x35, 0x79, 0x18, 0x33, 0x75, 0xd8, 0x37, 0x66, 0x2c, 0xb9, 0x42, 0xa8, 0x63, 0x5b, 0x94,
+	0xa0, 0x53, 0x58, 0x6c, 0x79, 0x03, 0x5f, 0x92, 0x3d, 0x39, 0xac, 0xc4, 0xdf, 0x4c, 0x25, 0xaa,
+	0x0d, 0x14, 0x08, 0x43, 0xd6, 0x70, 0x5d, 0x42, 0x5d, 0xc3, 0xed, 0xda, 0x56, 0x61, 0x21, 0xd9,
+	0x80, 0x1f, 0x51, 0x95, 0xa8, 0x8e, 0x6b, 0xc2, 0xb6, 0x60, 0xb8, 0x6d, 0x93, 0x74, 0x62, 0x6e,
+	0x63, 0x0f, 0x80, 0xba, 0x46, 0xcf, 0xd5, 0xbd, 0x2a, 0x61, 0xad, 0x15, 0x7f, 0xe2, 0x95, 0x47,
+	0xdb, 0xb0, 0x4c, 0xac, 0x4e, 0x00, 0x06, 0x17, 0x9c, 0x21, 0x56, 0xc7, 0x83, 0x38, 0x13, 0x76,
+	0xe2, 0x6c, 0xc3, 0xda, 0xe7, 0xb0, 0xd6, 0x0a, 0x50, 0xdd, 0x2f, 0x43, 0x0b, 0x4c, 0x29, 0x35,
+	0x6f, 0xff, 0xd5, 0x50, 0xea, 0x7f, 0x51, 0x0e, 0x01, 0x5b, 0x33, 0x8d, 0xae, 0x25, 0x12, 0xa3,
+	0x13, 0xe6, 0xe6, 0x6e, 0x61, 0x23, 0x32, 0x0b, 0x0f, 0x8d, 0xdb, 0x12, 0x04, 0xe9, 0x48, 0x7a,
+	0xff, 0xf7, 0xe8, 0x4d, 0x52, 0x7f, 0xfa, 0x26, 0xdc, 0x11, 0x6c, 0x05, 0x53, 0xd5, 0x35, 0x5c,
+	0x22, 0x1a, 0xd4, 0x4c, 0xda, 0xd1, 0xd1, 0x0a, 0xfa, 0xf4, 0xa4, 0x15, 0xfc, 0x08, 0xf9, 0x31,
+	0x66, 0x58, 0xe9, 0x15, 0xe4, 0x82, 0x4c, 0xba, 0xf7, 0x9c, 0x64, 0xbe, 0x2d, 0x0a, 0x2c, 0xb2,
+	0xad, 0xd1, 0x07, 0xf7, 0x0c, 0xf2, 0x91, 0x05, 0x79, 0x2c, 0x73, 0x74, 0x97, 0x12, 0x32, 0x3b,
+	0x63, 0xa6, 0x89, 0xcf, 0xf0, 0x97, 0x76, 0xb9, 0x02, 0x85, 0x46, 0xcf, 0x76, 0x6c, 0x4a, 0x7a,
+	0xea, 0xb5, 0x41, 0xcd, 0xae, 0x75, 0x95, 0xd8, 0xe5, 0x08, 0xb6, 0x26, 0xf9, 0x49, 0x85, 0xbe,
+	0x32, 0xd3, 0xfe, 0x89, 0xb5, 0x9a, 0xb0, 0xe1, 0x84, 0x7c, 0x9d, 0x86, 0x82, 0xb0, 0x5c, 0x79,
+	0x56, 0xb9, 0xa9, 0x03, 0x58, 0x67, 0x62, 0xe2, 0xd5, 0x0c, 0xae, 0x60, 0xfe, 0x9a, 0x93, 0xfc,
+	0xc7, 0x6a, 0x4e, 0xf3, 0x93, 0x6b, 0x0e, 0xf8, 0x73, 0xd7, 0x9c, 0x3a, 0x80, 0x9d, 0x9c, 0x70,
+	0x4f, 0x60, 0xfd, 0x8c, 0x38, 0x36, 0xed, 0xba, 0x89, 0xed, 0xfe, 0x87, 0xb5, 0x90, 0x96, 0x54,
+	0xea, 0xd3, 0xd0, 0x2c, 0xb1, 0xca, 0x29, 0x64, 0x3a, 0x01, 0x2d, 0x2c, 0xb0, 0x3f, 0xab, 0xc0,
+	0xc0, 0x6d, 0xc0, 0xe7, 0x38, 0xc8, 0xe1, 0xbb, 0x47, 0xb2, 0x1e, 0x40, 0x16, 0xdf, 0x25, 0x07,
+	0xd5, 0x02, 0x9b, 0xc4, 0x94, 0x2f, 0x20, 0x4d, 0xee, 0x86, 0x11, 0x77, 0x67, 0x45, 0xf4, 0x7d,
+	0x7c, 0xe6, 0xf3, 0x5f, 0x29, 0x58, 0xd4, 0x6c, 0xa7, 0xdb, 0x46, 0x59, 0xc8, 0x34, 0xa5, 0x0b,
+	0x49, 0x7e, 0x27, 0xb1, 0xff, 0xa0, 0x6d, 0xf8, 0x4f, 0xc0, 0x7c, 0x4d, 0x96, 0x74, 0xe1, 0x52,
+	0xae, 0x5d, 0xe8, 0xbc, 0x24, 0xc9, 0x4d, 0xa9, 0x86, 0x59, 0x06, 0x15, 0xe0, 0xdf, 0x31, 0x48,
+	0xc1, 0x6f, 0x9b, 0x58, 0xd5, 0xd8, 0x05, 0xf4, 0x14, 0x0e, 0xe3, 0x10, 0x5d, 0xf8, 0xa0, 0xab,
+	0x97, 0xb2, 0xa6, 0x4b, 0xcd, 0x37, 0x02, 0x56, 0xd8, 0xd4, 0x94, 0xbb, 0x82, 0xd5, 0x86, 0x2c,
+	0xa9, 0x98, 0x4d, 0xa3, 0x12, 0xec, 0x0a, 0xbc, 0x56, 0x13, 0xf1, 0x99, 0x1e, 0x7b, 0xca, 0x22,
+	0x3a, 0x80, 0xbd, 0x19, 0x8c, 0xd0, 0x64, 0x09, 0x6d, 0x02, 0xaa, 0x89, 0x7c, 0x5d, 0xd2, 0x45,
+	0xcc, 0x9f, 0x0d, 0xa5, 0x19, 0xb4, 0x05, 0xf9, 0xb1, 0x79, 0x28, 0x58, 0x46, 0x45, 0xd8, 0x09,
+	0xbd, 0x54, 0x8d, 0xd7, 0xb0, 0x2e, 0xf2, 0xaa, 0x38, 0xea, 0xbc, 0x12, 0xe9, 0x1c, 0xe0, 0x03,
+	0x4b, 0x88, 0x54, 0x19, 0x20, 0xa1, 0x69, 0xd6, 0x13, 0xf1, 0x9a, 0x86, 0xbd, 0x79, 0x5d, 0x96,
+	0x46, 0x76, 0x39, 0x2f, 0x47, 0x14, 0x19, 0xb8, 0xad, 0x4e, 0x4a, 0x86, 0x66, 0x6b, 0x5e, 0xc2,
+	0x86, 0x22, 0x37, 0x64, 0x15, 0x2b, 0xba, 0x7a, 0xc9, 0xab, 0x62, 0x5d, 0x7a, 0x3d, 0xc2, 0xd7,
+	0x3d, 0x3c, 0x50, 0xc6, 0xe2, 0x2c, 0x62, 0x21, 0x87, 0xdf, 0xd7, 0xb5, 0xe1, 0x59, 0x1b, 0x42,
+	0xee, 0xfb, 0x43, 0x91, 0xf9, 0xf1, 0x50, 0x64, 0x7e, 0x3e, 0x14, 0x99, 0xd6, 0x92, 0xff, 0x3f,
+	0xea, 0xe5, 0xef, 0x01, 0x00, 0xbb, 0x9e, 0xe7, 0x47, 0xa6, 0x09, 0x00, 0x00,
 }
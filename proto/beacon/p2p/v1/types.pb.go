@@ -44,7 +44,7 @@ func (x Validator_StatusFlags) String() string {
 	return proto.EnumName(Validator_StatusFlags_name, int32(x))
 }
 func (Validator_StatusFlags) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{6, 0}
+	return fileDescriptor_types_02289a81e781812c, []int{6, 0}
 }
 
 type BeaconState struct {
@@ -82,7 +82,7 @@ func (m *BeaconState) Reset()         { *m = BeaconState{} }
 func (m *BeaconState) String() string { return proto.CompactTextString(m) }
 func (*BeaconState) ProtoMessage()    {}
 func (*BeaconState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{0}
+	return fileDescriptor_types_02289a81e781812c, []int{0}
 }
 func (m *BeaconState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +299,7 @@ func (m *Fork) Reset()         { *m = Fork{} }
 func (m *Fork) String() string { return proto.CompactTextString(m) }
 func (*Fork) ProtoMessage()    {}
 func (*Fork) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{1}
+	return fileDescriptor_types_02289a81e781812c, []int{1}
 }
 func (m *Fork) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +363,7 @@ func (m *PendingAttestationRecord) Reset()         { *m = PendingAttestationReco
 func (m *PendingAttestationRecord) String() string { return proto.CompactTextString(m) }
 func (*PendingAttestationRecord) ProtoMessage()    {}
 func (*PendingAttestationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{2}
+	return fileDescriptor_types_02289a81e781812c, []int{2}
 }
 func (m *PendingAttestationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +434,7 @@ func (m *Attestation) Reset()         { *m = Attestation{} }
 func (m *Attestation) String() string { return proto.CompactTextString(m) }
 func (*Attestation) ProtoMessage()    {}
 func (*Attestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{3}
+	return fileDescriptor_types_02289a81e781812c, []int{3}
 }
 func (m *Attestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +510,7 @@ func (m *AttestationData) Reset()         { *m = AttestationData{} }
 func (m *AttestationData) String() string { return proto.CompactTextString(m) }
 func (*AttestationData) ProtoMessage()    {}
 func (*AttestationData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{4}
+	return fileDescriptor_types_02289a81e781812c, []int{4}
 }
 func (m *AttestationData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +615,7 @@ func (m *AttestationDataAndCustodyBit) Reset()         { *m = AttestationDataAnd
 func (m *AttestationDataAndCustodyBit) String() string { return proto.CompactTextString(m) }
 func (*AttestationDataAndCustodyBit) ProtoMessage()    {}
 func (*AttestationDataAndCustodyBit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{5}
+	return fileDescriptor_types_02289a81e781812c, []int{5}
 }
 func (m *AttestationDataAndCustodyBit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +677,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{6}
+	return fileDescriptor_types_02289a81e781812c, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +782,7 @@ func (m *ShardReassignmentRecord) Reset()         { *m = ShardReassignmentRecord
 func (m *ShardReassignmentRecord) String() string { return proto.CompactTextString(m) }
 func (*ShardReassignmentRecord) ProtoMessage()    {}
 func (*ShardReassignmentRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{7}
+	return fileDescriptor_types_02289a81e781812c, []int{7}
 }
 func (m *ShardReassignmentRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +844,7 @@ func (m *CrosslinkRecord) Reset()         { *m = CrosslinkRecord{} }
 func (m *CrosslinkRecord) String() string { return proto.CompactTextString(m) }
 func (*CrosslinkRecord) ProtoMessage()    {}
 func (*CrosslinkRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{8}
+	return fileDescriptor_types_02289a81e781812c, []int{8}
 }
 func (m *CrosslinkRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +904,7 @@ func (m *BeaconBlock) Reset()         { *m = BeaconBlock{} }
 func (m *BeaconBlock) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlock) ProtoMessage()    {}
 func (*BeaconBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{9}
+	return fileDescriptor_types_02289a81e781812c, []int{9}
 }
 func (m *BeaconBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +997,7 @@ func (m *BeaconBlockBody) Reset()         { *m = BeaconBlockBody{} }
 func (m *BeaconBlockBody) String() string { return proto.CompactTextString(m) }
 func (*BeaconBlockBody) ProtoMessage()    {}
 func (*BeaconBlockBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{10}
+	return fileDescriptor_types_02289a81e781812c, []int{10}
 }
 func (m *BeaconBlockBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1076,7 @@ func (m *DepositInput) Reset()         { *m = DepositInput{} }
 func (m *DepositInput) String() string { return proto.CompactTextString(m) }
 func (*DepositInput) ProtoMessage()    {}
 func (*DepositInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{11}
+	return fileDescriptor_types_02289a81e781812c, []int{11}
 }
 func (m *DepositInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1153,7 @@ func (m *ProposalSignedData) Reset()         { *m = ProposalSignedData{} }
 func (m *ProposalSignedData) String() string { return proto.CompactTextString(m) }
 func (*ProposalSignedData) ProtoMessage()    {}
 func (*ProposalSignedData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{12}
+	return fileDescriptor_types_02289a81e781812c, []int{12}
 }
 func (m *ProposalSignedData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1217,7 @@ func (m *SlashableVote) Reset()         { *m = SlashableVote{} }
 func (m *SlashableVote) String() string { return proto.CompactTextString(m) }
 func (*SlashableVote) ProtoMessage()    {}
 func (*SlashableVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{13}
+	return fileDescriptor_types_02289a81e781812c, []int{13}
 }
 func (m *SlashableVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1287,7 @@ func (m *DepositData) Reset()         { *m = DepositData{} }
 func (m *DepositData) String() string { return proto.CompactTextString(m) }
 func (*DepositData) ProtoMessage()    {}
 func (*DepositData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{14}
+	return fileDescriptor_types_02289a81e781812c, []int{14}
 }
 func (m *DepositData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,7 +1352,7 @@ func (m *ProposerSlashing) Reset()         { *m = ProposerSlashing{} }
 func (m *ProposerSlashing) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashing) ProtoMessage()    {}
 func (*ProposerSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{15}
+	return fileDescriptor_types_02289a81e781812c, []int{15}
 }
 func (m *ProposerSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1428,7 +1428,7 @@ func (m *AttesterSlashing) Reset()         { *m = AttesterSlashing{} }
 func (m *AttesterSlashing) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashing) ProtoMessage()    {}
 func (*AttesterSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{16}
+	return fileDescriptor_types_02289a81e781812c, []int{16}
 }
 func (m *AttesterSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1484,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{17}
+	return fileDescriptor_types_02289a81e781812c, []int{17}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +1547,7 @@ func (m *Exit) Reset()         { *m = Exit{} }
 func (m *Exit) String() string { return proto.CompactTextString(m) }
 func (*Exit) ProtoMessage()    {}
 func (*Exit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{18}
+	return fileDescriptor_types_02289a81e781812c, []int{18}
 }
 func (m *Exit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,7 +1609,7 @@ func (m *Eth1Data) Reset()         { *m = Eth1Data{} }
 func (m *Eth1Data) String() string { return proto.CompactTextString(m) }
 func (*Eth1Data) ProtoMessage()    {}
 func (*Eth1Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{19}
+	return fileDescriptor_types_02289a81e781812c, []int{19}
 }
 func (m *Eth1Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1664,7 @@ func (m *Eth1DataVote) Reset()         { *m = Eth1DataVote{} }
 func (m *Eth1DataVote) String() string { return proto.CompactTextString(m) }
 func (*Eth1DataVote) ProtoMessage()    {}
 func (*Eth1DataVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_types_e2ce2f3c01ec1c03, []int{20}
+	return fileDescriptor_types_02289a81e781812c, []int{20}
 }
 func (m *Eth1DataVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1707,6 +1707,227 @@ func (m *Eth1DataVote) GetVoteCount() uint64 {
 	return 0
 }
 
+type Genesis struct {
+	State                *BeaconState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Block                *BeaconBlock `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Genesis) Reset()         { *m = Genesis{} }
+func (m *Genesis) String() string { return proto.CompactTextString(m) }
+func (*Genesis) ProtoMessage()    {}
+func (*Genesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_02289a81e781812c, []int{21}
+}
+func (m *Genesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Genesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Genesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Genesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Genesis.Merge(dst, src)
+}
+func (m *Genesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *Genesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Genesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Genesis proto.InternalMessageInfo
+
+func (m *Genesis) GetState() *BeaconState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *Genesis) GetBlock() *BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type ValidatorBalanceDeltas struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Source               int64    `protobuf:"varint,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target               int64    `protobuf:"varint,3,opt,name=target,proto3" json:"target,omitempty"`
+	Head                 int64    `protobuf:"varint,4,opt,name=head,proto3" json:"head,omitempty"`
+	InclusionDistance    int64    `protobuf:"varint,5,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	AttestationInclusion int64    `protobuf:"varint,6,opt,name=attestation_inclusion,json=attestationInclusion,proto3" json:"attestation_inclusion,omitempty"`
+	Inactivity           int64    `protobuf:"varint,7,opt,name=inactivity,proto3" json:"inactivity,omitempty"`
+	Crosslink            int64    `protobuf:"varint,8,opt,name=crosslink,proto3" json:"crosslink,omitempty"`
+	Penalties            int64    `protobuf:"varint,9,opt,name=penalties,proto3" json:"penalties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalanceDeltas) Reset()         { *m = ValidatorBalanceDeltas{} }
+func (m *ValidatorBalanceDeltas) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceDeltas) ProtoMessage()    {}
+func (*ValidatorBalanceDeltas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_02289a81e781812c, []int{22}
+}
+func (m *ValidatorBalanceDeltas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBalanceDeltas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalanceDeltas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ValidatorBalanceDeltas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceDeltas.Merge(dst, src)
+}
+func (m *ValidatorBalanceDeltas) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalanceDeltas) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceDeltas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalanceDeltas proto.InternalMessageInfo
+
+func (m *ValidatorBalanceDeltas) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetSource() int64 {
+	if m != nil {
+		return m.Source
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetTarget() int64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetHead() int64 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetInclusionDistance() int64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetAttestationInclusion() int64 {
+	if m != nil {
+		return m.AttestationInclusion
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetInactivity() int64 {
+	if m != nil {
+		return m.Inactivity
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetCrosslink() int64 {
+	if m != nil {
+		return m.Crosslink
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltas) GetPenalties() int64 {
+	if m != nil {
+		return m.Penalties
+	}
+	return 0
+}
+
+type EpochRewardReport struct {
+	Epoch                uint64                    `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorDeltas      []*ValidatorBalanceDeltas `protobuf:"bytes,2,rep,name=validator_deltas,json=validatorDeltas,proto3" json:"validator_deltas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *EpochRewardReport) Reset()         { *m = EpochRewardReport{} }
+func (m *EpochRewardReport) String() string { return proto.CompactTextString(m) }
+func (*EpochRewardReport) ProtoMessage()    {}
+func (*EpochRewardReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_types_02289a81e781812c, []int{23}
+}
+func (m *EpochRewardReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRewardReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRewardReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EpochRewardReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRewardReport.Merge(dst, src)
+}
+func (m *EpochRewardReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRewardReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRewardReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRewardReport proto.InternalMessageInfo
+
+func (m *EpochRewardReport) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRewardReport) GetValidatorDeltas() []*ValidatorBalanceDeltas {
+	if m != nil {
+		return m.ValidatorDeltas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BeaconState)(nil), "ethereum.beacon.p2p.v1.BeaconState")
 	proto.RegisterType((*Fork)(nil), "ethereum.beacon.p2p.v1.Fork")
@@ -1729,7 +1950,10 @@ func init() {
 	proto.RegisterType((*Exit)(nil), "ethereum.beacon.p2p.v1.Exit")
 	proto.RegisterType((*Eth1Data)(nil), "ethereum.beacon.p2p.v1.Eth1Data")
 	proto.RegisterType((*Eth1DataVote)(nil), "ethereum.beacon.p2p.v1.Eth1DataVote")
+	proto.RegisterType((*Genesis)(nil), "ethereum.beacon.p2p.v1.Genesis")
 	proto.RegisterEnum("ethereum.beacon.p2p.v1.Validator_StatusFlags", Validator_StatusFlags_name, Validator_StatusFlags_value)
+	proto.RegisterType((*ValidatorBalanceDeltas)(nil), "ethereum.beacon.p2p.v1.ValidatorBalanceDeltas")
+	proto.RegisterType((*EpochRewardReport)(nil), "ethereum.beacon.p2p.v1.EpochRewardReport")
 }
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -2990,6 +3214,151 @@ func (m *Eth1DataVote) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Genesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Genesis) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.State.Size()))
+		n21, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Block.Size()))
+		n22, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorBalanceDeltas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalanceDeltas) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorIndex))
+	}
+	if m.Source != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+	}
+	if m.Target != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Target))
+	}
+	if m.Head != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Head))
+	}
+	if m.InclusionDistance != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.InclusionDistance))
+	}
+	if m.AttestationInclusion != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.AttestationInclusion))
+	}
+	if m.Inactivity != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Inactivity))
+	}
+	if m.Crosslink != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Crosslink))
+	}
+	if m.Penalties != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Penalties))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EpochRewardReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRewardReport) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.ValidatorDeltas) > 0 {
+		for _, msg := range m.ValidatorDeltas {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3670,15 +4039,95 @@ func (m *Eth1DataVote) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Genesis) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return n
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalanceDeltas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovTypes(uint64(m.ValidatorIndex))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	if m.Target != 0 {
+		n += 1 + sovTypes(uint64(m.Target))
+	}
+	if m.Head != 0 {
+		n += 1 + sovTypes(uint64(m.Head))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovTypes(uint64(m.InclusionDistance))
+	}
+	if m.AttestationInclusion != 0 {
+		n += 1 + sovTypes(uint64(m.AttestationInclusion))
+	}
+	if m.Inactivity != 0 {
+		n += 1 + sovTypes(uint64(m.Inactivity))
+	}
+	if m.Crosslink != 0 {
+		n += 1 + sovTypes(uint64(m.Crosslink))
+	}
+	if m.Penalties != 0 {
+		n += 1 + sovTypes(uint64(m.Penalties))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochRewardReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if len(m.ValidatorDeltas) > 0 {
+		for _, e := range m.ValidatorDeltas {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
 }
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -7672,6 +8121,446 @@ func (m *Eth1DataVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Genesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &BeaconState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalanceDeltas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBalanceDeltas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBalanceDeltas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			m.Head = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Head |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationInclusion", wireType)
+			}
+			m.AttestationInclusion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationInclusion |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactivity", wireType)
+			}
+			m.Inactivity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inactivity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crosslink", wireType)
+			}
+			m.Crosslink = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Crosslink |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
+			}
+			m.Penalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Penalties |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRewardReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRewardReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRewardReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDeltas = append(m.ValidatorDeltas, &ValidatorBalanceDeltas{})
+			if err := m.ValidatorDeltas[len(m.ValidatorDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7778,135 +8667,147 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_types_e2ce2f3c01ec1c03)
-}
-
-var fileDescriptor_types_e2ce2f3c01ec1c03 = []byte{
-	// 2003 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x73, 0x1b, 0x49,
-	0x19, 0x67, 0x24, 0x39, 0xb6, 0x3f, 0xc9, 0x96, 0xdc, 0x4e, 0xac, 0xd9, 0xc4, 0x89, 0x1d, 0x65,
-	0xb7, 0xec, 0x04, 0xd6, 0x5e, 0x69, 0x8b, 0x67, 0x08, 0x60, 0x39, 0x5e, 0xd6, 0x90, 0x65, 0x5d,
-	0x63, 0x6f, 0xc2, 0x05, 0xa6, 0x5a, 0x33, 0x2d, 0x69, 0xe2, 0xd1, 0xf4, 0xd4, 0x74, 0xcb, 0x1b,
-	0x53, 0x9c, 0xb8, 0xf1, 0x28, 0x8a, 0x7f, 0x01, 0xfe, 0x06, 0x2e, 0x14, 0x2c, 0x9c, 0x28, 0x38,
-	0xf2, 0x3c, 0xc0, 0x89, 0xca, 0x89, 0x03, 0xef, 0x13, 0x17, 0xaa, 0xa8, 0x7e, 0xcc, 0x53, 0x92,
-	0xb3, 0xd9, 0x5c, 0xf6, 0xa4, 0xea, 0xef, 0xfb, 0xfd, 0xbe, 0xee, 0xfe, 0xfa, 0x7b, 0x8d, 0x60,
-	0x23, 0x8c, 0x28, 0xa7, 0xbb, 0x3d, 0x82, 0x1d, 0x1a, 0xec, 0x86, 0x9d, 0x70, 0xf7, 0xac, 0xbd,
-	0xcb, 0xcf, 0x43, 0xc2, 0x76, 0xa4, 0x06, 0xad, 0x11, 0x3e, 0x24, 0x11, 0x19, 0x8f, 0x76, 0x14,
-	0x66, 0x27, 0xec, 0x84, 0x3b, 0x67, 0xed, 0xab, 0xd7, 0x14, 0xd1, 0xa1, 0xa3, 0x11, 0x0d, 0x76,
-	0x47, 0x84, 0x31, 0x3c, 0x88, 0x49, 0xad, 0x6f, 0xd5, 0xa0, 0xda, 0x95, 0xf0, 0x63, 0x8e, 0x39,
-	0x41, 0x47, 0x80, 0xce, 0xb0, 0xef, 0xb9, 0x98, 0xd3, 0xc8, 0x8e, 0xc8, 0xc0, 0x63, 0x3c, 0x3a,
-	0x37, 0x8d, 0xcd, 0xf2, 0x76, 0xb5, 0x73, 0x73, 0x67, 0xfa, 0x0e, 0x3b, 0x0f, 0x63, 0x86, 0xb5,
-	0x92, 0x90, 0x2d, 0xcd, 0x45, 0x07, 0xb0, 0x31, 0x69, 0xd1, 0x1e, 0x87, 0x2e, 0xe6, 0xc4, 0x26,
-	0x21, 0x75, 0x86, 0x66, 0x69, 0xd3, 0xd8, 0xae, 0x58, 0xeb, 0x13, 0xdc, 0x77, 0x24, 0xe8, 0x40,
-	0x60, 0xd0, 0xab, 0xd9, 0x83, 0xf5, 0xb0, 0x8f, 0x03, 0x87, 0x30, 0xb3, 0xbc, 0x59, 0xde, 0xae,
-	0x64, 0x76, 0xed, 0x6a, 0x05, 0xfa, 0x1c, 0x5c, 0xf3, 0x31, 0x27, 0x8c, 0xdb, 0x11, 0x0e, 0x5c,
-	0x4c, 0xed, 0x91, 0xf7, 0x84, 0x30, 0x7b, 0x88, 0xd9, 0xf0, 0xf5, 0x0e, 0x33, 0xff, 0x36, 0xbf,
-	0x59, 0xde, 0xae, 0x59, 0xa6, 0xc2, 0x58, 0x12, 0xf2, 0x96, 0x40, 0xbc, 0xa9, 0x00, 0xe8, 0xb3,
-	0x70, 0x35, 0x8c, 0xc8, 0x99, 0x47, 0xc7, 0x4c, 0x1d, 0xd2, 0x66, 0x1c, 0x47, 0xdc, 0x66, 0x43,
-	0x1c, 0xb9, 0xe6, 0xdf, 0xe7, 0xe5, 0x89, 0x9b, 0x31, 0x44, 0x1e, 0xf1, 0x58, 0x00, 0x8e, 0x85,
-	0x1e, 0x7d, 0x06, 0x5e, 0x72, 0xc6, 0x51, 0x44, 0x02, 0x3e, 0x85, 0xfc, 0x0f, 0x45, 0x5e, 0xd3,
-	0x88, 0x22, 0xf7, 0x5e, 0x66, 0x67, 0x07, 0xfb, 0xce, 0xd8, 0xc7, 0xdc, 0xa3, 0x81, 0x76, 0xd5,
-	0x3f, 0x15, 0xd9, 0x8c, 0x21, 0xfb, 0x29, 0x42, 0xf9, 0xe9, 0x6e, 0xba, 0xf5, 0x24, 0xfb, 0x5f,
-	0xfa, 0xdc, 0x1a, 0x31, 0x41, 0x9e, 0x72, 0x6b, 0x42, 0x5c, 0xed, 0x35, 0xf3, 0xdf, 0x82, 0x5d,
-	0x2b, 0xde, 0x9a, 0x10, 0x57, 0x39, 0x6d, 0xca, 0xad, 0x33, 0xe4, 0xff, 0x28, 0x72, 0xfe, 0xd6,
-	0x29, 0xf7, 0xd3, 0x90, 0x5c, 0xc9, 0x7e, 0x3c, 0x66, 0xdc, 0xeb, 0x7b, 0xc4, 0xd5, 0xa7, 0xfe,
-	0x6d, 0x5d, 0x39, 0x2c, 0x06, 0x7c, 0x29, 0xd6, 0xab, 0x43, 0x6f, 0x43, 0xbd, 0xc8, 0xf8, 0x9d,
-	0x62, 0x2c, 0x3f, 0xce, 0x23, 0x3f, 0x01, 0x6b, 0x5a, 0xe2, 0x28, 0xaf, 0xf4, 0x3c, 0xde, 0xf7,
-	0x88, 0xef, 0x9a, 0xbf, 0x57, 0x84, 0x2b, 0x39, 0x75, 0x57, 0x6b, 0xc5, 0x0e, 0x7d, 0x2f, 0xc0,
-	0xbe, 0xf7, 0x8d, 0x64, 0x87, 0x3f, 0xe8, 0x1d, 0x12, 0xb9, 0xda, 0xe1, 0x1d, 0x58, 0xd1, 0x61,
-	0xe7, 0x44, 0x94, 0x31, 0xdf, 0x0b, 0x4e, 0x99, 0xf9, 0x93, 0xa6, 0x4c, 0x9f, 0xad, 0x59, 0xe9,
-	0xb3, 0x1f, 0x43, 0x2d, 0xe2, 0xd0, 0xc8, 0xb5, 0x1a, 0xca, 0x44, 0x22, 0x66, 0xc2, 0xb3, 0xda,
-	0x6c, 0xcf, 0xa7, 0xce, 0xa9, 0x1d, 0x51, 0xca, 0x93, 0x58, 0xfe, 0x69, 0x53, 0xc6, 0xf2, 0x9a,
-	0x42, 0x74, 0x05, 0xc0, 0xa2, 0x94, 0x67, 0x22, 0xb9, 0x87, 0xb9, 0x33, 0x24, 0xee, 0x34, 0xf2,
-	0xcf, 0x14, 0xb9, 0xa9, 0x21, 0x13, 0xec, 0xbb, 0xc9, 0xce, 0x21, 0x89, 0x3d, 0x90, 0x64, 0xdf,
-	0x7b, 0x4d, 0x99, 0x7e, 0x4d, 0x85, 0x38, 0x8a, 0x01, 0x49, 0x12, 0xf6, 0x60, 0x55, 0x93, 0x31,
-	0x17, 0x3f, 0xd2, 0xab, 0xcc, 0xfc, 0xb9, 0xf2, 0xc7, 0x6b, 0xb3, 0xfc, 0x71, 0x44, 0x02, 0xd7,
-	0x0b, 0x06, 0x7b, 0x29, 0x47, 0x3b, 0x06, 0x29, 0x6b, 0x19, 0x45, 0xd6, 0x35, 0x5e, 0xe0, 0x92,
-	0x27, 0xf9, 0xdb, 0xfd, 0x22, 0xe7, 0x9a, 0x43, 0x01, 0xc8, 0x5e, 0xee, 0xcb, 0xa0, 0x5d, 0x6d,
-	0x13, 0x3e, 0x6c, 0xdb, 0x2e, 0xe6, 0xd8, 0xfc, 0xe1, 0xc6, 0xa6, 0xb1, 0x5d, 0xed, 0x6c, 0xce,
-	0x3a, 0xdc, 0x01, 0x1f, 0xb6, 0xef, 0x63, 0x8e, 0xad, 0x65, 0x45, 0x8d, 0xd7, 0xe8, 0x2d, 0xa8,
-	0x27, 0x56, 0xec, 0x33, 0xca, 0x09, 0x33, 0x7f, 0xb4, 0x21, 0x2f, 0xfa, 0xf2, 0xb3, 0x6c, 0x3d,
-	0xa4, 0x9c, 0x58, 0x4b, 0x24, 0xb3, 0x62, 0xa8, 0x05, 0xb5, 0x01, 0x09, 0x08, 0xf3, 0x98, 0xcd,
-	0xbd, 0x11, 0x31, 0xbf, 0xbd, 0x25, 0x03, 0xae, 0xaa, 0x85, 0x27, 0xde, 0x88, 0xa0, 0x36, 0x54,
-	0xfa, 0x34, 0x3a, 0x35, 0xbf, 0xb3, 0x25, 0xcf, 0xbc, 0x3e, 0x6b, 0x9f, 0x37, 0x68, 0x74, 0x6a,
-	0x49, 0x28, 0x5a, 0x85, 0x0a, 0xf3, 0x29, 0x37, 0xbf, 0xab, 0xcc, 0xc9, 0x45, 0x2b, 0x84, 0x8a,
-	0x80, 0xa0, 0xdb, 0xd0, 0x48, 0x92, 0xf0, 0x8c, 0x44, 0xcc, 0xa3, 0x81, 0x69, 0x48, 0x5c, 0x3d,
-	0x96, 0x3f, 0x54, 0x62, 0xb4, 0x05, 0xf5, 0x38, 0xd7, 0x63, 0xa4, 0xaa, 0xe2, 0xcb, 0x5a, 0x1c,
-	0x03, 0x2f, 0xc3, 0x9c, 0xca, 0x98, 0xb2, 0x54, 0xab, 0x45, 0xeb, 0xcf, 0x06, 0x98, 0xb3, 0x9e,
-	0x19, 0xdd, 0x85, 0x8a, 0x7c, 0x0a, 0x43, 0xde, 0x6a, 0x66, 0xda, 0x64, 0x88, 0xf2, 0x41, 0x24,
-	0x09, 0xb5, 0xe1, 0x32, 0x1e, 0x0c, 0x22, 0x32, 0x28, 0x64, 0x78, 0x49, 0x96, 0x9f, 0xd5, 0x8c,
-	0x2e, 0x49, 0xef, 0xdb, 0xd0, 0x70, 0xc6, 0x8c, 0x53, 0xf7, 0x3c, 0x85, 0x97, 0x25, 0xbc, 0xae,
-	0xe5, 0x09, 0xf4, 0x16, 0x2c, 0x09, 0x8f, 0xd9, 0x5e, 0xe0, 0xf8, 0x63, 0x97, 0xb8, 0x66, 0x45,
-	0xde, 0xaa, 0x26, 0x84, 0x87, 0x5a, 0xd6, 0xfa, 0x93, 0x01, 0xd5, 0xcc, 0xe1, 0x3e, 0xcc, 0xf7,
-	0xd9, 0x85, 0xc4, 0x02, 0xb1, 0x99, 0x37, 0x08, 0x30, 0x1f, 0x47, 0x44, 0xde, 0xaa, 0x66, 0xa1,
-	0x44, 0x75, 0x1c, 0x6b, 0x5a, 0xef, 0x95, 0xa1, 0x5e, 0x38, 0x28, 0x42, 0x3a, 0xa6, 0x8c, 0x34,
-	0xa4, 0xc4, 0xb3, 0xab, 0x6e, 0xa7, 0xa2, 0x42, 0x2d, 0xd0, 0x27, 0xc1, 0x54, 0x77, 0x9e, 0x2c,
-	0x45, 0xfa, 0x84, 0x57, 0x94, 0xbe, 0x50, 0x87, 0xd0, 0x5d, 0xb8, 0xaa, 0x5a, 0x4a, 0x8f, 0x8e,
-	0x03, 0x17, 0x47, 0xe7, 0x39, 0xaa, 0x3a, 0x6e, 0x53, 0x22, 0xba, 0x1a, 0x90, 0x21, 0x7f, 0x1c,
-	0x9a, 0x72, 0xfb, 0x29, 0x9b, 0xce, 0x49, 0xe6, 0x65, 0xa9, 0x2e, 0xee, 0xf9, 0x79, 0x58, 0x2f,
-	0xd6, 0xf2, 0x1c, 0xf7, 0x92, 0xe4, 0xbe, 0x54, 0x28, 0xd6, 0x19, 0x03, 0x5b, 0x93, 0x8d, 0x69,
-	0x7e, 0x6a, 0x5f, 0xba, 0x07, 0xd7, 0x52, 0xe0, 0xe4, 0x21, 0x17, 0xe4, 0x46, 0x66, 0x02, 0x29,
-	0x1e, 0xf4, 0x0e, 0xa4, 0x06, 0x6d, 0xf9, 0x12, 0x7f, 0x95, 0xfb, 0x74, 0x4b, 0xa6, 0x61, 0x2d,
-	0x25, 0xaa, 0x63, 0x91, 0xea, 0xdf, 0x84, 0xf5, 0xc2, 0xf3, 0xed, 0x05, 0xee, 0x7e, 0x12, 0x15,
-	0x2f, 0x16, 0xab, 0x1b, 0x50, 0xcd, 0x04, 0x9e, 0x7c, 0xfa, 0x05, 0x0b, 0xd2, 0x98, 0x6b, 0xfd,
-	0xb7, 0x0c, 0x8b, 0xc9, 0xb0, 0x88, 0xd6, 0xe0, 0x52, 0x38, 0xee, 0x9d, 0x92, 0x73, 0xb9, 0x5b,
-	0xcd, 0xd2, 0x2b, 0xd4, 0x85, 0xeb, 0xef, 0x7a, 0x7c, 0xe8, 0x46, 0xf8, 0x5d, 0xec, 0xdb, 0x4e,
-	0x44, 0x5c, 0x12, 0x70, 0x0f, 0xfb, 0xf1, 0xf8, 0xa6, 0x63, 0xff, 0x5a, 0x0a, 0xda, 0x4f, 0x31,
-	0xda, 0x27, 0x9f, 0x02, 0x53, 0x0f, 0x7e, 0x62, 0xee, 0xf5, 0xf8, 0x48, 0x54, 0xaa, 0x5c, 0xa4,
-	0xad, 0x29, 0xfd, 0x7e, 0xa2, 0xd6, 0xcc, 0x5b, 0xb0, 0xa4, 0x99, 0x3e, 0x3e, 0x27, 0x11, 0x8b,
-	0x53, 0x5c, 0x09, 0x1f, 0x48, 0x99, 0x48, 0x31, 0xec, 0x70, 0xef, 0x2c, 0x3b, 0x5c, 0xcd, 0xa9,
-	0x4a, 0x99, 0xca, 0xd5, 0xe3, 0x5e, 0x07, 0x20, 0x4f, 0x3c, 0x3d, 0x12, 0xc9, 0xa0, 0xa9, 0x58,
-	0x8b, 0x42, 0xa2, 0xd4, 0xb7, 0xa1, 0x91, 0xb9, 0x6c, 0x36, 0x4a, 0xea, 0xa9, 0x5c, 0x41, 0xb7,
-	0xa0, 0x9e, 0x36, 0x61, 0x85, 0x5c, 0x50, 0xf1, 0x94, 0x88, 0x15, 0xf0, 0x08, 0x6a, 0xe2, 0x71,
-	0xc6, 0xcc, 0xee, 0xfb, 0x78, 0xc0, 0x4c, 0xd8, 0x34, 0xb6, 0x97, 0x3b, 0xaf, 0x3e, 0x73, 0x7c,
-	0xdf, 0x39, 0x96, 0xac, 0x37, 0x04, 0xc9, 0xaa, 0xb2, 0x74, 0xd1, 0xfa, 0x02, 0x54, 0x33, 0x3a,
-	0x54, 0x85, 0xf9, 0xc3, 0xaf, 0x1c, 0x9e, 0x1c, 0xee, 0x3d, 0x68, 0x7c, 0x04, 0x21, 0x58, 0x56,
-	0x8b, 0x93, 0x83, 0xfb, 0xf6, 0xc1, 0x57, 0x0f, 0x4f, 0x1a, 0x06, 0x6a, 0x40, 0xed, 0xd1, 0xe1,
-	0xc9, 0x9b, 0xf7, 0xad, 0xbd, 0x47, 0x7b, 0xdd, 0x07, 0x07, 0x8d, 0x52, 0xcb, 0x87, 0xa6, 0x9c,
-	0x6f, 0x2d, 0x82, 0x99, 0x28, 0x34, 0xc2, 0xe3, 0xba, 0xde, 0x6f, 0x41, 0x3d, 0x1d, 0xed, 0x65,
-	0x17, 0xd7, 0xa5, 0x64, 0x39, 0x11, 0xcb, 0xd6, 0x3d, 0xa3, 0xa8, 0xc4, 0xe5, 0xa7, 0x9c, 0xe9,
-	0x68, 0x5f, 0x87, 0x7a, 0x61, 0xaa, 0x4a, 0x1b, 0x91, 0x91, 0x69, 0x44, 0x17, 0xd5, 0x86, 0xd2,
-	0xec, 0xda, 0xd0, 0xfa, 0x55, 0x29, 0xfe, 0x6c, 0x92, 0x9a, 0xa9, 0x25, 0xf0, 0x63, 0x80, 0x42,
-	0x2c, 0x3b, 0xe4, 0xa4, 0xd5, 0x86, 0xd2, 0xe4, 0x92, 0x78, 0x45, 0x38, 0x9c, 0x4c, 0xa9, 0x89,
-	0x75, 0xa9, 0xc8, 0x60, 0x5f, 0x83, 0xcb, 0x3a, 0x44, 0x23, 0x72, 0x46, 0xb0, 0x9f, 0xaf, 0x83,
-	0x48, 0xe9, 0x2c, 0xa9, 0xd2, 0x8c, 0x7b, 0xb0, 0x98, 0x8e, 0x38, 0x73, 0xef, 0x73, 0xc2, 0x59,
-	0x88, 0x27, 0x12, 0xb4, 0x0e, 0x8b, 0x69, 0x73, 0xb8, 0x24, 0x67, 0xaa, 0x54, 0x20, 0x6a, 0x46,
-	0x8f, 0xba, 0xe7, 0x32, 0x6c, 0x2f, 0xa8, 0x19, 0x19, 0x7f, 0x75, 0xa9, 0x7b, 0x6e, 0x49, 0x52,
-	0xeb, 0x7f, 0x25, 0xa8, 0x17, 0x34, 0xe8, 0x8b, 0x50, 0xcb, 0x0d, 0x8c, 0xea, 0xf3, 0xf3, 0xd6,
-	0xfb, 0x28, 0x46, 0x56, 0x8e, 0x88, 0x1e, 0x01, 0x0a, 0x23, 0x1a, 0x52, 0x46, 0x22, 0x9b, 0xf9,
-	0x98, 0x0d, 0xbd, 0x60, 0xc0, 0xcc, 0x92, 0x34, 0xb7, 0x3d, 0x73, 0xfc, 0xd4, 0x8c, 0x63, 0x4d,
-	0xb0, 0x56, 0xc2, 0x82, 0x44, 0x1a, 0x56, 0x1b, 0xe5, 0x0c, 0x97, 0x2f, 0x36, 0xbc, 0xa7, 0x19,
-	0xa9, 0x61, 0x5c, 0x90, 0x88, 0x79, 0x7b, 0xc1, 0x25, 0x21, 0x65, 0x1e, 0x17, 0x85, 0x47, 0x98,
-	0xdb, 0x98, 0x65, 0xee, 0xbe, 0xc2, 0x59, 0x09, 0x01, 0x75, 0x60, 0x4e, 0x14, 0x16, 0x66, 0xce,
-	0x49, 0xe6, 0xcc, 0x79, 0xf0, 0xe0, 0x89, 0xc7, 0x2d, 0x05, 0x6d, 0xfd, 0xa0, 0x04, 0x35, 0x6d,
-	0xe9, 0x30, 0x08, 0xc7, 0x7c, 0x66, 0x55, 0xde, 0x81, 0xd5, 0x30, 0xa2, 0xb4, 0x6f, 0xd3, 0xbe,
-	0x1d, 0x52, 0xc6, 0x08, 0x4b, 0xa6, 0xbe, 0x9a, 0x74, 0x11, 0xed, 0xbf, 0xdd, 0x3f, 0x4a, 0x14,
-	0xcf, 0xae, 0xe2, 0xe5, 0x17, 0xab, 0xe2, 0x95, 0x0b, 0xab, 0xb8, 0xfc, 0x16, 0x55, 0xad, 0x68,
-	0x92, 0xaa, 0xba, 0x7e, 0x53, 0x03, 0x8a, 0xdc, 0xd6, 0x63, 0x40, 0x2a, 0x06, 0xb0, 0x2f, 0x06,
-	0x1f, 0xe2, 0x3e, 0xe7, 0x94, 0x73, 0x07, 0x56, 0x66, 0x8d, 0x37, 0xf5, 0x5e, 0xa1, 0x90, 0xfc,
-	0xd1, 0x80, 0x25, 0xf9, 0xfa, 0xb8, 0xe7, 0x13, 0x31, 0xf9, 0xa3, 0x8f, 0xc2, 0x4a, 0xae, 0x1a,
-	0x7a, 0xe2, 0x4b, 0xcb, 0x90, 0x1f, 0x5a, 0x8d, 0x6c, 0x3d, 0x14, 0xf2, 0xa9, 0xa3, 0x5e, 0x69,
-	0xfa, 0xa8, 0x17, 0x77, 0xf6, 0xf2, 0x07, 0xe9, 0xec, 0xcf, 0x3d, 0x27, 0x7e, 0xdf, 0x80, 0xaa,
-	0x0e, 0x2b, 0xe9, 0xbd, 0x43, 0x58, 0xd2, 0x61, 0x6a, 0x7b, 0x22, 0xcc, 0xf4, 0x80, 0xf1, 0xf2,
-	0x33, 0x82, 0x5b, 0x86, 0xa4, 0x55, 0x73, 0x0b, 0x01, 0x8a, 0x47, 0x74, 0x1c, 0x70, 0xed, 0x75,
-	0xbd, 0x12, 0x45, 0x4a, 0x7c, 0x29, 0x31, 0x8e, 0x47, 0xa1, 0x6e, 0x06, 0xa9, 0xa0, 0xf5, 0xcb,
-	0x12, 0x34, 0x8a, 0x99, 0x8d, 0x5e, 0x81, 0xe5, 0xa4, 0x3e, 0x64, 0x1b, 0xcf, 0x52, 0x2c, 0x55,
-	0x7d, 0xc7, 0x82, 0x7a, 0xa8, 0x03, 0x42, 0x7d, 0xde, 0xb5, 0xe5, 0xd6, 0xd5, 0xce, 0x9d, 0x8b,
-	0x6b, 0x48, 0x36, 0x7e, 0x62, 0x9b, 0xd8, 0x17, 0xab, 0xb6, 0xa8, 0xe1, 0x89, 0xcd, 0xc4, 0xa1,
-	0x76, 0x5b, 0xc7, 0x09, 0x0a, 0x33, 0x06, 0xa4, 0xaa, 0x3d, 0x79, 0x0a, 0x95, 0x03, 0x2f, 0x70,
-	0x8a, 0xce, 0x8c, 0x53, 0xc4, 0x19, 0x32, 0x79, 0x8a, 0x4e, 0xeb, 0xc7, 0x06, 0x34, 0x8a, 0x85,
-	0x0c, 0xbd, 0x0d, 0x0d, 0x16, 0x07, 0xb1, 0xfc, 0xf6, 0xb5, 0xdb, 0xfa, 0x81, 0x5f, 0x99, 0x75,
-	0xb6, 0x5c, 0xd0, 0x5b, 0xcb, 0x2c, 0xbb, 0x6c, 0x4f, 0x31, 0xd8, 0xd1, 0x2e, 0xff, 0x40, 0x06,
-	0x3b, 0xad, 0xef, 0x19, 0x30, 0xaf, 0x63, 0x0a, 0x75, 0xe0, 0xca, 0x88, 0x44, 0xa7, 0x3e, 0xb1,
-	0x7b, 0x11, 0x0e, 0x9c, 0x61, 0xf2, 0x77, 0x81, 0x21, 0x3b, 0xdb, 0xaa, 0x52, 0x76, 0xa5, 0x2e,
-	0xfe, 0xab, 0xe0, 0x0e, 0xac, 0x68, 0x0e, 0x8f, 0x08, 0xd1, 0xc1, 0xa2, 0xe2, 0xaf, 0xae, 0x14,
-	0x27, 0x11, 0x21, 0x2a, 0x5c, 0x6e, 0x42, 0x1c, 0xb0, 0x76, 0x92, 0x71, 0x35, 0xab, 0xea, 0xa6,
-	0xe9, 0xd0, 0xc2, 0x50, 0x11, 0x45, 0x78, 0x6a, 0x51, 0x99, 0x32, 0x0e, 0x95, 0xa6, 0x8e, 0x43,
-	0xb9, 0xae, 0xac, 0x36, 0x49, 0x05, 0xad, 0xaf, 0xc1, 0x42, 0xf2, 0xdf, 0xc4, 0x0e, 0xac, 0xc6,
-	0x27, 0xca, 0xd6, 0x24, 0x55, 0xe0, 0x57, 0xb4, 0x2a, 0x33, 0x60, 0xdc, 0x84, 0x9a, 0xaa, 0x60,
-	0xb9, 0xa1, 0xa5, 0x2a, 0x65, 0xba, 0x70, 0xf9, 0x50, 0xcb, 0xfe, 0x7d, 0x91, 0x9f, 0x30, 0x8c,
-	0xe7, 0x9e, 0x30, 0xae, 0x03, 0xc8, 0x67, 0x76, 0x32, 0x89, 0xbd, 0x28, 0x24, 0xfb, 0x42, 0xd0,
-	0xad, 0xfd, 0xfa, 0xe9, 0x0d, 0xe3, 0x37, 0x4f, 0x6f, 0x18, 0x7f, 0x79, 0x7a, 0xc3, 0xe8, 0x5d,
-	0x92, 0xff, 0x5d, 0xbf, 0xfe, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xbf, 0x68, 0xa9, 0x13,
-	0x17, 0x00, 0x00,
+	proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_types_02289a81e781812c)
+}
+
+var fileDescriptor_types_02289a81e781812c = []byte{
+	// 2205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0x67, 0x24, 0x39, 0xb6, 0x9f, 0x64, 0x4b, 0x6e, 0x27, 0xf6, 0xec, 0xe6, 0xc3, 0xce, 0x64,
+	0xb7, 0xec, 0x04, 0xd6, 0x5e, 0x29, 0xc5, 0xc7, 0x12, 0x02, 0x58, 0xb6, 0x77, 0x57, 0x90, 0x65,
+	0x53, 0x6d, 0x6f, 0x02, 0x07, 0x98, 0x6a, 0xcd, 0xb4, 0xa5, 0x89, 0x47, 0x33, 0x53, 0xd3, 0x2d,
+	0x27, 0xa6, 0xa8, 0xa2, 0x8a, 0xe2, 0xc2, 0x47, 0x51, 0xfc, 0x0b, 0xf0, 0x37, 0x70, 0xa1, 0x60,
+	0xe1, 0x44, 0xc1, 0x91, 0xcf, 0x03, 0x9c, 0xa8, 0x9c, 0x38, 0xf0, 0x7d, 0xe2, 0x42, 0x15, 0xd5,
+	0x1f, 0xf3, 0xa1, 0x91, 0x64, 0x27, 0x9b, 0x0b, 0x27, 0xbb, 0xdf, 0xfb, 0xfd, 0x5e, 0x77, 0xbf,
+	0x7e, 0xfd, 0xde, 0xeb, 0x11, 0xac, 0x45, 0x71, 0xc8, 0xc3, 0xed, 0x2e, 0x25, 0x4e, 0x18, 0x6c,
+	0x47, 0xad, 0x68, 0xfb, 0xa4, 0xb9, 0xcd, 0x4f, 0x23, 0xca, 0xb6, 0xa4, 0x06, 0xad, 0x50, 0xde,
+	0xa7, 0x31, 0x1d, 0x0e, 0xb6, 0x14, 0x66, 0x2b, 0x6a, 0x45, 0x5b, 0x27, 0xcd, 0x97, 0x2f, 0x2b,
+	0xa2, 0x13, 0x0e, 0x06, 0x61, 0xb0, 0x3d, 0xa0, 0x8c, 0x91, 0x5e, 0x42, 0xb2, 0xbe, 0x51, 0x83,
+	0x6a, 0x5b, 0xc2, 0x0f, 0x38, 0xe1, 0x14, 0xdd, 0x07, 0x74, 0x42, 0x7c, 0xcf, 0x25, 0x3c, 0x8c,
+	0xed, 0x98, 0xf6, 0x3c, 0xc6, 0xe3, 0x53, 0xd3, 0x58, 0x2f, 0x6f, 0x56, 0x5b, 0xd7, 0xb7, 0x26,
+	0xcf, 0xb0, 0xf5, 0x20, 0x61, 0xe0, 0xa5, 0x94, 0x8c, 0x35, 0x17, 0xed, 0xc3, 0xda, 0xb8, 0x45,
+	0x7b, 0x18, 0xb9, 0x84, 0x53, 0x9b, 0x46, 0xa1, 0xd3, 0x37, 0x4b, 0xeb, 0xc6, 0x66, 0x05, 0x5f,
+	0x19, 0xe3, 0xbe, 0x27, 0x41, 0xfb, 0x02, 0x83, 0x5e, 0xcb, 0x2f, 0xac, 0x4b, 0x7c, 0x12, 0x38,
+	0x94, 0x99, 0xe5, 0xf5, 0xf2, 0x66, 0x25, 0x37, 0x6b, 0x5b, 0x2b, 0xd0, 0xa7, 0xe1, 0xb2, 0x4f,
+	0x38, 0x65, 0xdc, 0x8e, 0x49, 0xe0, 0x92, 0xd0, 0x1e, 0x78, 0x4f, 0x28, 0xb3, 0xfb, 0x84, 0xf5,
+	0x6f, 0xb7, 0x98, 0xf9, 0xd7, 0xd9, 0xf5, 0xf2, 0x66, 0x0d, 0x9b, 0x0a, 0x83, 0x25, 0xe4, 0x1d,
+	0x81, 0x78, 0x5b, 0x01, 0xd0, 0xa7, 0xe0, 0xe5, 0x28, 0xa6, 0x27, 0x5e, 0x38, 0x64, 0x6a, 0x91,
+	0x36, 0xe3, 0x24, 0xe6, 0x36, 0xeb, 0x93, 0xd8, 0x35, 0xff, 0x36, 0x2b, 0x57, 0xbc, 0x9a, 0x40,
+	0xe4, 0x12, 0x0f, 0x04, 0xe0, 0x40, 0xe8, 0xd1, 0x27, 0xe1, 0x25, 0x67, 0x18, 0xc7, 0x34, 0xe0,
+	0x13, 0xc8, 0x7f, 0x57, 0xe4, 0x15, 0x8d, 0x28, 0x72, 0xef, 0xe6, 0x66, 0x76, 0x88, 0xef, 0x0c,
+	0x7d, 0xc2, 0xbd, 0x30, 0xd0, 0xae, 0xfa, 0x87, 0x22, 0x9b, 0x09, 0x64, 0x37, 0x43, 0x28, 0x3f,
+	0xdd, 0xc9, 0xa6, 0x1e, 0x67, 0xff, 0x53, 0xaf, 0x5b, 0x23, 0xc6, 0xc8, 0x13, 0x76, 0x4d, 0xa9,
+	0xab, 0xbd, 0x66, 0xfe, 0x4b, 0xb0, 0x6b, 0xc5, 0x5d, 0x53, 0xea, 0x2a, 0xa7, 0x4d, 0xd8, 0x75,
+	0x8e, 0xfc, 0x6f, 0x45, 0x1e, 0xdd, 0x75, 0xc6, 0x7d, 0x03, 0xd2, 0x2d, 0xd9, 0x8f, 0x86, 0x8c,
+	0x7b, 0x47, 0x1e, 0x75, 0xf5, 0xaa, 0x7f, 0x53, 0x57, 0x0e, 0x4b, 0x00, 0x9f, 0x4b, 0xf4, 0x6a,
+	0xd1, 0x9b, 0x50, 0x2f, 0x32, 0x7e, 0xab, 0x18, 0x8b, 0x8f, 0x46, 0x91, 0x1f, 0x83, 0x15, 0x2d,
+	0x71, 0x94, 0x57, 0xba, 0x1e, 0x3f, 0xf2, 0xa8, 0xef, 0x9a, 0xbf, 0x53, 0x84, 0x4b, 0x23, 0xea,
+	0xb6, 0xd6, 0x8a, 0x19, 0x8e, 0xbc, 0x80, 0xf8, 0xde, 0x57, 0xd3, 0x19, 0x7e, 0xaf, 0x67, 0x48,
+	0xe5, 0x6a, 0x86, 0xf7, 0x60, 0x49, 0x87, 0x9d, 0x13, 0x87, 0x8c, 0xf9, 0x5e, 0x70, 0xcc, 0xcc,
+	0x1f, 0xaf, 0xca, 0xeb, 0xb3, 0x31, 0xed, 0xfa, 0xec, 0x26, 0x50, 0x4c, 0x9d, 0x30, 0x76, 0x71,
+	0x43, 0x99, 0x48, 0xc5, 0x4c, 0x78, 0x56, 0x9b, 0xed, 0xfa, 0xa1, 0x73, 0x6c, 0xc7, 0x61, 0xc8,
+	0xd3, 0x58, 0xfe, 0xc9, 0xaa, 0x8c, 0xe5, 0x15, 0x85, 0x68, 0x0b, 0x00, 0x0e, 0x43, 0x9e, 0x8b,
+	0xe4, 0x2e, 0xe1, 0x4e, 0x9f, 0xba, 0x93, 0xc8, 0x3f, 0x55, 0xe4, 0x55, 0x0d, 0x19, 0x63, 0xdf,
+	0x49, 0x67, 0x8e, 0x68, 0xe2, 0x81, 0xf4, 0xf6, 0xbd, 0xbf, 0x2a, 0xaf, 0xdf, 0xaa, 0x42, 0xdc,
+	0x4f, 0x00, 0xe9, 0x25, 0xec, 0xc2, 0xb2, 0x26, 0x13, 0x2e, 0xfe, 0x48, 0xaf, 0x32, 0xf3, 0x67,
+	0xca, 0x1f, 0xaf, 0x4f, 0xf3, 0xc7, 0x7d, 0x1a, 0xb8, 0x5e, 0xd0, 0xdb, 0xc9, 0x38, 0xda, 0x31,
+	0x48, 0x59, 0xcb, 0x29, 0xf2, 0xae, 0xf1, 0x02, 0x97, 0x3e, 0x19, 0xdd, 0xdd, 0xcf, 0x47, 0x5c,
+	0xd3, 0x11, 0x80, 0xfc, 0xe6, 0x3e, 0x0f, 0xda, 0xd5, 0x36, 0xe5, 0xfd, 0xa6, 0xed, 0x12, 0x4e,
+	0xcc, 0x1f, 0xac, 0xad, 0x1b, 0x9b, 0xd5, 0xd6, 0xfa, 0xb4, 0xc5, 0xed, 0xf3, 0x7e, 0x73, 0x8f,
+	0x70, 0x82, 0x17, 0x15, 0x35, 0x19, 0xa3, 0x77, 0xa0, 0x9e, 0x5a, 0xb1, 0x4f, 0x42, 0x4e, 0x99,
+	0xf9, 0xc3, 0x35, 0xb9, 0xd1, 0x57, 0xce, 0xb3, 0xf5, 0x20, 0xe4, 0x14, 0x2f, 0xd0, 0xdc, 0x88,
+	0x21, 0x0b, 0x6a, 0x3d, 0x1a, 0x50, 0xe6, 0x31, 0x9b, 0x7b, 0x03, 0x6a, 0x7e, 0x6b, 0x43, 0x06,
+	0x5c, 0x55, 0x0b, 0x0f, 0xbd, 0x01, 0x45, 0x4d, 0xa8, 0x1c, 0x85, 0xf1, 0xb1, 0xf9, 0xed, 0x0d,
+	0xb9, 0xe6, 0x2b, 0xd3, 0xe6, 0x79, 0x33, 0x8c, 0x8f, 0xb1, 0x84, 0xa2, 0x65, 0xa8, 0x30, 0x3f,
+	0xe4, 0xe6, 0x77, 0x94, 0x39, 0x39, 0xb0, 0x22, 0xa8, 0x08, 0x08, 0xba, 0x09, 0x8d, 0xf4, 0x12,
+	0x9e, 0xd0, 0x98, 0x79, 0x61, 0x60, 0x1a, 0x12, 0x57, 0x4f, 0xe4, 0x0f, 0x94, 0x18, 0x6d, 0x40,
+	0x3d, 0xb9, 0xeb, 0x09, 0x52, 0x65, 0xf1, 0x45, 0x2d, 0x4e, 0x80, 0x17, 0x61, 0x46, 0xdd, 0x98,
+	0xb2, 0x54, 0xab, 0x81, 0xf5, 0x27, 0x03, 0xcc, 0x69, 0xc7, 0x8c, 0xee, 0x40, 0x45, 0x1e, 0x85,
+	0xb1, 0x6e, 0x9c, 0x75, 0x6d, 0x72, 0x44, 0x79, 0x20, 0x92, 0x84, 0x9a, 0x70, 0x91, 0xf4, 0x7a,
+	0x31, 0xed, 0x15, 0x6e, 0x78, 0x49, 0xa6, 0x9f, 0xe5, 0x9c, 0x2e, 0xbd, 0xde, 0x37, 0xa1, 0xe1,
+	0x0c, 0x19, 0x0f, 0xdd, 0xd3, 0x0c, 0x5e, 0x96, 0xf0, 0xba, 0x96, 0xa7, 0xd0, 0x1b, 0xb0, 0x20,
+	0x3c, 0x66, 0x7b, 0x81, 0xe3, 0x0f, 0x5d, 0xea, 0x9a, 0x15, 0xb9, 0xab, 0x9a, 0x10, 0x76, 0xb4,
+	0xcc, 0xfa, 0xa3, 0x01, 0xd5, 0xdc, 0xe2, 0xfe, 0x9f, 0xf7, 0xb3, 0x0d, 0xa9, 0x05, 0x6a, 0x33,
+	0xaf, 0x17, 0x10, 0x3e, 0x8c, 0xa9, 0xdc, 0x55, 0x0d, 0xa3, 0x54, 0x75, 0x90, 0x68, 0xac, 0xf7,
+	0xcb, 0x50, 0x2f, 0x2c, 0x14, 0x21, 0x1d, 0x53, 0x46, 0x16, 0x52, 0xe2, 0xd8, 0x55, 0xb5, 0x53,
+	0x51, 0xa1, 0x06, 0xe8, 0xe3, 0x60, 0xaa, 0x3d, 0x8f, 0xa7, 0x22, 0xbd, 0xc2, 0x4b, 0x4a, 0x5f,
+	0xc8, 0x43, 0xe8, 0x0e, 0xbc, 0xac, 0x4a, 0x4a, 0x37, 0x1c, 0x06, 0x2e, 0x89, 0x4f, 0x47, 0xa8,
+	0x6a, 0xb9, 0xab, 0x12, 0xd1, 0xd6, 0x80, 0x1c, 0xf9, 0xa3, 0xb0, 0x2a, 0xa7, 0x9f, 0x30, 0xe9,
+	0x8c, 0x64, 0x5e, 0x94, 0xea, 0xe2, 0x9c, 0x9f, 0x81, 0x2b, 0xc5, 0x5c, 0x3e, 0xc2, 0xbd, 0x20,
+	0xb9, 0x2f, 0x15, 0x92, 0x75, 0xce, 0xc0, 0xc6, 0x78, 0x61, 0x9a, 0x9d, 0x58, 0x97, 0xee, 0xc2,
+	0xe5, 0x0c, 0x38, 0xbe, 0xc8, 0x39, 0x39, 0x91, 0x99, 0x42, 0x8a, 0x0b, 0xbd, 0x05, 0x99, 0x41,
+	0x5b, 0x9e, 0xc4, 0x5f, 0xe4, 0x3c, 0xed, 0x92, 0x69, 0xe0, 0x85, 0x54, 0x75, 0x20, 0xae, 0xfa,
+	0xd7, 0xe0, 0x4a, 0xe1, 0xf8, 0x76, 0x02, 0x77, 0x37, 0x8d, 0x8a, 0x17, 0x8b, 0xd5, 0x35, 0xa8,
+	0xe6, 0x02, 0x4f, 0x1e, 0xfd, 0x1c, 0x86, 0x2c, 0xe6, 0xac, 0xff, 0x94, 0x61, 0x3e, 0x6d, 0x16,
+	0xd1, 0x0a, 0x5c, 0x88, 0x86, 0xdd, 0x63, 0x7a, 0x2a, 0x67, 0xab, 0x61, 0x3d, 0x42, 0x6d, 0xb8,
+	0xfa, 0xd8, 0xe3, 0x7d, 0x37, 0x26, 0x8f, 0x89, 0x6f, 0x3b, 0x31, 0x75, 0x69, 0xc0, 0x3d, 0xe2,
+	0x27, 0xed, 0x9b, 0x8e, 0xfd, 0xcb, 0x19, 0x68, 0x37, 0xc3, 0x68, 0x9f, 0x7c, 0x02, 0x4c, 0xdd,
+	0xf8, 0x89, 0xbe, 0xd7, 0xe3, 0x03, 0x91, 0xa9, 0x46, 0x22, 0x6d, 0x45, 0xe9, 0x77, 0x53, 0xb5,
+	0x66, 0xde, 0x80, 0x05, 0xcd, 0xf4, 0xc9, 0x29, 0x8d, 0x59, 0x72, 0xc5, 0x95, 0xf0, 0x9e, 0x94,
+	0x89, 0x2b, 0x46, 0x1c, 0xee, 0x9d, 0xe4, 0x9b, 0xab, 0x19, 0x95, 0x29, 0x33, 0xb9, 0x3a, 0xdc,
+	0xab, 0x00, 0xf4, 0x89, 0xa7, 0x5b, 0x22, 0x19, 0x34, 0x15, 0x3c, 0x2f, 0x24, 0x4a, 0x7d, 0x13,
+	0x1a, 0xb9, 0xcd, 0xe6, 0xa3, 0xa4, 0x9e, 0xc9, 0x15, 0x74, 0x03, 0xea, 0x59, 0x11, 0x56, 0xc8,
+	0x39, 0x15, 0x4f, 0xa9, 0x58, 0x01, 0xef, 0x43, 0x4d, 0x1c, 0xce, 0x90, 0xd9, 0x47, 0x3e, 0xe9,
+	0x31, 0x13, 0xd6, 0x8d, 0xcd, 0xc5, 0xd6, 0x6b, 0xe7, 0xb6, 0xef, 0x5b, 0x07, 0x92, 0xf5, 0xa6,
+	0x20, 0xe1, 0x2a, 0xcb, 0x06, 0xd6, 0x67, 0xa1, 0x9a, 0xd3, 0xa1, 0x2a, 0xcc, 0x76, 0xbe, 0xd0,
+	0x39, 0xec, 0xec, 0xdc, 0x6b, 0x7c, 0x08, 0x21, 0x58, 0x54, 0x83, 0xc3, 0xfd, 0x3d, 0x7b, 0xff,
+	0x8b, 0x9d, 0xc3, 0x86, 0x81, 0x1a, 0x50, 0x7b, 0xd8, 0x39, 0x7c, 0x7b, 0x0f, 0xef, 0x3c, 0xdc,
+	0x69, 0xdf, 0xdb, 0x6f, 0x94, 0x2c, 0x1f, 0x56, 0x65, 0x7f, 0x8b, 0x29, 0x61, 0x22, 0xd1, 0x08,
+	0x8f, 0xeb, 0x7c, 0xbf, 0x01, 0xf5, 0xac, 0xb5, 0x97, 0x55, 0x5c, 0xa7, 0x92, 0xc5, 0x54, 0x2c,
+	0x4b, 0xf7, 0x94, 0xa4, 0x92, 0xa4, 0x9f, 0x72, 0xae, 0xa2, 0x7d, 0x05, 0xea, 0x85, 0xae, 0x2a,
+	0x2b, 0x44, 0x46, 0xae, 0x10, 0x9d, 0x95, 0x1b, 0x4a, 0xd3, 0x73, 0x83, 0xf5, 0xcb, 0x52, 0xf2,
+	0x6c, 0x92, 0x9a, 0x89, 0x29, 0xf0, 0x23, 0x80, 0x22, 0x22, 0x2b, 0xe4, 0xb8, 0xd5, 0x86, 0xd2,
+	0x8c, 0x5c, 0xe2, 0x25, 0xe1, 0x70, 0x3a, 0x21, 0x27, 0xd6, 0xa5, 0x22, 0x87, 0x7d, 0x1d, 0x2e,
+	0xea, 0x10, 0x8d, 0xe9, 0x09, 0x25, 0xfe, 0x68, 0x1e, 0x44, 0x4a, 0x87, 0xa5, 0x4a, 0x33, 0xee,
+	0xc2, 0x7c, 0xd6, 0xe2, 0xcc, 0x3c, 0x63, 0x87, 0x33, 0x97, 0x74, 0x24, 0xe8, 0x0a, 0xcc, 0x67,
+	0xc5, 0xe1, 0x82, 0xec, 0xa9, 0x32, 0x81, 0xc8, 0x19, 0xdd, 0xd0, 0x3d, 0x35, 0x67, 0xcf, 0xce,
+	0x19, 0x39, 0x7f, 0xb5, 0x43, 0xf7, 0x14, 0x4b, 0x92, 0xf5, 0xdf, 0x12, 0xd4, 0x0b, 0x1a, 0xf4,
+	0x16, 0xd4, 0x46, 0x1a, 0x46, 0xf5, 0xfc, 0xbc, 0xf1, 0x0c, 0xc9, 0x08, 0x8f, 0x10, 0xd1, 0x43,
+	0x40, 0x51, 0x1c, 0x46, 0x21, 0xa3, 0xb1, 0xcd, 0x7c, 0xc2, 0xfa, 0x5e, 0xd0, 0x63, 0x66, 0x49,
+	0x9a, 0xdb, 0x9c, 0xda, 0x7e, 0x6a, 0xc6, 0x81, 0x26, 0xe0, 0xa5, 0xa8, 0x20, 0x91, 0x86, 0xd5,
+	0x44, 0x23, 0x86, 0xcb, 0x67, 0x1b, 0xde, 0xd1, 0x8c, 0xcc, 0x30, 0x29, 0x48, 0x44, 0xbf, 0x3d,
+	0xe7, 0xd2, 0x28, 0x64, 0x1e, 0x17, 0x89, 0x47, 0x98, 0x5b, 0x9b, 0x66, 0x6e, 0x4f, 0xe1, 0x70,
+	0x4a, 0x40, 0x2d, 0x98, 0x11, 0x89, 0x85, 0x99, 0x33, 0xeb, 0xe5, 0xb3, 0xfa, 0xc1, 0xfd, 0x27,
+	0x1e, 0xc7, 0x0a, 0x6a, 0x7d, 0xbf, 0x04, 0x35, 0x6d, 0xa9, 0x13, 0x44, 0x43, 0x3e, 0x35, 0x2b,
+	0x6f, 0xc1, 0x72, 0x14, 0x87, 0xe1, 0x91, 0x1d, 0x1e, 0xd9, 0x51, 0xc8, 0x18, 0x65, 0x69, 0xd7,
+	0x57, 0x93, 0x2e, 0x0a, 0x8f, 0xde, 0x3d, 0xba, 0x9f, 0x2a, 0xce, 0xcf, 0xe2, 0xe5, 0x17, 0xcb,
+	0xe2, 0x95, 0x33, 0xb3, 0xb8, 0x7c, 0x8b, 0xaa, 0x52, 0x34, 0x4e, 0x55, 0x55, 0x7f, 0x55, 0x03,
+	0x8a, 0x5c, 0xeb, 0x11, 0x20, 0x15, 0x03, 0xc4, 0x17, 0x8d, 0x0f, 0x75, 0x9f, 0xb3, 0xcb, 0xb9,
+	0x05, 0x4b, 0xd3, 0xda, 0x9b, 0x7a, 0xb7, 0x90, 0x48, 0xfe, 0x60, 0xc0, 0x82, 0x3c, 0x7d, 0xd2,
+	0xf5, 0xa9, 0xe8, 0xfc, 0xd1, 0x87, 0x61, 0x69, 0x24, 0x1b, 0x7a, 0x0e, 0x55, 0x37, 0xa0, 0x82,
+	0x1b, 0xf9, 0x7c, 0x28, 0xe4, 0x13, 0x5b, 0xbd, 0xd2, 0xe4, 0x56, 0x2f, 0xa9, 0xec, 0xe5, 0x0f,
+	0x52, 0xd9, 0x9f, 0xbb, 0x4f, 0xfc, 0x9e, 0x01, 0x55, 0x1d, 0x56, 0xd2, 0x7b, 0x1d, 0x58, 0xd0,
+	0x61, 0x6a, 0x7b, 0x22, 0xcc, 0x74, 0x83, 0xf1, 0xca, 0x39, 0xc1, 0x2d, 0x43, 0x12, 0xd7, 0xdc,
+	0x42, 0x80, 0x92, 0x41, 0x38, 0x0c, 0xb8, 0xf6, 0xba, 0x1e, 0x89, 0x24, 0x25, 0x5e, 0x4a, 0x8c,
+	0x93, 0x41, 0xa4, 0x8b, 0x41, 0x26, 0xb0, 0x7e, 0x51, 0x82, 0x46, 0xf1, 0x66, 0xa3, 0x57, 0x61,
+	0x31, 0xcd, 0x0f, 0xf9, 0xc2, 0xb3, 0x90, 0x48, 0x55, 0xdd, 0xc1, 0x50, 0x8f, 0x74, 0x40, 0xa8,
+	0xe7, 0x5d, 0x53, 0x4e, 0x5d, 0x6d, 0xdd, 0x3a, 0x3b, 0x87, 0xe4, 0xe3, 0x27, 0xb1, 0x49, 0x7c,
+	0x31, 0x6a, 0x8a, 0x1c, 0x9e, 0xda, 0x4c, 0x1d, 0x6a, 0x37, 0x75, 0x9c, 0xa0, 0x28, 0x67, 0x40,
+	0xaa, 0x9a, 0xe3, 0xab, 0x50, 0x77, 0xe0, 0x05, 0x56, 0xd1, 0x9a, 0xb2, 0x8a, 0xe4, 0x86, 0x8c,
+	0xaf, 0xa2, 0x65, 0xfd, 0xc8, 0x80, 0x46, 0x31, 0x91, 0xa1, 0x77, 0xa1, 0xc1, 0x92, 0x20, 0x96,
+	0x6f, 0x5f, 0xbb, 0xa9, 0x0f, 0xf8, 0xd5, 0x69, 0x6b, 0x1b, 0x09, 0x7a, 0xbc, 0xc8, 0xf2, 0xc3,
+	0xe6, 0x04, 0x83, 0x2d, 0xb3, 0xf4, 0xc1, 0x0d, 0xb6, 0xac, 0xef, 0x1a, 0x30, 0xab, 0x63, 0x0a,
+	0xb5, 0xe0, 0xd2, 0x80, 0xc6, 0xc7, 0x3e, 0xb5, 0xbb, 0x31, 0x09, 0x9c, 0x7e, 0xfa, 0xb9, 0xc0,
+	0x90, 0x95, 0x6d, 0x59, 0x29, 0xdb, 0x52, 0x97, 0x7c, 0x2a, 0xb8, 0x05, 0x4b, 0x9a, 0xc3, 0x63,
+	0x4a, 0x75, 0xb0, 0xa8, 0xf8, 0xab, 0x2b, 0xc5, 0x61, 0x4c, 0xa9, 0x0a, 0x97, 0xeb, 0x90, 0x04,
+	0xac, 0x9d, 0xde, 0xb8, 0x1a, 0xae, 0xba, 0xd9, 0x75, 0xb0, 0x08, 0x54, 0x44, 0x12, 0x9e, 0x98,
+	0x54, 0x26, 0xb4, 0x43, 0xa5, 0x89, 0xed, 0xd0, 0x48, 0x55, 0x56, 0x93, 0x64, 0x02, 0xeb, 0xcb,
+	0x30, 0x97, 0x7e, 0x9b, 0xd8, 0x82, 0xe5, 0x64, 0x45, 0xf9, 0x9c, 0xa4, 0x12, 0xfc, 0x92, 0x56,
+	0xe5, 0x1a, 0x8c, 0xeb, 0x50, 0x53, 0x19, 0x6c, 0xa4, 0x69, 0xa9, 0x4a, 0x99, 0x4e, 0x5c, 0x3e,
+	0xd4, 0xf2, 0x9f, 0x2f, 0x46, 0x3b, 0x0c, 0xe3, 0xb9, 0x3b, 0x8c, 0xab, 0x00, 0xf2, 0x98, 0x9d,
+	0xdc, 0xc5, 0x9e, 0x17, 0x92, 0x5d, 0x21, 0xb0, 0xbe, 0x0e, 0xb3, 0x6f, 0xa9, 0x0f, 0x1f, 0xe8,
+	0x0d, 0x98, 0x91, 0xfd, 0x90, 0x9e, 0xe4, 0xc6, 0xd9, 0xed, 0x86, 0xfc, 0xaa, 0x8d, 0x15, 0x43,
+	0x50, 0xe5, 0x16, 0xcc, 0xd2, 0xb3, 0x50, 0x55, 0xcf, 0xa7, 0x18, 0x22, 0x7d, 0xac, 0x3c, 0x28,
+	0x7c, 0x65, 0xde, 0xa3, 0x3e, 0x27, 0xec, 0xd9, 0xdb, 0xd7, 0x15, 0xb8, 0xc0, 0xc2, 0x61, 0xec,
+	0x50, 0x39, 0x7f, 0x19, 0xeb, 0x91, 0x90, 0x73, 0x12, 0xf7, 0xa8, 0x6a, 0x61, 0xcb, 0x58, 0x8f,
+	0x44, 0x70, 0xf4, 0x29, 0x51, 0xdf, 0x18, 0xca, 0x58, 0xfe, 0x2f, 0x3e, 0x83, 0xcb, 0x6f, 0x0f,
+	0xa2, 0xc4, 0xda, 0xae, 0xc7, 0xb8, 0x58, 0x88, 0xbc, 0xae, 0x65, 0xbc, 0x94, 0x6a, 0xf6, 0xb4,
+	0x02, 0xdd, 0x86, 0x4b, 0xb9, 0x86, 0xc8, 0x4e, 0x01, 0xf2, 0x1d, 0x52, 0xc6, 0x17, 0x73, 0xca,
+	0x4e, 0xa2, 0x43, 0xd7, 0x00, 0xbc, 0x40, 0x3e, 0x63, 0x3c, 0xae, 0xba, 0xba, 0x32, 0xce, 0x49,
+	0x44, 0xdc, 0xa5, 0x2f, 0x62, 0xf9, 0x02, 0x29, 0xe3, 0x4c, 0x20, 0xb4, 0xf2, 0x39, 0xc2, 0x3d,
+	0xca, 0xcc, 0x79, 0xa5, 0x4d, 0x05, 0xd6, 0x37, 0x0d, 0x58, 0x92, 0x8f, 0x14, 0x4c, 0x1f, 0xcb,
+	0xd7, 0x40, 0x14, 0xc6, 0x7c, 0x4a, 0x6f, 0xfe, 0x25, 0xc8, 0x0a, 0x9e, 0xed, 0x4a, 0x67, 0xeb,
+	0xde, 0x6d, 0xeb, 0xdc, 0xa7, 0xcc, 0xc8, 0x11, 0xe1, 0xec, 0x80, 0x94, 0xa0, 0x5d, 0xfb, 0xd5,
+	0xd3, 0x6b, 0xc6, 0xaf, 0x9f, 0x5e, 0x33, 0xfe, 0xfc, 0xf4, 0x9a, 0xd1, 0xbd, 0x20, 0x7f, 0x0b,
+	0xb9, 0xfd, 0xbf, 0x01, 0x00, 0x32, 0x2e, 0xad, 0xbd, 0x63, 0x19, 0x00, 0x00,
 }
@@ -6,7 +6,7 @@
 mocks=("./validator/internal/attester_service_mock.go AttesterServiceClient"
        "./validator/internal/beacon_service_mock.go BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient"
        "./validator/internal/proposer_service_mock.go ProposerServiceClient"
-       "./validator/internal/validator_service_mock.go ValidatorServiceClient")
+       "./validator/internal/validator_service_mock.go ValidatorServiceClient,ValidatorService_ValidatorStatusClient")
 
 for ((i = 0; i < ${#mocks[@]}; i++)); do
     file=${mocks[i]% *};
@@ -66,6 +66,30 @@ func FillBitfield(count int) []byte {
 	return bitfield
 }
 
+// BitfieldsOverlap reports whether any bit is set in both bitfields.
+func BitfieldsOverlap(a []byte, b []byte) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeBitfields returns a bitfield with the bits set in either bitfield,
+// as long as the longest of the two.
+func MergeBitfields(a []byte, b []byte) []byte {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	merged := make([]byte, len(a))
+	copy(merged, a)
+	for i := range b {
+		merged[i] |= b[i]
+	}
+	return merged
+}
+
 func fillNBits(numBits uint64) byte {
 	result := byte(0)
 	for i := uint64(0); i < numBits; i++ {
@@ -85,3 +85,44 @@ func TestBitSet(t *testing.T) {
 		}
 	}
 }
+
+func TestBitfieldsOverlap(t *testing.T) {
+	tests := []struct {
+		a       []byte
+		b       []byte
+		overlap bool
+	}{
+		{a: []byte{200}, b: []byte{49}, overlap: false},     //11001000, 00110001
+		{a: []byte{200}, b: []byte{8}, overlap: true},       //11001000, 00001000
+		{a: []byte{0, 1}, b: []byte{255}, overlap: false},   //00000000 00000001, 11111111
+		{a: []byte{0, 1}, b: []byte{0, 255}, overlap: true}, //00000000 00000001, 00000000 11111111
+		{a: []byte{}, b: []byte{255}, overlap: false},
+	}
+	for _, tt := range tests {
+		if BitfieldsOverlap(tt.a, tt.b) != tt.overlap {
+			t.Errorf("BitfieldsOverlap(%08b, %08b) = %v, want = %v", tt.a, tt.b, !tt.overlap, tt.overlap)
+		}
+	}
+}
+
+func TestMergeBitfields(t *testing.T) {
+	tests := []struct {
+		a      []byte
+		b      []byte
+		merged []byte
+	}{
+		{a: []byte{200}, b: []byte{49}, merged: []byte{249}},
+		{a: []byte{200}, b: []byte{8}, merged: []byte{200}},
+		{a: []byte{1}, b: []byte{2, 4}, merged: []byte{3, 4}},
+		{a: []byte{}, b: []byte{}, merged: []byte{}},
+	}
+	for _, tt := range tests {
+		a := append([]byte{}, tt.a...)
+		if merged := MergeBitfields(tt.a, tt.b); !bytes.Equal(merged, tt.merged) {
+			t.Errorf("MergeBitfields(%08b, %08b) = %08b, want = %08b", tt.a, tt.b, merged, tt.merged)
+		}
+		if !bytes.Equal(a, tt.a) {
+			t.Errorf("MergeBitfields modified its input %08b", a)
+		}
+	}
+}
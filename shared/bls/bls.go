@@ -8,8 +8,13 @@ import (
 	"math/big"
 )
 
+// signatureLength is the length of a compressed signature in the BLS signature scheme.
+const signatureLength = 96
+
 // Signature used in the BLS signature scheme.
-type Signature struct{}
+type Signature struct {
+	buf []byte
+}
 
 // SecretKey used in the BLS scheme.
 type SecretKey struct {
@@ -48,6 +53,17 @@ func (p *PublicKey) UnBufferPublicKey(bufferedKey []byte) {
 
 }
 
+// BufferedSignature returns the signature in a byte format.
+func (s *Signature) BufferedSignature() []byte {
+	return s.buf
+}
+
+// UnBufferSignature takes the byte representation of a signature
+// and sets it to the underlying signature object.
+func (s *Signature) UnBufferSignature(bufferedSig []byte) {
+	s.buf = bufferedSig
+}
+
 // GenerateKey generates a new secret key using a seed.
 func GenerateKey(seed []byte) *SecretKey {
 	return &SecretKey{
@@ -55,30 +71,31 @@ func GenerateKey(seed []byte) *SecretKey {
 	}
 }
 
-// Sign a message using a secret key - in a beacon/validator client,
-// this key will come from and be unlocked from the account keystore.
-func Sign(sec *SecretKey, msg []byte) (*Signature, error) {
-	return &Signature{}, nil
+// Sign a message in the given signature domain using a secret key - in a
+// beacon/validator client, this key will come from and be unlocked from the
+// account keystore. A signature only verifies in the domain it was made in.
+func Sign(sec *SecretKey, msg []byte, domain uint64) (*Signature, error) {
+	return &Signature{buf: make([]byte, signatureLength)}, nil
 }
 
-// VerifySig against a public key.
-func VerifySig(pub *PublicKey, msg []byte, sig *Signature) (bool, error) {
+// VerifySig against a public key, for a message signed in the given domain.
+func VerifySig(pub *PublicKey, msg []byte, sig *Signature, domain uint64) (bool, error) {
 	return true, nil
 }
 
 // VerifyAggregateSig created using the underlying BLS signature
-// aggregation scheme.
-func VerifyAggregateSig(pubs []*PublicKey, msg []byte, asig *Signature) (bool, error) {
+// aggregation scheme, for a message signed in the given domain.
+func VerifyAggregateSig(pubs []*PublicKey, msg []byte, asig *Signature, domain uint64) (bool, error) {
 	return true, nil
 }
 
 // BatchVerify a list of individual signatures by aggregating them.
-func BatchVerify(pubs []*PublicKey, msg []byte, sigs []*Signature) (bool, error) {
+func BatchVerify(pubs []*PublicKey, msg []byte, sigs []*Signature, domain uint64) (bool, error) {
 	asigs, err := AggregateSigs(sigs)
 	if err != nil {
 		return false, fmt.Errorf("could not aggregate signatures: %v", err)
 	}
-	return VerifyAggregateSig(pubs, msg, asigs)
+	return VerifyAggregateSig(pubs, msg, asigs, domain)
 }
 
 // AggregateSigs puts multiple signatures into one using the underlying
@@ -7,8 +7,12 @@ import (
 func TestSign(t *testing.T) {
 	sk := &SecretKey{}
 	msg := []byte{}
-	if _, err := Sign(sk, msg); err != nil {
-		t.Errorf("Expected nil error, received %v", err)
+	sig, err := Sign(sk, msg, 0)
+	if err != nil {
+		t.Fatalf("Expected nil error, received %v", err)
+	}
+	if len(sig.BufferedSignature()) != signatureLength {
+		t.Errorf("Expected a %d byte signature, received %d bytes", signatureLength, len(sig.BufferedSignature()))
 	}
 }
 
@@ -23,7 +27,7 @@ func TestVerifySig(t *testing.T) {
 	pk := &PublicKey{}
 	msg := []byte{}
 	sig := &Signature{}
-	if _, err := VerifySig(pk, msg, sig); err != nil {
+	if _, err := VerifySig(pk, msg, sig, 0); err != nil {
 		t.Errorf("Expected nil error, received %v", err)
 	}
 }
@@ -32,7 +36,7 @@ func TestVerifyAggregateSig(t *testing.T) {
 	pk := &PublicKey{}
 	msg := []byte{}
 	asig := &Signature{}
-	if _, err := VerifyAggregateSig([]*PublicKey{pk}, msg, asig); err != nil {
+	if _, err := VerifyAggregateSig([]*PublicKey{pk}, msg, asig, 0); err != nil {
 		t.Errorf("Expected nil error, received %v", err)
 	}
 }
@@ -41,7 +45,7 @@ func TestBatchVerify(t *testing.T) {
 	pk := &PublicKey{}
 	msg := []byte{}
 	sig := &Signature{}
-	if _, err := BatchVerify([]*PublicKey{pk}, msg, []*Signature{sig}); err != nil {
+	if _, err := BatchVerify([]*PublicKey{pk}, msg, []*Signature{sig}, 0); err != nil {
 		t.Errorf("Expected nil error, received %v", err)
 	}
 }
@@ -60,6 +60,12 @@ var (
 		Usage: "Indicate what fraction of p2p messages are sampled for tracing.",
 		Value: 0.20,
 	}
+	// ChainConfigFileFlag defines the path to a YAML or JSON file overriding the
+	// beacon chain and deposit contract configs.
+	ChainConfigFileFlag = cli.StringFlag{
+		Name:  "chain-config-file",
+		Usage: "Path to a YAML or JSON file with the chain configuration to use instead of the defaults",
+	}
 	// DisableMonitoringFlag defines a flag to disable the metrics collection.
 	DisableMonitoringFlag = cli.BoolFlag{
 		Name:  "disable-monitoring",
@@ -0,0 +1,300 @@
+package debug
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// apiPrefix is the path the debug API is served under on the pprof server.
+const apiPrefix = "/debug/api/"
+
+// bearerPrefix precedes the API token in the Authorization header of requests.
+const bearerPrefix = "Bearer "
+
+// profileDirName is the directory of the data directory the profiles are written to.
+const profileDirName = "profiles"
+
+var (
+	// DebugAPIFlag to serve the debug API on the pprof HTTP server.
+	DebugAPIFlag = cli.BoolFlag{
+		Name:  "debugapi",
+		Usage: "Serve the runtime debug API on the pprof HTTP server, writing profiles to <datadir>/profiles, requires --pprof",
+	}
+	// DebugAPITokenFileFlag to specify the file holding the token of the debug API.
+	DebugAPITokenFileFlag = cli.StringFlag{
+		Name:  "debugapitokenfile",
+		Usage: "File holding the bearer token of the debug API, generated if missing (default: <datadir>/debugapi.token)",
+	}
+)
+
+// apiHandler serves the methods of a debug handler over HTTP, so a running node can
+// be profiled without restarting it. Every request must carry the API token as a
+// bearer token. Profiles are written to files in the profile directory of the node,
+// which requests name relative to it.
+type apiHandler struct {
+	token      []byte
+	profileDir string
+	mux        *http.ServeMux
+	methods    map[string]map[string]apiMethod // map of paths to the methods served for each HTTP method.
+}
+
+// apiMethod is a method of the debug API, which returns a result to encode as JSON.
+type apiMethod func(*http.Request) (interface{}, error)
+
+func newAPIHandler(token string, profileDir string, h *HandlerT) *apiHandler {
+	a := &apiHandler{
+		token:      []byte(token),
+		profileDir: profileDir,
+		mux:        http.NewServeMux(),
+		methods:    make(map[string]map[string]apiMethod),
+	}
+	a.get("memstats", func(*http.Request) (interface{}, error) {
+		return h.MemStats(), nil
+	})
+	a.get("gcstats", func(*http.Request) (interface{}, error) {
+		return h.GcStats(), nil
+	})
+	a.get("verbosity", func(*http.Request) (interface{}, error) {
+		return log.GetLevel().String(), nil
+	})
+	a.mux.HandleFunc(apiPrefix+"stacks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if _, err := w.Write([]byte(h.Stacks())); err != nil {
+			log.Errorf("Could not write goroutine stacks: %v", err)
+		}
+	})
+	a.post("verbosity", func(r *http.Request) (interface{}, error) {
+		level, err := log.ParseLevel(r.FormValue("level"))
+		if err != nil {
+			return nil, err
+		}
+		previous := log.GetLevel()
+		log.SetLevel(level)
+		log.WithField("previous", previous).Infof("Changed log verbosity to %s", level)
+		return previous.String(), nil
+	})
+	a.post("gcpercent", func(r *http.Request) (interface{}, error) {
+		percent, err := intParam(r, "percent")
+		if err != nil {
+			return nil, err
+		}
+		return h.SetGCPercent(percent), nil
+	})
+	a.post("freeosmemory", func(*http.Request) (interface{}, error) {
+		h.FreeOSMemory()
+		return nil, nil
+	})
+	a.post("cpuprofile", func(r *http.Request) (interface{}, error) {
+		return a.profileFor(r, h.CPUProfile)
+	})
+	a.post("cpuprofile/start", func(r *http.Request) (interface{}, error) {
+		return a.withFile(r, h.StartCPUProfile)
+	})
+	a.post("cpuprofile/stop", func(*http.Request) (interface{}, error) {
+		return nil, h.StopCPUProfile()
+	})
+	a.post("trace", func(r *http.Request) (interface{}, error) {
+		return a.profileFor(r, h.GoTrace)
+	})
+	a.post("trace/start", func(r *http.Request) (interface{}, error) {
+		return a.withFile(r, h.StartGoTrace)
+	})
+	a.post("trace/stop", func(*http.Request) (interface{}, error) {
+		return nil, h.StopGoTrace()
+	})
+	a.post("blockprofile", func(r *http.Request) (interface{}, error) {
+		return a.profileFor(r, h.BlockProfile)
+	})
+	a.post("blockprofilerate", func(r *http.Request) (interface{}, error) {
+		rate, err := intParam(r, "rate")
+		if err != nil {
+			return nil, err
+		}
+		h.SetBlockProfileRate(rate)
+		return nil, nil
+	})
+	a.post("mutexprofile", func(r *http.Request) (interface{}, error) {
+		return a.profileFor(r, h.MutexProfile)
+	})
+	a.post("mutexprofilefraction", func(r *http.Request) (interface{}, error) {
+		rate, err := intParam(r, "rate")
+		if err != nil {
+			return nil, err
+		}
+		h.SetMutexProfileFraction(rate)
+		return nil, nil
+	})
+	a.post("memprofile", func(r *http.Request) (interface{}, error) {
+		return a.withFile(r, h.WriteMemProfile)
+	})
+	return a
+}
+
+// ServeHTTP rejects the requests without the API token before serving them. The
+// token must be given exactly as "Authorization: Bearer <token>".
+func (a *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, bearerPrefix) ||
+		subtle.ConstantTimeCompare([]byte(auth[len(bearerPrefix):]), a.token) != 1 {
+		http.Error(w, "invalid debug API token", http.StatusUnauthorized)
+		return
+	}
+	a.mux.ServeHTTP(w, r)
+}
+
+func (a *apiHandler) get(name string, fn apiMethod) {
+	a.handle(http.MethodGet, name, fn)
+}
+
+func (a *apiHandler) post(name string, fn apiMethod) {
+	a.handle(http.MethodPost, name, fn)
+}
+
+// handle serves a method of the API for requests with the given HTTP method, writing
+// its result as JSON. A path can be served for both GET and POST requests.
+func (a *apiHandler) handle(method string, name string, fn apiMethod) {
+	path := apiPrefix + name
+	methods, ok := a.methods[path]
+	if !ok {
+		methods = make(map[string]apiMethod)
+		a.methods[path] = methods
+		a.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fn, ok := methods[r.Method]
+			if !ok {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			result, err := fn(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			writeJSON(w, result)
+		})
+	}
+	methods[method] = fn
+}
+
+func writeJSON(w http.ResponseWriter, result interface{}) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(body); err != nil {
+		log.Errorf("Could not write debug API response: %v", err)
+	}
+}
+
+func intParam(r *http.Request, name string) (int, error) {
+	value, err := strconv.Atoi(r.FormValue(name))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s parameter: %v", name, err)
+	}
+	return value, nil
+}
+
+// withFile calls fn with the path of the file parameter of the request in the profile
+// directory, rejecting files outside of it so the API cannot overwrite other files
+// of the host.
+func (a *apiHandler) withFile(r *http.Request, fn func(file string) error) (interface{}, error) {
+	file := r.FormValue("file")
+	if file == "" {
+		return nil, errors.New("missing file parameter")
+	}
+	if filepath.IsAbs(file) {
+		return nil, errors.New("file parameter must be relative to the profile directory")
+	}
+	path := filepath.Join(a.profileDir, file)
+	rel, err := filepath.Rel(a.profileDir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, errors.New("file parameter must name a file in the profile directory")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("could not create profile directory: %v", err)
+	}
+	return nil, fn(path)
+}
+
+// profileFor calls a profiling method with the file and seconds parameters of the
+// request, responding once the profile is written.
+func (a *apiHandler) profileFor(r *http.Request, fn func(file string, nsec uint) error) (interface{}, error) {
+	seconds, err := strconv.ParseUint(r.FormValue("seconds"), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seconds parameter: %v", err)
+	}
+	return a.withFile(r, func(file string) error {
+		return fn(file, uint(seconds))
+	})
+}
+
+// loadAPIToken reads the token of the debug API from a file, generating a random
+// token readable only by the current user if the file does not exist.
+func loadAPIToken(path string) (string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(enc))
+		if token == "" {
+			return "", fmt.Errorf("debug API token file %s is empty", path)
+		}
+		return token, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", fmt.Errorf("could not read debug API token: %v", err)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate debug API token: %v", err)
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", fmt.Errorf("could not write debug API token: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(token + "\n"); err != nil {
+		return "", fmt.Errorf("could not write debug API token: %v", err)
+	}
+	log.WithField("file", path).Info("Generated debug API token")
+	return token, nil
+}
+
+// registerAPI serves the debug API of the global handler on the default HTTP mux,
+// which the pprof server uses.
+func registerAPI(ctx *cli.Context) error {
+	dataDir := ctx.GlobalString(cmd.DataDirFlag.Name)
+	tokenFile := ctx.GlobalString(DebugAPITokenFileFlag.Name)
+	if tokenFile == "" {
+		tokenFile = filepath.Join(dataDir, "debugapi.token")
+	}
+	token, err := loadAPIToken(expandHome(tokenFile))
+	if err != nil {
+		return err
+	}
+	profileDir := expandHome(filepath.Join(dataDir, profileDirName))
+	http.Handle(apiPrefix, newAPIHandler(token, profileDir, Handler))
+	return nil
+}
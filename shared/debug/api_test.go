@@ -0,0 +1,166 @@
+package debug
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const testToken = "secret"
+
+func serveAPI(t *testing.T, method string, path string, token string) *httptest.ResponseRecorder {
+	return serveAPIWithProfileDir(t, os.TempDir(), method, path, token)
+}
+
+func serveAPIWithProfileDir(t *testing.T, profileDir string, method string, path string, token string) *httptest.ResponseRecorder {
+	auth := ""
+	if token != "" {
+		auth = "Bearer " + token
+	}
+	return serveAPIWithAuthorization(t, profileDir, method, path, auth)
+}
+
+func serveAPIWithAuthorization(t *testing.T, profileDir string, method string, path string, auth string) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(method, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	rr := httptest.NewRecorder()
+	newAPIHandler(testToken, profileDir, Handler).ServeHTTP(rr, req)
+	return rr
+}
+
+func TestAPIHandler_RequiresToken(t *testing.T) {
+	for _, token := range []string{"", "wrong"} {
+		if rr := serveAPI(t, "GET", apiPrefix+"memstats", token); rr.Code != http.StatusUnauthorized {
+			t.Errorf("expected unauthorized status for token %q, received %d", token, rr.Code)
+		}
+	}
+	if rr := serveAPI(t, "GET", apiPrefix+"memstats", testToken); rr.Code != http.StatusOK {
+		t.Errorf("expected OK status, received %d: %s", rr.Code, rr.Body.String())
+	}
+}
+
+func TestAPIHandler_RequiresBearerAuthorization(t *testing.T) {
+	for _, auth := range []string{testToken, "bearer " + testToken, "Basic " + testToken, "Bearer  " + testToken, "Bearer"} {
+		if rr := serveAPIWithAuthorization(t, os.TempDir(), "GET", apiPrefix+"memstats", auth); rr.Code != http.StatusUnauthorized {
+			t.Errorf("expected unauthorized status for authorization %q, received %d", auth, rr.Code)
+		}
+	}
+}
+
+func TestAPIHandler_MemStatsAndStacks(t *testing.T) {
+	rr := serveAPI(t, "GET", apiPrefix+"memstats", testToken)
+	var stats struct{ HeapAlloc uint64 }
+	if err := json.Unmarshal(rr.Body.Bytes(), &stats); err != nil {
+		t.Fatalf("could not decode memory statistics: %v", err)
+	}
+	if stats.HeapAlloc == 0 {
+		t.Error("expected heap allocation to be reported")
+	}
+
+	rr = serveAPI(t, "GET", apiPrefix+"stacks", testToken)
+	if !strings.Contains(rr.Body.String(), "goroutine") {
+		t.Errorf("expected goroutine dump, received %s", rr.Body.String())
+	}
+	if rr := serveAPI(t, "POST", apiPrefix+"stacks", testToken); rr.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected method not allowed status, received %d", rr.Code)
+	}
+}
+
+func TestAPIHandler_Verbosity(t *testing.T) {
+	defer log.SetLevel(log.GetLevel())
+	log.SetLevel(log.InfoLevel)
+
+	rr := serveAPI(t, "POST", apiPrefix+"verbosity?level=debug", testToken)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected OK status, received %d: %s", rr.Code, rr.Body.String())
+	}
+	if rr.Body.String() != `"info"` {
+		t.Errorf("expected previous level to be returned, received %s", rr.Body.String())
+	}
+	if log.GetLevel() != log.DebugLevel {
+		t.Errorf("expected debug level, received %v", log.GetLevel())
+	}
+
+	rr = serveAPI(t, "GET", apiPrefix+"verbosity", testToken)
+	if rr.Body.String() != `"debug"` {
+		t.Errorf("expected current level, received %s", rr.Body.String())
+	}
+
+	if rr := serveAPI(t, "POST", apiPrefix+"verbosity?level=loud", testToken); rr.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request status for an unknown level, received %d", rr.Code)
+	}
+}
+
+func TestAPIHandler_MemProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debugapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	profileDir := filepath.Join(dir, profileDirName)
+
+	if rr := serveAPIWithProfileDir(t, profileDir, "POST", apiPrefix+"memprofile", testToken); rr.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request status without a file, received %d", rr.Code)
+	}
+	if rr := serveAPIWithProfileDir(t, profileDir, "POST", apiPrefix+"memprofile?file=heap/mem.prof", testToken); rr.Code != http.StatusOK {
+		t.Fatalf("expected OK status, received %d: %s", rr.Code, rr.Body.String())
+	}
+	if _, err := os.Stat(filepath.Join(profileDir, "heap", "mem.prof")); err != nil {
+		t.Errorf("expected memory profile to be written: %v", err)
+	}
+
+	outside := filepath.Join(dir, "mem.prof")
+	for _, file := range []string{outside, "../mem.prof", "heap/../../mem.prof", "."} {
+		if rr := serveAPIWithProfileDir(t, profileDir, "POST", apiPrefix+"memprofile?file="+file, testToken); rr.Code != http.StatusBadRequest {
+			t.Errorf("expected bad request status for file %q, received %d", file, rr.Code)
+		}
+	}
+	if _, err := os.Stat(outside); !os.IsNotExist(err) {
+		t.Errorf("expected no profile to be written outside of the profile directory: %v", err)
+	}
+}
+
+func TestLoadAPIToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "debugapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "debugapi.token")
+
+	token, err := loadAPIToken(path)
+	if err != nil {
+		t.Fatalf("could not generate token: %v", err)
+	}
+	if len(token) != 64 {
+		t.Errorf("expected 32 byte hex token, received %q", token)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected token file to be readable by its owner only, received %v", info.Mode().Perm())
+	}
+
+	loaded, err := loadAPIToken(path)
+	if err != nil {
+		t.Fatalf("could not load token: %v", err)
+	}
+	if loaded != token {
+		t.Errorf("expected token %q to be loaded, received %q", token, loaded)
+	}
+}
@@ -347,7 +347,14 @@ func Setup(ctx *cli.Context) error {
 	// pprof server
 	if ctx.GlobalBool(PProfFlag.Name) {
 		address := fmt.Sprintf("%s:%d", ctx.GlobalString(PProfAddrFlag.Name), ctx.GlobalInt(PProfPortFlag.Name))
+		if ctx.GlobalBool(DebugAPIFlag.Name) {
+			if err := registerAPI(ctx); err != nil {
+				return err
+			}
+		}
 		startPProf(address)
+	} else if ctx.GlobalBool(DebugAPIFlag.Name) {
+		return errors.New("the debug API is served on the pprof server, enable it with --pprof")
 	}
 	return nil
 }
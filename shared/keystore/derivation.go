@@ -0,0 +1,153 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Keys are derived from a seed following EIP-2333, along the paths of EIP-2334.
+const (
+	derivationPurpose  = 12381
+	derivationCoinType = 3600
+
+	lamportChunks = 255
+	// secretKeyOKMBytes is the length of the key material reduced to a secret
+	// key, which is longer than the curve order to keep the bias negligible.
+	secretKeyOKMBytes = 48
+)
+
+var (
+	// curveOrder is the order r of the BLS12-381 curve, which secret keys are
+	// elements of.
+	curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+	keyGenSalt    = []byte("BLS-SIG-KEYGEN-SALT-")
+)
+
+// WithdrawalKeyPath returns the derivation path of the withdrawal key of the
+// validator account with the given index.
+func WithdrawalKeyPath(index uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0", derivationPurpose, derivationCoinType, index)
+}
+
+// SigningKeyPath returns the derivation path of the signing key of the
+// validator account with the given index.
+func SigningKeyPath(index uint32) string {
+	return WithdrawalKeyPath(index) + "/0"
+}
+
+// NewKeyFromSeed derives the key at the derivation path from the seed.
+func NewKeyFromSeed(seed []byte, path string) (*Key, error) {
+	secretKey, err := DeriveSecretKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyFromBLS(secretKey)
+}
+
+// DeriveSecretKey derives the secret key at the derivation path, such as
+// m/12381/3600/0/0, from the seed.
+func DeriveSecretKey(seed []byte, path string) (*bls.SecretKey, error) {
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := deriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		sk = deriveChildSK(sk, index)
+	}
+	return &bls.SecretKey{K: sk}, nil
+}
+
+func parseDerivationPath(path string) ([]uint32, error) {
+	nodes := strings.Split(path, "/")
+	if nodes[0] != "m" {
+		return nil, fmt.Errorf("derivation path %s does not start at the master key m", path)
+	}
+	indices := make([]uint32, len(nodes)-1)
+	for i, node := range nodes[1:] {
+		index, err := strconv.ParseUint(node, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %q in derivation path %s", node, path)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
+
+func deriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < 32 {
+		return nil, errors.New("seed must be at least 32 bytes long")
+	}
+	return hkdfModR(seed), nil
+}
+
+func deriveChildSK(parentSK *big.Int, index uint32) *big.Int {
+	return hkdfModR(parentSKToLamportPK(parentSK, index))
+}
+
+// hkdfModR derives a non zero secret key from the input key material.
+func hkdfModR(ikm []byte) *big.Int {
+	salt := keyGenSalt
+	// The key material is suffixed with a zero byte.
+	ikm = append(append([]byte{}, ikm...), 0)
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		hash := sha256.Sum256(salt)
+		salt = hash[:]
+		okm := make([]byte, secretKeyOKMBytes)
+		r := hkdf.New(sha256.New, ikm, salt, []byte{0, secretKeyOKMBytes})
+		if _, err := io.ReadFull(r, okm); err != nil {
+			panic(err) // HKDF only fails when reading more than 255 hashes.
+		}
+		sk.SetBytes(okm)
+		sk.Mod(sk, curveOrder)
+	}
+	return sk
+}
+
+// parentSKToLamportPK compresses the Lamport public key derived from the
+// parent secret key, which child keys are derived from.
+func parentSKToLamportPK(parentSK *big.Int, index uint32) []byte {
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+	ikm := make([]byte, 32)
+	skBytes := parentSK.Bytes()
+	copy(ikm[32-len(skBytes):], skBytes)
+	notIKM := make([]byte, len(ikm))
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+
+	lamportPK := sha256.New()
+	for _, chunks := range [][][]byte{ikmToLamportSK(ikm, salt), ikmToLamportSK(notIKM, salt)} {
+		for _, chunk := range chunks {
+			hash := sha256.Sum256(chunk)
+			lamportPK.Write(hash[:])
+		}
+	}
+	return lamportPK.Sum(nil)
+}
+
+func ikmToLamportSK(ikm []byte, salt []byte) [][]byte {
+	okm := make([]byte, lamportChunks*sha256.Size)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, nil), okm); err != nil {
+		panic(err) // HKDF only fails when reading more than 255 hashes.
+	}
+	chunks := make([][]byte, lamportChunks)
+	for i := range chunks {
+		chunks[i] = okm[i*sha256.Size : (i+1)*sha256.Size]
+	}
+	return chunks
+}
@@ -0,0 +1,84 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestDeriveSecretKey(t *testing.T) {
+	// Test vectors from EIP-2333.
+	tests := []struct {
+		seed     string
+		masterSK string
+		index    uint32
+		childSK  string
+	}{
+		{
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK: "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			index:    0,
+			childSK:  "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:     "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK: "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			index:    3141592653,
+			childSK:  "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+	}
+	for _, tt := range tests {
+		seed, _ := hex.DecodeString(tt.seed)
+		master, err := DeriveSecretKey(seed, "m")
+		if err != nil {
+			t.Fatalf("Could not derive master key: %v", err)
+		}
+		if master.K.String() != tt.masterSK {
+			t.Errorf("Expected master key %s, received %s", tt.masterSK, master.K)
+		}
+		child, err := DeriveSecretKey(seed, "m/"+fmt.Sprint(tt.index))
+		if err != nil {
+			t.Fatalf("Could not derive child key: %v", err)
+		}
+		if child.K.String() != tt.childSK {
+			t.Errorf("Expected child key %s, received %s", tt.childSK, child.K)
+		}
+	}
+}
+
+func TestNewKeyFromSeed(t *testing.T) {
+	seed := make([]byte, 32)
+	if SigningKeyPath(2) != "m/12381/3600/2/0/0" {
+		t.Errorf("Unexpected signing key path %s", SigningKeyPath(2))
+	}
+	if WithdrawalKeyPath(2) != "m/12381/3600/2/0" {
+		t.Errorf("Unexpected withdrawal key path %s", WithdrawalKeyPath(2))
+	}
+	signingKey, err := NewKeyFromSeed(seed, SigningKeyPath(0))
+	if err != nil {
+		t.Fatalf("Could not derive signing key: %v", err)
+	}
+	withdrawalKey, err := NewKeyFromSeed(seed, WithdrawalKeyPath(0))
+	if err != nil {
+		t.Fatalf("Could not derive withdrawal key: %v", err)
+	}
+	if signingKey.SecretKey.K.Cmp(withdrawalKey.SecretKey.K) == 0 {
+		t.Error("Expected signing and withdrawal keys to differ")
+	}
+	again, err := NewKeyFromSeed(seed, SigningKeyPath(0))
+	if err != nil {
+		t.Fatalf("Could not derive signing key: %v", err)
+	}
+	if signingKey.SecretKey.K.Cmp(again.SecretKey.K) != 0 {
+		t.Error("Expected key derivation to be deterministic")
+	}
+
+	if _, err := NewKeyFromSeed(seed[:16], SigningKeyPath(0)); err == nil {
+		t.Error("Expected error when deriving a key from a short seed")
+	}
+	for _, path := range []string{"", "12381/3600", "m/12381/-1", "m/4294967296"} {
+		if _, err := NewKeyFromSeed(seed, path); err == nil {
+			t.Errorf("Expected error when deriving a key at path %q", path)
+		}
+	}
+}
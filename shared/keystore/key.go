@@ -21,6 +21,7 @@ package keystore
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -160,6 +161,17 @@ func NewKey(rand io.Reader) (*Key, error) {
 	return newKeyFromBLS(secretKey)
 }
 
+// NewKeyFromSecret creates a key from a buffered secret key, such as one exported
+// from another keystore.
+func NewKeyFromSecret(secret []byte) (*Key, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("secret key is empty")
+	}
+	secretKey := &bls.SecretKey{}
+	secretKey.UnBufferSecretKey(secret)
+	return newKeyFromBLS(secretKey)
+}
+
 func storeNewRandomKey(ks keyStore, rand io.Reader, password string) error {
 	key, err := NewKey(rand)
 	if err != nil {
@@ -174,17 +186,28 @@ func storeNewRandomKey(ks keyStore, rand io.Reader, password string) error {
 }
 
 func writeKeyFile(file string, content []byte) error {
+	// Atomic write: create a temporary hidden file first
+	// then move it into place.
+	tmp, err := writeTempKeyFile(file, content)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// writeTempKeyFile writes the content to a temporary hidden file next to the file,
+// and returns the path of the temporary file.
+func writeTempKeyFile(file string, content []byte) (string, error) {
 	// Create the keystore directory with appropriate permissions
 	// in case it is not present yet.
 	const dirPerm = 0700
 	if err := os.MkdirAll(filepath.Dir(file), dirPerm); err != nil {
-		return err
+		return "", err
 	}
-	// Atomic write: create a temporary hidden file first
-	// then move it into place. TempFile assigns mode 0600.
+	// TempFile assigns mode 0600.
 	f, err := ioutil.TempFile(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
 	if err != nil {
-		return err
+		return "", err
 	}
 	if _, err := f.Write(content); err != nil {
 		newErr := f.Close()
@@ -195,10 +218,10 @@ func writeKeyFile(file string, content []byte) error {
 		if newErr != nil {
 			err = newErr
 		}
-		return err
+		return "", err
 	}
 	if err := f.Close(); err != nil {
-		return err
+		return "", err
 	}
-	return os.Rename(f.Name(), file)
+	return f.Name(), nil
 }
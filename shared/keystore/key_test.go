@@ -87,6 +87,20 @@ func TestNewKeyFromBLS(t *testing.T) {
 
 }
 
+func TestNewKeyFromSecret(t *testing.T) {
+	key, err := NewKeyFromSecret(big.NewInt(20).Bytes())
+	if err != nil {
+		t.Fatalf("could not get new key from secret %v", err)
+	}
+	if big.NewInt(20).Cmp(key.SecretKey.K) != 0 {
+		t.Fatalf("secret key is not of the expected value %d", key.SecretKey.K)
+	}
+
+	if _, err := NewKeyFromSecret(nil); err == nil {
+		t.Error("expected error for an empty secret key")
+	}
+}
+
 func TestWriteFile(t *testing.T) {
 	tmpdir := os.TempDir()
 	filedir := tmpdir + "/keystore"
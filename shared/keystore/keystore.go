@@ -29,6 +29,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common/math"
@@ -71,6 +72,15 @@ func NewKeystore(directory string) Store {
 	}
 }
 
+// NewKeystoreWithScrypt from a directory, encrypting keys with the given scrypt parameters.
+func NewKeystoreWithScrypt(directory string, scryptN, scryptP int) Store {
+	return Store{
+		keysDirPath: directory,
+		scryptN:     scryptN,
+		scryptP:     scryptP,
+	}
+}
+
 // GetKey from file using the filename path and a decryption password.
 func (ks Store) GetKey(filename, password string) (*Key, error) {
 	// Load the key from the keystore and decrypt its contents
@@ -99,6 +109,44 @@ func (ks Store) JoinPath(filename string) string {
 	return filepath.Join(ks.keysDirPath, filename)
 }
 
+// KeyFilePath returns the path of the file the key is stored in within the keystore
+// directory, named after its public key.
+func (ks Store) KeyFilePath(key *Key) string {
+	return ks.JoinPath(keyFileName(key.PublicKey))
+}
+
+// KeyFiles returns the paths of the keyfiles in the keystore directory, in lexical order.
+// A keystore directory which does not exist yet holds no keyfiles.
+func (ks Store) KeyFiles() ([]string, error) {
+	files, err := ioutil.ReadDir(ks.keysDirPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range files {
+		if f.IsDir() || !IsKeyFileName(f.Name()) {
+			continue
+		}
+		paths = append(paths, ks.JoinPath(f.Name()))
+	}
+	return paths, nil
+}
+
+// StoreTempKey encrypts the key with a password into a temporary file next to the
+// key file, and returns the path of the temporary file. Renaming the temporary file
+// to the key file replaces the key file atomically, which lets several key files be
+// replaced only once all of them are encrypted.
+func (ks Store) StoreTempKey(filename string, key *Key, auth string) (string, error) {
+	keyjson, err := EncryptKey(key, auth, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return "", err
+	}
+	return writeTempKeyFile(filename, keyjson)
+}
+
 // StoreRandomKey generates a key, encrypts with 'auth' and stores in the given directory
 func StoreRandomKey(dir, password string, scryptN, scryptP int) error {
 	err := storeNewRandomKey(Store{dir, scryptN, scryptP}, rand.Reader, password)
@@ -73,3 +73,91 @@ func TestEncryptDecryptKey(t *testing.T) {
 	}
 
 }
+
+func TestKeyFiles(t *testing.T) {
+	filedir := os.TempDir() + "/keystore"
+	ks := &Store{
+		keysDirPath: filedir,
+		scryptN:     LightScryptN,
+		scryptP:     LightScryptP,
+	}
+
+	files, err := ks.KeyFiles()
+	if err != nil {
+		t.Fatalf("unable to list keyfiles of missing directory %v", err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected no keyfiles in missing directory, received %v", files)
+	}
+
+	var paths []string
+	for i := 0; i < 2; i++ {
+		key, err := NewKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("key generation failed %v", err)
+		}
+		path := ks.KeyFilePath(key)
+		if err := ks.StoreKey(path, key, "password"); err != nil {
+			t.Fatalf("unable to store key %v", err)
+		}
+		paths = append(paths, path)
+	}
+	if err := writeKeyFile(ks.JoinPath("otherfile"), []byte{}); err != nil {
+		t.Fatalf("unable to write file %v", err)
+	}
+
+	files, err = ks.KeyFiles()
+	if err != nil {
+		t.Fatalf("unable to list keyfiles %v", err)
+	}
+	if len(files) != 2 || files[0] != paths[0] || files[1] != paths[1] {
+		t.Errorf("expected keyfiles %v, received %v", paths, files)
+	}
+
+	if err := os.RemoveAll(filedir); err != nil {
+		t.Errorf("unable to remove temporary files %v", err)
+	}
+}
+
+func TestStoreTempKey(t *testing.T) {
+	filedir := os.TempDir() + "/keystore"
+	ks := &Store{
+		keysDirPath: filedir,
+		scryptN:     LightScryptN,
+		scryptP:     LightScryptP,
+	}
+
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("key generation failed %v", err)
+	}
+	path := ks.KeyFilePath(key)
+	if err := ks.StoreKey(path, key, "password"); err != nil {
+		t.Fatalf("unable to store key %v", err)
+	}
+
+	tmp, err := ks.StoreTempKey(path, key, "newpassword")
+	if err != nil {
+		t.Fatalf("unable to store temporary key %v", err)
+	}
+	if _, err := ks.GetKey(path, "password"); err != nil {
+		t.Errorf("expected key file to be unchanged until the temporary file is renamed %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatalf("unable to rename temporary key file %v", err)
+	}
+	if _, err := ks.GetKey(path, "password"); err != ErrDecrypt {
+		t.Errorf("expected decryption error with old password, received %v", err)
+	}
+	newkey, err := ks.GetKey(path, "newpassword")
+	if err != nil {
+		t.Fatalf("unable to get key with new password %v", err)
+	}
+	if newkey.SecretKey.K.Cmp(key.SecretKey.K) != 0 {
+		t.Errorf("retrieved secret keys are not equal %v , %v", newkey.SecretKey.K, key.SecretKey.K)
+	}
+
+	if err := os.RemoveAll(filedir); err != nil {
+		t.Errorf("unable to remove temporary files %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// mnemonicEntropyBytes is the entropy of generated mnemonics, which are
+	// encoded in 24 words.
+	mnemonicEntropyBytes = 32
+	mnemonicSeedRounds   = 2048
+	mnemonicSeedBytes    = 64
+	wordBits             = 11
+)
+
+var (
+	words     = strings.Fields(englishWordlist)
+	wordIndex = make(map[string]int64, len(words))
+)
+
+func init() {
+	for i, word := range words {
+		wordIndex[word] = int64(i)
+	}
+}
+
+// NewMnemonic generates a new random BIP-39 mnemonic, which the keys of
+// validator accounts can be derived from.
+func NewMnemonic(rand io.Reader) (string, error) {
+	entropy := make([]byte, mnemonicEntropyBytes)
+	if _, err := io.ReadFull(rand, entropy); err != nil {
+		return "", err
+	}
+	return EntropyToMnemonic(entropy)
+}
+
+// EntropyToMnemonic encodes the entropy in the words of a BIP-39 mnemonic. The
+// entropy must be 16 to 32 bytes long, in multiples of 4 bytes.
+func EntropyToMnemonic(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("invalid entropy length %d", len(entropy))
+	}
+	checksumBits := uint(len(entropy) / 4)
+	checksum := sha256.Sum256(entropy)
+
+	// The entropy is followed by the first bits of its hash, and split into
+	// 11 bit indices into the wordlist.
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, checksumBits)
+	data.Or(data, big.NewInt(int64(checksum[0]>>(8-checksumBits))))
+
+	numWords := (len(entropy)*8 + int(checksumBits)) / wordBits
+	mnemonic := make([]string, numWords)
+	mask := big.NewInt(1<<wordBits - 1)
+	index := new(big.Int)
+	for i := numWords - 1; i >= 0; i-- {
+		index.And(data, mask)
+		data.Rsh(data, wordBits)
+		mnemonic[i] = words[index.Int64()]
+	}
+	return strings.Join(mnemonic, " "), nil
+}
+
+// MnemonicToEntropy decodes the entropy of a BIP-39 mnemonic, verifying its
+// checksum.
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	mnemonicWords := strings.Fields(mnemonic)
+	if len(mnemonicWords) < 12 || len(mnemonicWords) > 24 || len(mnemonicWords)%3 != 0 {
+		return nil, fmt.Errorf("invalid number of mnemonic words %d", len(mnemonicWords))
+	}
+	data := new(big.Int)
+	for _, word := range mnemonicWords {
+		index, ok := wordIndex[word]
+		if !ok {
+			return nil, fmt.Errorf("invalid mnemonic word %q", word)
+		}
+		data.Lsh(data, wordBits)
+		data.Or(data, big.NewInt(index))
+	}
+
+	checksumBits := uint(len(mnemonicWords) / 3)
+	checksum := new(big.Int).And(data, big.NewInt(1<<checksumBits-1))
+	data.Rsh(data, checksumBits)
+	entropy := make([]byte, len(mnemonicWords)*4/3)
+	dataBytes := data.Bytes()
+	copy(entropy[len(entropy)-len(dataBytes):], dataBytes)
+
+	hash := sha256.Sum256(entropy)
+	if int64(hash[0]>>(8-checksumBits)) != checksum.Int64() {
+		return nil, errors.New("invalid mnemonic checksum")
+	}
+	return entropy, nil
+}
+
+// MnemonicToSeed verifies the BIP-39 mnemonic and returns the seed it and the
+// optional passphrase stand for, which is the root of the derived keys.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+	// Both the mnemonic and the passphrase are NFKD normalized, so that a passphrase
+	// typed with precomposed or combining characters stands for the same seed.
+	normalized := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(normalized), []byte(salt), mnemonicSeedRounds, mnemonicSeedBytes, sha512.New), nil
+}
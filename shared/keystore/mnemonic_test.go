@@ -0,0 +1,105 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEntropyToMnemonic(t *testing.T) {
+	// Test vectors from BIP-39.
+	tests := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			entropy:  "00000000000000000000000000000000",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			entropy:  "8080808080808080808080808080808080808080808080808080808080808080",
+			mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+			seed:     "c0c519bd0e91a2ed54357d9d1ebef6f5af218a153624cf4f2da911a0ed8f7a09e2ef61af0aca007096df430022f7a2b6fb91661a9589097069720d015e4e982f",
+		},
+	}
+	for _, tt := range tests {
+		entropy, _ := hex.DecodeString(tt.entropy)
+		mnemonic, err := EntropyToMnemonic(entropy)
+		if err != nil {
+			t.Fatalf("Could not encode entropy: %v", err)
+		}
+		if mnemonic != tt.mnemonic {
+			t.Errorf("Expected mnemonic %q, received %q", tt.mnemonic, mnemonic)
+		}
+		decoded, err := MnemonicToEntropy(mnemonic)
+		if err != nil {
+			t.Fatalf("Could not decode mnemonic: %v", err)
+		}
+		if !bytes.Equal(decoded, entropy) {
+			t.Errorf("Expected entropy %#x, received %#x", entropy, decoded)
+		}
+		seed, err := MnemonicToSeed(mnemonic, "TREZOR")
+		if err != nil {
+			t.Fatalf("Could not compute seed: %v", err)
+		}
+		if hex.EncodeToString(seed) != tt.seed {
+			t.Errorf("Expected seed %s, received %x", tt.seed, seed)
+		}
+	}
+}
+
+func TestMnemonicToEntropy_Invalid(t *testing.T) {
+	tests := []string{
+		"abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon satoshis",
+	}
+	for _, mnemonic := range tests {
+		if _, err := MnemonicToEntropy(mnemonic); err == nil {
+			t.Errorf("Expected error when decoding mnemonic %q", mnemonic)
+		}
+		if _, err := MnemonicToSeed(mnemonic, ""); err == nil {
+			t.Errorf("Expected error when computing the seed of mnemonic %q", mnemonic)
+		}
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate mnemonic: %v", err)
+	}
+	if len(strings.Fields(mnemonic)) != 24 {
+		t.Errorf("Expected mnemonic of 24 words, received %q", mnemonic)
+	}
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		t.Errorf("Generated invalid mnemonic: %v", err)
+	}
+}
+
+func TestMnemonicToSeed_NormalizesPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	// The passphrase is spelled with a precomposed and with a combining accent.
+	composed, err := MnemonicToSeed(mnemonic, "caf\u00e9")
+	if err != nil {
+		t.Fatalf("Could not compute seed: %v", err)
+	}
+	decomposed, err := MnemonicToSeed(mnemonic, "cafe\u0301")
+	if err != nil {
+		t.Fatalf("Could not compute seed: %v", err)
+	}
+	if !bytes.Equal(composed, decomposed) {
+		t.Error("Expected equivalent passphrases to stand for the same seed")
+	}
+	other, err := MnemonicToSeed(mnemonic, "cafe")
+	if err != nil {
+		t.Fatalf("Could not compute seed: %v", err)
+	}
+	if bytes.Equal(composed, other) {
+		t.Error("Expected different passphrases to stand for different seeds")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"crypto/cipher"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -48,11 +49,19 @@ func ensureInt(x interface{}) int {
 	return res
 }
 
+// keyFilePrefix starts the name of every keyfile.
+const keyFilePrefix = "UTC--"
+
 // keyFileName implements the naming convention for keyfiles:
 // UTC--<created_at UTC ISO8601>-<address hex>
 func keyFileName(pubkey *bls.PublicKey) string {
 	ts := time.Now().UTC()
-	return fmt.Sprintf("UTC--%s--%s", toISO8601(ts), hex.EncodeToString(pubkey.BufferedPublicKey()))
+	return fmt.Sprintf("%s%s--%s", keyFilePrefix, toISO8601(ts), hex.EncodeToString(pubkey.BufferedPublicKey()))
+}
+
+// IsKeyFileName reports whether the file name follows the naming convention for keyfiles.
+func IsKeyFileName(name string) bool {
+	return strings.HasPrefix(name, keyFilePrefix)
 }
 
 func toISO8601(t time.Time) string {
@@ -17,72 +17,83 @@ func makeEmptySignature() [][]byte {
 // BeaconChainConfig contains constant configs for node to participate in beacon chain.
 type BeaconChainConfig struct {
 	// Misc constants.
-	ShardCount                 uint64 // ShardCount is the number of shard chains in Ethereum 2.0.
-	TargetCommitteeSize        uint64 // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
-	EjectionBalance            uint64 // EjectionBalance is the minimal GWei a validator needs to have before ejected.
-	MaxBalanceChurnQuotient    uint64 // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
-	Gwei                       uint64 // Gwei is the denomination of Gwei in Ether.
-	BeaconChainShardNumber     uint64 // BeaconChainShardNumber is the shard number of the beacon chain.
-	MaxIndicesPerSlashableVote uint64 // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
-	LatestBlockRootsLength     uint64 // LatestBlockRootsLength is the number of block roots kept in the beacon state.
-	LatestRandaoMixesLength    uint64 // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
-	LatestPenalizedExitLength  uint64 // LatestPenalizedExitLength is used to track penalized exit balances per time interval.
-	LatestIndexRootsLength     uint64 // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
-	MaxWithdrawalsPerEpoch     uint64 // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
+	ShardCount                 uint64 `yaml:"shard_count"`                    // ShardCount is the number of shard chains in Ethereum 2.0.
+	TargetCommitteeSize        uint64 `yaml:"target_committee_size"`          // TargetCommitteeSize is the number of validators in a committee when the chain is healthy.
+	EjectionBalance            uint64 `yaml:"ejection_balance"`               // EjectionBalance is the minimal GWei a validator needs to have before ejected.
+	MaxBalanceChurnQuotient    uint64 `yaml:"max_balance_churn_quotient"`     // MaxBalanceChurnQuotient is used to determine how many validators can rotate per epoch.
+	Gwei                       uint64 `yaml:"gwei"`                           // Gwei is the denomination of Gwei in Ether.
+	BeaconChainShardNumber     uint64 `yaml:"beacon_chain_shard_number"`      // BeaconChainShardNumber is the shard number of the beacon chain.
+	MaxIndicesPerSlashableVote uint64 `yaml:"max_indices_per_slashable_vote"` // MaxIndicesPerSlashableVote is used to determine how many validators can be slashed per vote.
+	LatestBlockRootsLength     uint64 `yaml:"latest_block_roots_length"`      // LatestBlockRootsLength is the number of block roots kept in the beacon state.
+	LatestRandaoMixesLength    uint64 `yaml:"latest_randao_mixes_length"`     // LatestRandaoMixesLength is the number of randao mixes kept in the beacon state.
+	LatestPenalizedExitLength  uint64 `yaml:"latest_penalized_exit_length"`   // LatestPenalizedExitLength is used to track penalized exit balances per time interval.
+	LatestIndexRootsLength     uint64 `yaml:"latest_index_roots_length"`      // LatestIndexRootsLength is the number of index roots kept in beacon state, used by light client.
+	MaxWithdrawalsPerEpoch     uint64 `yaml:"max_withdrawals_per_epoch"`      // MaxWithdrawalsPerEpoch is the max withdrawals can happen for a single epoch.
+	ShuffleRoundCount          uint64 `yaml:"shuffle_round_count"`            // ShuffleRoundCount is the number of rounds of the swap-or-not shuffle.
 
 	// Deposit contract constants.
-	DepositContractAddress   []byte // DepositContractAddress is the address of the deposit contract in PoW chain.
-	DepositContractTreeDepth uint64 // Depth of the Merkle trie of deposits in the validator deposit contract on the PoW chain.
-	MinDeposit               uint64 // MinDeposit is the maximal amount of Gwei a validator can send to the deposit contract at once.
-	MaxDeposit               uint64 // MaxDeposit is the maximal amount of Gwei a validator can send to the deposit contract at once.
+	DepositContractAddress   []byte `yaml:"deposit_contract_address"`    // DepositContractAddress is the address of the deposit contract in PoW chain.
+	DepositContractTreeDepth uint64 `yaml:"deposit_contract_tree_depth"` // Depth of the Merkle trie of deposits in the validator deposit contract on the PoW chain.
+	MinDeposit               uint64 `yaml:"min_deposit"`                 // MinDeposit is the maximal amount of Gwei a validator can send to the deposit contract at once.
+	MaxDeposit               uint64 `yaml:"max_deposit"`                 // MaxDeposit is the maximal amount of Gwei a validator can send to the deposit contract at once.
 
 	// Initial value constants.
-	GenesisForkVersion      uint64   // GenesisForkVersion is used to track fork version between state transitions.
-	GenesisSlot             uint64   // GenesisSlot is used to initialize the genesis state fields.
-	GenesisEpoch            uint64   // GenesisEpoch is used to initialize epoch.
-	GenesisStartShard       uint64   // GenesisStartShard is the first shard to assign validators.
-	ZeroHash                [32]byte // ZeroHash is used to represent a zeroed out 32 byte array.
-	EmptySignature          [][]byte // EmptySignature is used to represent a zeroed out BLS Signature.
-	BLSWithdrawalPrefixByte byte     // BLSWithdrawalPrefixByte is used for BLS withdrawal and it's the first byte.
+	GenesisForkVersion      uint64   `yaml:"genesis_fork_version"`       // GenesisForkVersion is used to track fork version between state transitions.
+	GenesisSlot             uint64   `yaml:"genesis_slot"`               // GenesisSlot is used to initialize the genesis state fields.
+	GenesisEpoch            uint64   `yaml:"genesis_epoch"`              // GenesisEpoch is used to initialize epoch.
+	GenesisStartShard       uint64   `yaml:"genesis_start_shard"`        // GenesisStartShard is the first shard to assign validators.
+	ZeroHash                [32]byte `yaml:"-"`                          // ZeroHash is used to represent a zeroed out 32 byte array.
+	EmptySignature          [][]byte `yaml:"-"`                          // EmptySignature is used to represent a zeroed out BLS Signature.
+	BLSWithdrawalPrefixByte byte     `yaml:"bls_withdrawal_prefix_byte"` // BLSWithdrawalPrefixByte is used for BLS withdrawal and it's the first byte.
 
 	// Time parameters constants.
-	SlotDuration                 uint64 // SlotDuration is how many seconds are in a single slot.
-	MinAttestationInclusionDelay uint64 // MinAttestationInclusionDelay defines how long validator has to wait to include attestation for beacon block.
-	EpochLength                  uint64 // EpochLength is the number of slots in an epoch.
-	SeedLookahead                uint64 // SeedLookahead is the duration of randao look ahead seed.
-	EntryExitDelay               uint64 // EntryExitDelay is the duration a validator has to wait for entry and exit in epoch.
-	Eth1DataVotingPeriod         uint64 // Eth1DataVotingPeriod defines how often the merkle root of deposit receipts get updated in beacon node.
-	Eth1FollowDistance           uint64 // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
-	MinValidatorWithdrawalEpochs uint64 // MinValidatorWithdrawalEpochs is the shortest amount of time a validator can get the deposit out.
-	FarFutureEpoch               uint64 // FarFutureEpoch represents a epoch extremely far away in the future used as the default penalization slot for validators.
+	SlotDuration                 uint64 `yaml:"slot_duration"`                   // SlotDuration is how many seconds are in a single slot.
+	MinAttestationInclusionDelay uint64 `yaml:"min_attestation_inclusion_delay"` // MinAttestationInclusionDelay defines how long validator has to wait to include attestation for beacon block.
+	EpochLength                  uint64 `yaml:"epoch_length"`                    // EpochLength is the number of slots in an epoch.
+	SeedLookahead                uint64 `yaml:"seed_lookahead"`                  // SeedLookahead is the duration of randao look ahead seed.
+	EntryExitDelay               uint64 `yaml:"entry_exit_delay"`                // EntryExitDelay is the duration a validator has to wait for entry and exit in epoch.
+	Eth1DataVotingPeriod         uint64 `yaml:"eth1_data_voting_period"`         // Eth1DataVotingPeriod defines how often the merkle root of deposit receipts get updated in beacon node.
+	Eth1FollowDistance           uint64 `yaml:"eth1_follow_distance"`            // Eth1FollowDistance is the number of eth1.0 blocks to wait before considering a new deposit for voting. This only applies after the chain as been started.
+	MinValidatorWithdrawalEpochs uint64 `yaml:"min_validator_withdrawal_epochs"` // MinValidatorWithdrawalEpochs is the shortest amount of time a validator can get the deposit out.
+	FarFutureEpoch               uint64 `yaml:"far_future_epoch"`                // FarFutureEpoch represents a epoch extremely far away in the future used as the default penalization slot for validators.
 
 	// Reward and penalty quotients constants.
-	BaseRewardQuotient           uint64 // BaseRewardQuotient is used to calculate validator per-slot interest rate.
-	WhistlerBlowerRewardQuotient uint64 // WhistlerBlowerRewardQuotient is used to calculate whistler blower reward.
-	IncluderRewardQuotient       uint64 // IncluderRewardQuotient defines the reward quotient of proposer for including attestations..
-	InactivityPenaltyQuotient    uint64 // InactivityPenaltyQuotient defines how much validator leaks out balances for offline.
+	BaseRewardQuotient           uint64 `yaml:"base_reward_quotient"`            // BaseRewardQuotient is used to calculate validator per-slot interest rate.
+	WhistlerBlowerRewardQuotient uint64 `yaml:"whistler_blower_reward_quotient"` // WhistlerBlowerRewardQuotient is used to calculate whistler blower reward.
+	IncluderRewardQuotient       uint64 `yaml:"includer_reward_quotient"`        // IncluderRewardQuotient defines the reward quotient of proposer for including attestations..
+	InactivityPenaltyQuotient    uint64 `yaml:"inactivity_penalty_quotient"`     // InactivityPenaltyQuotient defines how much validator leaks out balances for offline.
 
 	// Max operations per block constants.
-	MaxExits             uint64 // MaxExits determines the maximum number of validator exits in a block.
-	MaxDeposits          uint64 // MaxExits determines the maximum number of validator deposits in a block.
-	MaxAttestations      uint64 // MaxAttestations defines the maximum allowed attestations in a beacon block.
-	MaxProposerSlashings uint64 // MaxProposerSlashings defines the maximum number of slashings of proposers possible in a block.
-	MaxAttesterSlashings uint64 // MaxAttesterSlashings defines the maximum number of casper FFG slashings possible in a block.
+	MaxExits             uint64 `yaml:"max_exits"`              // MaxExits determines the maximum number of validator exits in a block.
+	MaxDeposits          uint64 `yaml:"max_deposits"`           // MaxExits determines the maximum number of validator deposits in a block.
+	MaxAttestations      uint64 `yaml:"max_attestations"`       // MaxAttestations defines the maximum allowed attestations in a beacon block.
+	MaxProposerSlashings uint64 `yaml:"max_proposer_slashings"` // MaxProposerSlashings defines the maximum number of slashings of proposers possible in a block.
+	MaxAttesterSlashings uint64 `yaml:"max_attester_slashings"` // MaxAttesterSlashings defines the maximum number of casper FFG slashings possible in a block.
 
 	// Prysm constants.
-	DepositsForChainStart uint64    // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
-	SimulatedBlockRandao  [32]byte  // SimulatedBlockRandao is a RANDAO seed stubbed in simulated block for advancing local beacon chain.
-	RandBytes             uint64    // RandBytes is the number of bytes used as entropy to shuffle validators.
-	SyncPollingInterval   int64     // SyncPollingInterval queries network nodes for sync status.
-	GenesisTime           time.Time // GenesisTime used by the protocol.
-	MaxNumLog2Validators  uint64    // MaxNumLog2Validators is the Max number of validators in Log2 exists given total ETH supply.
+	DepositsForChainStart uint64    `yaml:"deposits_for_chain_start"` // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
+	SimulatedBlockRandao  [32]byte  `yaml:"simulated_block_randao"`   // SimulatedBlockRandao is a RANDAO seed stubbed in simulated block for advancing local beacon chain.
+	RandBytes             uint64    `yaml:"rand_bytes"`               // RandBytes is the number of bytes used as entropy to shuffle validators.
+	SyncPollingInterval   int64     `yaml:"sync_polling_interval"`    // SyncPollingInterval queries network nodes for sync status.
+	GenesisTime           time.Time `yaml:"genesis_time"`             // GenesisTime used by the protocol.
+	MaxNumLog2Validators  uint64    `yaml:"max_num_log2_validators"`  // MaxNumLog2Validators is the Max number of validators in Log2 exists given total ETH supply.
+	ShuffleAlgorithm      string    `yaml:"shuffle_algorithm"`        // ShuffleAlgorithm is the algorithm validators are shuffled into committees with, either FisherYatesShuffle or SwapOrNotShuffle.
 }
 
+// The shuffle algorithms validators can be shuffled into committees with.
+const (
+	// FisherYatesShuffle shuffles the whole list of validators with a Fisher-Yates shuffle.
+	FisherYatesShuffle = "fisher_yates"
+	// SwapOrNotShuffle shuffles validators with the swap-or-not shuffle, which can also
+	// find the committee position of a single validator without shuffling the whole list.
+	SwapOrNotShuffle = "swap_or_not"
+)
+
 // DepositContractConfig contains the deposits for
 type DepositContractConfig struct {
-	DepositsForChainStart *big.Int // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
-	MinDepositAmount      *big.Int // MinDepositAmount defines the minimum deposit amount in gwei that is required in the deposit contract.
-	MaxDepositAmount      *big.Int // // MaxDepositAmount defines the minimum deposit amount in gwei that is required in the deposit contract.
+	DepositsForChainStart *big.Int `yaml:"deposits_for_chain_start"` // DepositsForChainStart defines how many validator deposits needed to kick off beacon chain.
+	MinDepositAmount      *big.Int `yaml:"min_deposit_amount"`       // MinDepositAmount defines the minimum deposit amount in gwei that is required in the deposit contract.
+	MaxDepositAmount      *big.Int `yaml:"max_deposit_amount"`       // // MaxDepositAmount defines the minimum deposit amount in gwei that is required in the deposit contract.
 }
 
 // ShardChainConfig contains configs for node to participate in shard chains.
@@ -104,6 +115,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	LatestPenalizedExitLength:  8192,
 	LatestIndexRootsLength:     8192,
 	MaxWithdrawalsPerEpoch:     4,
+	ShuffleRoundCount:          90,
 
 	// Deposit contract constants.
 	DepositContractTreeDepth: 32,
@@ -147,6 +159,7 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	SyncPollingInterval:   6 * 4, // Query nodes over the network every 4 slots for sync status.
 	GenesisTime:           time.Date(2018, 9, 0, 0, 0, 0, 0, time.UTC),
 	MaxNumLog2Validators:  24,
+	ShuffleAlgorithm:      FisherYatesShuffle,
 }
 
 var demoBeaconConfig = &BeaconChainConfig{
@@ -162,6 +175,7 @@ var demoBeaconConfig = &BeaconChainConfig{
 	LatestPenalizedExitLength:  defaultBeaconConfig.LatestPenalizedExitLength,
 	LatestIndexRootsLength:     defaultBeaconConfig.LatestIndexRootsLength,
 	MaxWithdrawalsPerEpoch:     defaultBeaconConfig.MaxWithdrawalsPerEpoch,
+	ShuffleRoundCount:          defaultBeaconConfig.ShuffleRoundCount,
 
 	// Deposit contract constants.
 	DepositContractTreeDepth: defaultBeaconConfig.DepositContractTreeDepth,
@@ -205,6 +219,7 @@ var demoBeaconConfig = &BeaconChainConfig{
 	SyncPollingInterval:   2 * 4, // Query nodes over the network every 4 slots for sync status.
 	GenesisTime:           time.Now(),
 	MaxNumLog2Validators:  defaultBeaconConfig.MaxNumLog2Validators,
+	ShuffleAlgorithm:      defaultBeaconConfig.ShuffleAlgorithm,
 	SimulatedBlockRandao:  [32]byte{'S', 'I', 'M', 'U', 'L', 'A', 'T', 'O', 'R'},
 }
 
@@ -259,3 +274,9 @@ func UseDemoBeaconConfig() {
 func OverrideBeaconConfig(c *BeaconChainConfig) {
 	beaconConfig = c
 }
+
+// OverrideContractConfig by replacing the deposit contract config. Any subsequent
+// calls to params.ContractConfig() will return this new configuration.
+func OverrideContractConfig(c *DepositContractConfig) {
+	contractConfig = c
+}
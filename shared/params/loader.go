@@ -0,0 +1,359 @@
+package params
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-yaml/yaml"
+)
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	bigIntType = reflect.TypeOf(&big.Int{})
+)
+
+// chainConfigFile is the layout of a chain configuration file. Both sections are
+// optional, only the keys present in the file override the current configuration.
+type chainConfigFile struct {
+	BeaconChainConfig     map[string]interface{} `yaml:"beacon_chain_config"`
+	DepositContractConfig map[string]interface{} `yaml:"deposit_contract_config"`
+}
+
+// LoadChainConfigFile reads a YAML or JSON chain configuration file and uses it to
+// override the current beacon chain and deposit contract configs. Keys are the yaml
+// tags of the BeaconChainConfig and DepositContractConfig fields, for example:
+//
+//    beacon_chain_config:
+//      epoch_length: 8
+//      shard_count: 16
+//    deposit_contract_config:
+//      deposits_for_chain_start: 8
+//
+// Byte fields are hex encoded with a 0x prefix and the genesis time is either an
+// RFC 3339 timestamp or a unix timestamp. Unknown keys, values which do not fit their
+// field and invalid combinations of values are rejected, in which case the current
+// configs are left untouched.
+func LoadChainConfigFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read chain config file: %v", err)
+	}
+	beaconCfg, contractCfg, err := ParseChainConfig(data, BeaconConfig())
+	if err != nil {
+		return fmt.Errorf("invalid chain config file %s: %v", path, err)
+	}
+	OverrideBeaconConfig(beaconCfg)
+	OverrideContractConfig(contractCfg)
+	return nil
+}
+
+// ParseChainConfig applies the YAML or JSON chain configuration on top of a copy of
+// the given beacon chain config and validates the result. Deposit contract values which
+// are not part of the configuration follow the beacon chain config's deposit parameters.
+func ParseChainConfig(data []byte, beaconCfg *BeaconChainConfig) (*BeaconChainConfig, *DepositContractConfig, error) {
+	file := &chainConfigFile{}
+	if err := yaml.UnmarshalStrict(data, file); err != nil {
+		return nil, nil, fmt.Errorf("could not decode chain config: %v", err)
+	}
+	newBeaconCfg := *beaconCfg
+	if err := setConfigFields(&newBeaconCfg, file.BeaconChainConfig); err != nil {
+		return nil, nil, fmt.Errorf("beacon_chain_config: %v", err)
+	}
+	newContractCfg := &DepositContractConfig{
+		DepositsForChainStart: new(big.Int).SetUint64(newBeaconCfg.DepositsForChainStart),
+		MinDepositAmount:      new(big.Int).SetUint64(newBeaconCfg.MinDeposit),
+		MaxDepositAmount:      new(big.Int).SetUint64(newBeaconCfg.MaxDeposit),
+	}
+	if err := setConfigFields(newContractCfg, file.DepositContractConfig); err != nil {
+		return nil, nil, fmt.Errorf("deposit_contract_config: %v", err)
+	}
+	if err := ValidateChainConfig(&newBeaconCfg, newContractCfg); err != nil {
+		return nil, nil, err
+	}
+	return &newBeaconCfg, newContractCfg, nil
+}
+
+// ValidateChainConfig checks the values of the given configs make sense on their own
+// and together, returning every problem found.
+func ValidateChainConfig(beaconCfg *BeaconChainConfig, contractCfg *DepositContractConfig) error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	nonZero := map[string]uint64{
+		"shard_count":                     beaconCfg.ShardCount,
+		"target_committee_size":           beaconCfg.TargetCommitteeSize,
+		"max_balance_churn_quotient":      beaconCfg.MaxBalanceChurnQuotient,
+		"latest_block_roots_length":       beaconCfg.LatestBlockRootsLength,
+		"latest_randao_mixes_length":      beaconCfg.LatestRandaoMixesLength,
+		"latest_penalized_exit_length":    beaconCfg.LatestPenalizedExitLength,
+		"latest_index_roots_length":       beaconCfg.LatestIndexRootsLength,
+		"max_deposit":                     beaconCfg.MaxDeposit,
+		"slot_duration":                   beaconCfg.SlotDuration,
+		"epoch_length":                    beaconCfg.EpochLength,
+		"eth1_data_voting_period":         beaconCfg.Eth1DataVotingPeriod,
+		"base_reward_quotient":            beaconCfg.BaseRewardQuotient,
+		"whistler_blower_reward_quotient": beaconCfg.WhistlerBlowerRewardQuotient,
+		"includer_reward_quotient":        beaconCfg.IncluderRewardQuotient,
+		"inactivity_penalty_quotient":     beaconCfg.InactivityPenaltyQuotient,
+		"deposits_for_chain_start":        beaconCfg.DepositsForChainStart,
+	}
+	keys := make([]string, 0, len(nonZero))
+	for key := range nonZero {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		check(nonZero[key] > 0, "%s must be greater than 0", key)
+	}
+
+	check(beaconCfg.MinDeposit <= beaconCfg.MaxDeposit,
+		"min_deposit %d is greater than max_deposit %d", beaconCfg.MinDeposit, beaconCfg.MaxDeposit)
+	check(beaconCfg.EjectionBalance <= beaconCfg.MaxDeposit,
+		"ejection_balance %d is greater than max_deposit %d", beaconCfg.EjectionBalance, beaconCfg.MaxDeposit)
+	check(beaconCfg.DepositContractTreeDepth > 0 && beaconCfg.DepositContractTreeDepth <= 64,
+		"deposit_contract_tree_depth %d must be between 1 and 64", beaconCfg.DepositContractTreeDepth)
+	check(len(beaconCfg.DepositContractAddress) == 0 || len(beaconCfg.DepositContractAddress) == 20,
+		"deposit_contract_address must be 20 bytes, received %d", len(beaconCfg.DepositContractAddress))
+	if beaconCfg.EpochLength > 0 {
+		check(beaconCfg.MinAttestationInclusionDelay < beaconCfg.EpochLength,
+			"min_attestation_inclusion_delay %d must be lower than epoch_length %d",
+			beaconCfg.MinAttestationInclusionDelay, beaconCfg.EpochLength)
+		check(beaconCfg.LatestBlockRootsLength >= beaconCfg.EpochLength,
+			"latest_block_roots_length %d must be at least epoch_length %d",
+			beaconCfg.LatestBlockRootsLength, beaconCfg.EpochLength)
+		check(beaconCfg.GenesisEpoch == beaconCfg.GenesisSlot/beaconCfg.EpochLength,
+			"genesis_epoch %d does not match genesis_slot %d", beaconCfg.GenesisEpoch, beaconCfg.GenesisSlot)
+	}
+	check(beaconCfg.SeedLookahead < beaconCfg.LatestRandaoMixesLength,
+		"seed_lookahead %d must be lower than latest_randao_mixes_length %d",
+		beaconCfg.SeedLookahead, beaconCfg.LatestRandaoMixesLength)
+	check(beaconCfg.EntryExitDelay >= beaconCfg.SeedLookahead,
+		"entry_exit_delay %d must be at least seed_lookahead %d", beaconCfg.EntryExitDelay, beaconCfg.SeedLookahead)
+	check(beaconCfg.ShardCount == 0 || beaconCfg.GenesisStartShard < beaconCfg.ShardCount,
+		"genesis_start_shard %d must be lower than shard_count %d", beaconCfg.GenesisStartShard, beaconCfg.ShardCount)
+	check(beaconCfg.RandBytes > 0 && beaconCfg.RandBytes <= 8,
+		"rand_bytes %d must be between 1 and 8", beaconCfg.RandBytes)
+	check(beaconCfg.MaxNumLog2Validators <= 8*beaconCfg.RandBytes,
+		"max_num_log2_validators %d cannot be shuffled with rand_bytes %d", beaconCfg.MaxNumLog2Validators, beaconCfg.RandBytes)
+	check(beaconCfg.SyncPollingInterval > 0, "sync_polling_interval must be greater than 0")
+	check(beaconCfg.ShuffleAlgorithm == FisherYatesShuffle || beaconCfg.ShuffleAlgorithm == SwapOrNotShuffle,
+		"shuffle_algorithm %q must be %s or %s", beaconCfg.ShuffleAlgorithm, FisherYatesShuffle, SwapOrNotShuffle)
+	check(beaconCfg.ShuffleRoundCount > 0 && beaconCfg.ShuffleRoundCount <= 256,
+		"shuffle_round_count %d must be between 1 and 256", beaconCfg.ShuffleRoundCount)
+
+	if contractCfg == nil || contractCfg.DepositsForChainStart == nil ||
+		contractCfg.MinDepositAmount == nil || contractCfg.MaxDepositAmount == nil {
+		problems = append(problems, "deposit contract config is incomplete")
+	} else {
+		check(contractCfg.DepositsForChainStart.Cmp(new(big.Int).SetUint64(beaconCfg.DepositsForChainStart)) == 0,
+			"deposit contract deposits_for_chain_start %v does not match beacon chain deposits_for_chain_start %d",
+			contractCfg.DepositsForChainStart, beaconCfg.DepositsForChainStart)
+		check(contractCfg.MinDepositAmount.Cmp(new(big.Int).SetUint64(beaconCfg.MinDeposit)) == 0,
+			"deposit contract min_deposit_amount %v does not match beacon chain min_deposit %d",
+			contractCfg.MinDepositAmount, beaconCfg.MinDeposit)
+		check(contractCfg.MaxDepositAmount.Cmp(new(big.Int).SetUint64(beaconCfg.MaxDeposit)) == 0,
+			"deposit contract max_deposit_amount %v does not match beacon chain max_deposit %d",
+			contractCfg.MaxDepositAmount, beaconCfg.MaxDeposit)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid chain config: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ConfigEntries returns the values of every configurable field of the given config,
+// keyed by their yaml tag and formatted the same way they are in a chain config file.
+func ConfigEntries(cfg interface{}) map[string]string {
+	v := reflect.ValueOf(cfg).Elem()
+	entries := make(map[string]string)
+	for i := 0; i < v.NumField(); i++ {
+		key := v.Type().Field(i).Tag.Get("yaml")
+		if key == "" || key == "-" {
+			continue
+		}
+		entries[key] = formatConfigValue(v.Field(i))
+	}
+	return entries
+}
+
+// setConfigFields sets the fields of the config matching the yaml tags of the given values.
+func setConfigFields(cfg interface{}, values map[string]interface{}) error {
+	v := reflect.ValueOf(cfg).Elem()
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < v.NumField(); i++ {
+		key := v.Type().Field(i).Tag.Get("yaml")
+		if key != "" && key != "-" {
+			fields[key] = v.Field(i)
+		}
+	}
+	for key, value := range values {
+		field, ok := fields[key]
+		if !ok {
+			return fmt.Errorf("unknown key %s", key)
+		}
+		if err := setConfigValue(field, value); err != nil {
+			return fmt.Errorf("invalid value for %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func setConfigValue(field reflect.Value, value interface{}) error {
+	switch {
+	case field.Type() == timeType:
+		switch t := value.(type) {
+		case time.Time:
+			field.Set(reflect.ValueOf(t.UTC()))
+		case string:
+			parsed, err := time.Parse(time.RFC3339, t)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(parsed.UTC()))
+		default:
+			unix, err := toInt64(value)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(time.Unix(unix, 0).UTC()))
+		}
+	case field.Type() == bigIntType:
+		var n *big.Int
+		if s, ok := value.(string); ok {
+			parsed, ok := new(big.Int).SetString(s, 10)
+			if !ok {
+				return fmt.Errorf("%q is not a decimal number", s)
+			}
+			n = parsed
+		} else {
+			u, err := toUint64(value)
+			if err != nil {
+				return err
+			}
+			n = new(big.Int).SetUint64(u)
+		}
+		if n.Sign() < 0 {
+			return fmt.Errorf("%v is negative", n)
+		}
+		field.Set(reflect.ValueOf(n))
+	case field.Kind() == reflect.Uint64 || field.Kind() == reflect.Uint8:
+		u, err := toUint64(value)
+		if err != nil {
+			return err
+		}
+		if field.OverflowUint(u) {
+			return fmt.Errorf("%d overflows %s", u, field.Type())
+		}
+		field.SetUint(u)
+	case field.Kind() == reflect.Int64:
+		i, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case field.Kind() == reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, received %v", value)
+		}
+		field.SetString(s)
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.Uint8:
+		b, err := decodeHexValue(value)
+		if err != nil {
+			return err
+		}
+		field.SetBytes(b)
+	case field.Kind() == reflect.Array && field.Type().Elem().Kind() == reflect.Uint8:
+		b, err := decodeHexValue(value)
+		if err != nil {
+			return err
+		}
+		if len(b) != field.Len() {
+			return fmt.Errorf("expected %d bytes, received %d", field.Len(), len(b))
+		}
+		reflect.Copy(field, reflect.ValueOf(b))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+func formatConfigValue(field reflect.Value) string {
+	switch {
+	case field.Type() == timeType:
+		return field.Interface().(time.Time).UTC().Format(time.RFC3339)
+	case field.Type() == bigIntType:
+		if field.IsNil() {
+			return ""
+		}
+		return field.Interface().(*big.Int).String()
+	case field.Kind() == reflect.Uint64 || field.Kind() == reflect.Uint8:
+		return strconv.FormatUint(field.Uint(), 10)
+	case field.Kind() == reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case field.Kind() == reflect.Slice:
+		return fmt.Sprintf("%#x", field.Bytes())
+	case field.Kind() == reflect.Array:
+		b := make([]byte, field.Len())
+		reflect.Copy(reflect.ValueOf(b), field)
+		return fmt.Sprintf("%#x", b)
+	default:
+		return fmt.Sprintf("%v", field.Interface())
+	}
+}
+
+func toUint64(value interface{}) (uint64, error) {
+	switch n := value.(type) {
+	case int:
+		if n >= 0 {
+			return uint64(n), nil
+		}
+	case int64:
+		if n >= 0 {
+			return uint64(n), nil
+		}
+	case uint64:
+		return n, nil
+	case uint:
+		return uint64(n), nil
+	default:
+		return 0, fmt.Errorf("expected an unsigned integer, received %v", value)
+	}
+	return 0, fmt.Errorf("expected an unsigned integer, received negative %v", value)
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch n := value.(type) {
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint64:
+		if n <= 1<<63-1 {
+			return int64(n), nil
+		}
+		return 0, fmt.Errorf("%d overflows int64", n)
+	default:
+		return 0, fmt.Errorf("expected an integer, received %v", value)
+	}
+}
+
+func decodeHexValue(value interface{}) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, fmt.Errorf("expected a 0x prefixed hex string, received %v", value)
+	}
+	return hex.DecodeString(s[2:])
+}
@@ -0,0 +1,156 @@
+package params
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateChainConfig_Presets(t *testing.T) {
+	if err := ValidateChainConfig(defaultBeaconConfig, defaultDepositContractConfig); err != nil {
+		t.Errorf("Default config is invalid: %v", err)
+	}
+	if err := ValidateChainConfig(demoBeaconConfig, defaultDepositContractConfig); err != nil {
+		t.Errorf("Demo config is invalid: %v", err)
+	}
+}
+
+func TestParseChainConfig_YAML(t *testing.T) {
+	data := []byte(`
+beacon_chain_config:
+  epoch_length: 8
+  shard_count: 16
+  deposits_for_chain_start: 8
+  slot_duration: 3
+  deposit_contract_address: "0x0102030405060708090a0b0c0d0e0f1011121314"
+  genesis_time: 2019-01-02T03:04:05Z
+  far_future_epoch: 18446744073709551615
+  shuffle_algorithm: swap_or_not
+`)
+	beaconCfg, contractCfg, err := ParseChainConfig(data, defaultBeaconConfig)
+	if err != nil {
+		t.Fatalf("Could not parse chain config: %v", err)
+	}
+	if beaconCfg.EpochLength != 8 || beaconCfg.ShardCount != 16 || beaconCfg.SlotDuration != 3 {
+		t.Errorf("Config values were not overridden: %+v", beaconCfg)
+	}
+	if beaconCfg.TargetCommitteeSize != defaultBeaconConfig.TargetCommitteeSize {
+		t.Errorf("Expected unspecified values to keep their default, received %d", beaconCfg.TargetCommitteeSize)
+	}
+	if beaconCfg.ShuffleAlgorithm != SwapOrNotShuffle {
+		t.Errorf("Expected swap-or-not shuffle, received %q", beaconCfg.ShuffleAlgorithm)
+	}
+	if beaconCfg.FarFutureEpoch != 1<<64-1 {
+		t.Errorf("Expected max uint64 far future epoch, received %d", beaconCfg.FarFutureEpoch)
+	}
+	if len(beaconCfg.DepositContractAddress) != 20 || beaconCfg.DepositContractAddress[19] != 0x14 {
+		t.Errorf("Unexpected deposit contract address %#x", beaconCfg.DepositContractAddress)
+	}
+	if !beaconCfg.GenesisTime.Equal(time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("Unexpected genesis time %v", beaconCfg.GenesisTime)
+	}
+	if contractCfg.DepositsForChainStart.Uint64() != 8 {
+		t.Errorf("Expected deposit contract to follow beacon chain deposits for chain start, received %v",
+			contractCfg.DepositsForChainStart)
+	}
+	if defaultBeaconConfig.EpochLength != 64 {
+		t.Error("Parsing a chain config modified the base config")
+	}
+}
+
+func TestParseChainConfig_JSON(t *testing.T) {
+	data := []byte(`{
+		"beacon_chain_config": {"epoch_length": 16, "min_deposit": 2000000000},
+		"deposit_contract_config": {"min_deposit_amount": "2000000000"}
+	}`)
+	beaconCfg, contractCfg, err := ParseChainConfig(data, defaultBeaconConfig)
+	if err != nil {
+		t.Fatalf("Could not parse chain config: %v", err)
+	}
+	if beaconCfg.EpochLength != 16 || contractCfg.MinDepositAmount.Uint64() != 2e9 {
+		t.Errorf("Config values were not overridden: %+v, %+v", beaconCfg, contractCfg)
+	}
+}
+
+func TestParseChainConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		config string
+		err    string
+	}{
+		{config: "unknown_section: {}", err: "not found"},
+		{config: "beacon_chain_config: {epoch_lenght: 8}", err: "unknown key epoch_lenght"},
+		{config: "beacon_chain_config: {zero_hash: \"0x00\"}", err: "unknown key zero_hash"},
+		{config: "beacon_chain_config: {epoch_length: -1}", err: "negative"},
+		{config: "beacon_chain_config: {epoch_length: \"8\"}", err: "expected an unsigned integer"},
+		{config: "beacon_chain_config: {bls_withdrawal_prefix_byte: 256}", err: "overflows"},
+		{config: "beacon_chain_config: {simulated_block_randao: \"0x01\"}", err: "expected 32 bytes"},
+		{config: "beacon_chain_config: {deposit_contract_address: \"0xzz\"}", err: "invalid byte"},
+		{config: "beacon_chain_config: {epoch_length: 0}", err: "epoch_length must be greater than 0"},
+		{config: "beacon_chain_config: {min_deposit: 64000000000}", err: "min_deposit 64000000000 is greater than max_deposit"},
+		{config: "beacon_chain_config: {min_attestation_inclusion_delay: 64}", err: "must be lower than epoch_length"},
+		{config: "deposit_contract_config: {deposits_for_chain_start: 8}", err: "does not match beacon chain deposits_for_chain_start"},
+		{config: "beacon_chain_config: {shuffle_algorithm: 8}", err: "expected a string"},
+		{config: "beacon_chain_config: {shuffle_algorithm: shuffle}", err: "shuffle_algorithm \"shuffle\" must be fisher_yates or swap_or_not"},
+		{config: "beacon_chain_config: {shuffle_round_count: 257}", err: "shuffle_round_count 257 must be between 1 and 256"},
+	}
+	for _, tt := range tests {
+		_, _, err := ParseChainConfig([]byte(tt.config), defaultBeaconConfig)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected error containing %q for config %q, received %v", tt.err, tt.config, err)
+		}
+	}
+}
+
+func TestLoadChainConfigFile(t *testing.T) {
+	defer func() {
+		OverrideBeaconConfig(defaultBeaconConfig)
+		OverrideContractConfig(defaultDepositContractConfig)
+	}()
+	dir, err := ioutil.TempDir("", "chainconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "invalid.yaml")
+	if err := ioutil.WriteFile(path, []byte("beacon_chain_config: {epoch_length: 0}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadChainConfigFile(path); err == nil {
+		t.Error("Expected error when loading an invalid chain config")
+	}
+	if BeaconConfig() != defaultBeaconConfig {
+		t.Error("Expected an invalid chain config to leave the current config untouched")
+	}
+
+	path = filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(path, []byte("beacon_chain_config: {deposits_for_chain_start: 4}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadChainConfigFile(path); err != nil {
+		t.Fatalf("Could not load chain config file: %v", err)
+	}
+	if BeaconConfig().DepositsForChainStart != 4 || ContractConfig().DepositsForChainStart.Uint64() != 4 {
+		t.Errorf("Expected 4 deposits for chain start, received %d and %v",
+			BeaconConfig().DepositsForChainStart, ContractConfig().DepositsForChainStart)
+	}
+}
+
+func TestConfigEntries(t *testing.T) {
+	entries := ConfigEntries(defaultBeaconConfig)
+	if entries["epoch_length"] != "64" {
+		t.Errorf("Expected epoch length 64, received %s", entries["epoch_length"])
+	}
+	if entries["genesis_time"] != "2018-08-31T00:00:00Z" {
+		t.Errorf("Unexpected genesis time %s", entries["genesis_time"])
+	}
+	if _, ok := entries["zero_hash"]; ok {
+		t.Error("Expected fields which are not configurable to be left out")
+	}
+	if entries := ConfigEntries(defaultDepositContractConfig); entries["max_deposit_amount"] != "32000000000" {
+		t.Errorf("Unexpected max deposit amount %s", entries["max_deposit_amount"])
+	}
+}
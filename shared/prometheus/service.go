@@ -1,9 +1,8 @@
 package prometheus
 
 import (
-	"bytes"
 	"context"
-	"fmt"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -30,44 +29,56 @@ func NewPrometheusService(addr string, svcRegistry *shared.ServiceRegistry) *Ser
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.HandleFunc("/healthz", s.healthzHandler)
+	mux.HandleFunc("/readyz", s.readyzHandler)
 
 	s.server = &http.Server{Addr: addr, Handler: mux}
 
 	return s
 }
 
+// healthzHandler reports whether the node is alive, writing the health report of
+// the services as JSON. It fails only when a service has failed, so syncing and
+// degraded nodes are not restarted.
 func (s *Service) healthzHandler(w http.ResponseWriter, _ *http.Request) {
-	// Call all services in the registry.
-	// if any are not OK, write 500
-	// print the statuses of all services.
-
-	statuses := s.svcRegistry.Statuses()
-	hasError := false
-	var buf bytes.Buffer
-	for k, v := range statuses {
-		var status string
-		if v == nil {
-			status = "OK"
-		} else {
-			hasError = true
-			status = "ERROR " + v.Error()
-		}
+	report := s.svcRegistry.Health()
+	code := http.StatusOK
+	if report.Status == shared.StatusFailed {
+		code = http.StatusInternalServerError
+	}
+	writeHealthReport(w, code, report)
+}
 
-		if _, err := buf.WriteString(fmt.Sprintf("%s: %s\n", k, status)); err != nil {
-			hasError = true
+// readyzHandler reports whether the node can serve requests, writing the health
+// report of the services as JSON. The node is ready unless a service is syncing or
+// failed, whatever the status of the other services, so a syncing node is alive but
+// not ready even when another service is degraded.
+func (s *Service) readyzHandler(w http.ResponseWriter, _ *http.Request) {
+	report := s.svcRegistry.Health()
+	code := http.StatusOK
+	for _, service := range report.Services {
+		switch service.Status {
+		case shared.StatusFailed:
+			code = http.StatusInternalServerError
+		case shared.StatusSyncing:
+			if code == http.StatusOK {
+				code = http.StatusServiceUnavailable
+			}
 		}
 	}
+	writeHealthReport(w, code, report)
+}
 
-	// Write status header
-	if hasError {
+func writeHealthReport(w http.ResponseWriter, code int, report *shared.HealthReport) {
+	body, err := json.Marshal(report)
+	if err != nil {
+		log.Errorf("Could not encode health report %v", err)
 		w.WriteHeader(http.StatusInternalServerError)
-	} else {
-		w.WriteHeader(http.StatusOK)
+		return
 	}
-
-	// Write http body
-	if _, err := w.Write(buf.Bytes()); err != nil {
-		log.Errorf("Could not write healthz body %v", err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(body); err != nil {
+		log.Errorf("Could not write health report %v", err)
 	}
 }
 
@@ -1,10 +1,10 @@
 package prometheus
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
-	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared"
@@ -39,6 +39,21 @@ func (m *mockService) Status() error {
 	return m.status
 }
 
+func serveHealth(t *testing.T, handler http.HandlerFunc) (int, *shared.HealthReport) {
+	req, err := http.NewRequest("GET", "/", nil /*reader*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	report := &shared.HealthReport{}
+	if err := json.Unmarshal(rr.Body.Bytes(), report); err != nil {
+		t.Fatalf("Could not decode health report %q: %v", rr.Body.String(), err)
+	}
+	return rr.Code, report
+}
+
 func TestHealthz(t *testing.T) {
 	registry := shared.NewServiceRegistry()
 	m := &mockService{}
@@ -47,42 +62,85 @@ func TestHealthz(t *testing.T) {
 	}
 	s := NewPrometheusService("" /*addr*/, registry)
 
-	req, err := http.NewRequest("GET", "/healthz", nil /*reader*/)
-	if err != nil {
-		t.Fatal(err)
+	code, report := serveHealth(t, s.healthzHandler)
+	if code != http.StatusOK {
+		t.Errorf("expected OK status but got %v", code)
 	}
-
-	handler := http.HandlerFunc(s.healthzHandler)
-
-	rr := httptest.NewRecorder()
-	handler.ServeHTTP(rr, req)
-
-	if status := rr.Code; status != http.StatusOK {
-		t.Errorf("expected OK status but got %v", rr.Code)
+	if report.Status != shared.StatusHealthy {
+		t.Errorf("expected healthy report, got %v", report.Status)
+	}
+	if len(report.Services) != 1 || report.Services[0].Service != "*prometheus.mockService" {
+		t.Errorf("Expected report to contain mockService status, but got %v", report.Services)
 	}
 
-	body := rr.Body.String()
-	if !strings.Contains(body, "*prometheus.mockService: OK") {
-		t.Errorf("Expected body to contain mockService status, but got %v", body)
+	m.status = shared.Syncing(errors.New("syncing from slot 10"))
+	if code, _ := serveHealth(t, s.healthzHandler); code != http.StatusOK {
+		t.Errorf("expected OK status for a syncing node but got %v", code)
 	}
 
 	m.status = errors.New("something really bad has happened")
+	code, report = serveHealth(t, s.healthzHandler)
+	if code != http.StatusInternalServerError {
+		t.Errorf("expected error status but got %v", code)
+	}
+	if report.Status != shared.StatusFailed {
+		t.Errorf("expected failed report, got %v", report.Status)
+	}
+	if report.Services[0].Error != "something really bad has happened" {
+		t.Errorf("Expected report to contain mockService error, but got %v", report.Services[0].Error)
+	}
+}
 
-	rr = httptest.NewRecorder()
-	handler.ServeHTTP(rr, req)
+func TestReadyz(t *testing.T) {
+	registry := shared.NewServiceRegistry()
+	m := &mockService{}
+	if err := registry.RegisterService(m); err != nil {
+		t.Fatalf("failed to registry service %v", err)
+	}
+	s := NewPrometheusService("" /*addr*/, registry)
 
-	if status := rr.Code; status != http.StatusInternalServerError {
-		t.Errorf("expected error status but got %v", rr.Code)
+	tests := []struct {
+		status error
+		code   int
+		level  shared.StatusLevel
+	}{
+		{status: nil, code: http.StatusOK, level: shared.StatusHealthy},
+		{status: shared.Degraded(errors.New("no peers")), code: http.StatusOK, level: shared.StatusDegraded},
+		{status: shared.Syncing(errors.New("syncing")), code: http.StatusServiceUnavailable, level: shared.StatusSyncing},
+		{status: errors.New("failure"), code: http.StatusInternalServerError, level: shared.StatusFailed},
+	}
+	for _, tt := range tests {
+		m.status = tt.status
+		code, report := serveHealth(t, s.readyzHandler)
+		if code != tt.code {
+			t.Errorf("expected %d status for %v but got %v", tt.code, tt.level, code)
+		}
+		if report.Status != tt.level {
+			t.Errorf("expected %v report, got %v", tt.level, report.Status)
+		}
 	}
 
-	body = rr.Body.String()
-	if !strings.Contains(
-		body,
-		"*prometheus.mockService: ERROR something really bad has happened",
-	) {
-		t.Errorf("Expected body to contain mockService status, but got %v", body)
+	// The node is not ready while a service syncs, even if another one is worse off.
+	m.status = shared.Syncing(errors.New("syncing"))
+	degraded := &degradedMockService{}
+	if err := registry.RegisterService(degraded); err != nil {
+		t.Fatalf("failed to registry service %v", err)
+	}
+	code, report := serveHealth(t, s.readyzHandler)
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("expected %d status while syncing but got %v", http.StatusServiceUnavailable, code)
 	}
+	if report.Status != shared.StatusDegraded {
+		t.Errorf("expected %v report, got %v", shared.StatusDegraded, report.Status)
+	}
+}
+
+type degradedMockService struct {
+	mockService
+}
 
+func (d *degradedMockService) Status() error {
+	return shared.Degraded(errors.New("no peers"))
 }
 
 func TestStatus(t *testing.T) {
@@ -0,0 +1,143 @@
+package shared
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// StatusLevel describes how well a service is running, from healthy to failed.
+type StatusLevel int
+
+const (
+	// StatusHealthy is the level of a service which is running normally.
+	StatusHealthy StatusLevel = iota
+	// StatusSyncing is the level of a service which is running, but still catching
+	// up with the network before it can serve requests.
+	StatusSyncing
+	// StatusDegraded is the level of a service which is running with reduced
+	// functionality, such as a service whose dependency failed.
+	StatusDegraded
+	// StatusFailed is the level of a service which is not running properly.
+	StatusFailed
+)
+
+// String returns the name of the status level.
+func (l StatusLevel) String() string {
+	switch l {
+	case StatusHealthy:
+		return "healthy"
+	case StatusSyncing:
+		return "syncing"
+	case StatusDegraded:
+		return "degraded"
+	case StatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("StatusLevel(%d)", int(l))
+	}
+}
+
+// MarshalText encodes the status level as its name, such as in JSON health reports.
+func (l StatusLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText decodes the status level from its name.
+func (l *StatusLevel) UnmarshalText(text []byte) error {
+	for level := StatusHealthy; level <= StatusFailed; level++ {
+		if level.String() == string(text) {
+			*l = level
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown status level %q", text)
+}
+
+// StatusError is an error returned by the Status method of a service which is
+// not failed, but not healthy either.
+type StatusError struct {
+	Level StatusLevel
+	Err   error
+}
+
+// Error returns the message of the underlying error.
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+// Syncing marks the status of a service as syncing, with the error describing its progress.
+func Syncing(err error) error {
+	return &StatusError{Level: StatusSyncing, Err: err}
+}
+
+// Degraded marks the status of a service as degraded, with the error describing why.
+func Degraded(err error) error {
+	return &StatusError{Level: StatusDegraded, Err: err}
+}
+
+// StatusLevelOf returns the status level described by the result of Service.Status.
+// A nil status is healthy, and an error which is not a StatusError is failed.
+func StatusLevelOf(status error) StatusLevel {
+	if status == nil {
+		return StatusHealthy
+	}
+	if statusErr, ok := status.(*StatusError); ok {
+		return statusErr.Level
+	}
+	return StatusFailed
+}
+
+// ServiceHealth is the status of a registered service, in a health report.
+type ServiceHealth struct {
+	Service   string      `json:"service"`
+	Status    StatusLevel `json:"status"`
+	Error     string      `json:"error,omitempty"`
+	DependsOn []string    `json:"dependsOn,omitempty"`
+}
+
+// HealthReport aggregates the statuses of the services in a registry. Its status
+// is the worst status of the services.
+type HealthReport struct {
+	Status   StatusLevel     `json:"status"`
+	Services []ServiceHealth `json:"services"`
+}
+
+// Health returns the status of every service in the order they are started.
+// A service is at least as unhealthy as the services it depends on, except that
+// the failure of a dependency only degrades it.
+func (s *ServiceRegistry) Health() *HealthReport {
+	report := &HealthReport{Status: StatusHealthy}
+	levels := make(map[reflect.Type]StatusLevel)
+	for _, kind := range s.startOrder() {
+		status := s.services[kind].Status()
+		health := ServiceHealth{
+			Service: kind.String(),
+			Status:  StatusLevelOf(status),
+		}
+		if status != nil {
+			health.Error = status.Error()
+		}
+		for _, dependency := range s.dependencies[kind] {
+			if _, ok := s.services[dependency]; !ok {
+				continue
+			}
+			health.DependsOn = append(health.DependsOn, dependency.String())
+			inherited := levels[dependency]
+			if inherited == StatusFailed {
+				inherited = StatusDegraded
+			}
+			if inherited > health.Status {
+				health.Status = inherited
+				if health.Error == "" {
+					health.Error = fmt.Sprintf("depends on %v which is %v", dependency, levels[dependency])
+				}
+			}
+		}
+		levels[kind] = health.Status
+		if health.Status > report.Status {
+			report.Status = health.Status
+		}
+		report.Services = append(report.Services, health)
+	}
+	return report
+}
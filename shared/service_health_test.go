@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStatusLevelOf(t *testing.T) {
+	tests := []struct {
+		status error
+		level  StatusLevel
+	}{
+		{status: nil, level: StatusHealthy},
+		{status: Syncing(errors.New("syncing")), level: StatusSyncing},
+		{status: Degraded(errors.New("no peers")), level: StatusDegraded},
+		{status: errors.New("failure"), level: StatusFailed},
+	}
+	for _, tt := range tests {
+		if level := StatusLevelOf(tt.status); level != tt.level {
+			t.Errorf("expected %v for status %v, received %v", tt.level, tt.status, level)
+		}
+	}
+}
+
+func TestHealth_AggregatesDependencies(t *testing.T) {
+	registry := NewServiceRegistry()
+	dependency := &mockService{}
+	dependent := &secondMockService{}
+	if err := registry.RegisterService(dependent, dependency); err != nil {
+		t.Fatalf("failed to register dependent service: %v", err)
+	}
+	if err := registry.RegisterService(dependency); err != nil {
+		t.Fatalf("failed to register dependency: %v", err)
+	}
+
+	report := registry.Health()
+	if report.Status != StatusHealthy {
+		t.Errorf("expected healthy report, received %v", report.Status)
+	}
+	if report.Services[0].Service != "*shared.mockService" {
+		t.Errorf("expected dependency to be reported first, received %v", report.Services[0].Service)
+	}
+
+	dependency.status = Syncing(errors.New("syncing"))
+	report = registry.Health()
+	if report.Status != StatusSyncing || report.Services[1].Status != StatusSyncing {
+		t.Errorf("expected dependent service to be syncing with its dependency, received %v", report.Services[1].Status)
+	}
+
+	dependency.status = errors.New("failure")
+	report = registry.Health()
+	if report.Status != StatusFailed {
+		t.Errorf("expected failed report, received %v", report.Status)
+	}
+	if report.Services[1].Status != StatusDegraded {
+		t.Errorf("expected dependent service to be degraded, received %v", report.Services[1].Status)
+	}
+	if report.Services[1].Error == "" {
+		t.Error("expected dependent service to report the failed dependency")
+	}
+}
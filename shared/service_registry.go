@@ -3,12 +3,16 @@ package shared
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
 var log = logrus.WithField("prefix", "registry")
 
+// defaultStopTimeout is how long StopAll waits for each service to stop.
+const defaultStopTimeout = 30 * time.Second
+
 // Service is a struct that can be registered into a ServiceRegistry for
 // easy dependency management.
 type Service interface {
@@ -25,36 +29,76 @@ type Service interface {
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
 type ServiceRegistry struct {
-	services     map[reflect.Type]Service // map of types to services.
-	serviceTypes []reflect.Type           // keep an ordered slice of registered service types.
+	services     map[reflect.Type]Service        // map of types to services.
+	serviceTypes []reflect.Type                  // keep an ordered slice of registered service types.
+	dependencies map[reflect.Type][]reflect.Type // map of types to the types of the services they depend on.
+	stopTimeout  time.Duration                   // how long to wait for each service to stop.
 }
 
 // NewServiceRegistry starts a registry instance for convenience
 func NewServiceRegistry() *ServiceRegistry {
 	return &ServiceRegistry{
-		services: make(map[reflect.Type]Service),
+		services:     make(map[reflect.Type]Service),
+		dependencies: make(map[reflect.Type][]reflect.Type),
+		stopTimeout:  defaultStopTimeout,
 	}
 }
 
-// StartAll initialized each service in order of registration.
+// StartAll initialized each service after the services it depends on, and
+// otherwise in order of registration.
 func (s *ServiceRegistry) StartAll() {
-	log.Infof("Starting %d services: %v", len(s.serviceTypes), s.serviceTypes)
-	for _, kind := range s.serviceTypes {
+	order := s.startOrder()
+	log.Infof("Starting %d services: %v", len(order), order)
+	for _, kind := range order {
 		log.Debugf("Starting service type %v", kind)
 		s.services[kind].Start()
 	}
 }
 
-// StopAll ends every service in reverse order of registration, logging a
-// panic if any of them fail to stop.
-func (s *ServiceRegistry) StopAll() {
-	for i := len(s.serviceTypes) - 1; i >= 0; i-- {
-		kind := s.serviceTypes[i]
+// StopAll ends every service in reverse order of starting, logging an
+// error if any of them fail to stop or do not stop within the stop timeout.
+// Each service is given the chance to finish its work before the services
+// it depends on are stopped. An error is returned if any service did not stop
+// within the timeout, as it may still be using resources shared with the node.
+func (s *ServiceRegistry) StopAll() error {
+	order := s.startOrder()
+	var running []reflect.Type
+	for i := len(order) - 1; i >= 0; i-- {
+		kind := order[i]
 		service := s.services[kind]
-		if err := service.Stop(); err != nil {
-			log.Panicf("Could not stop the following service: %v, %v", kind, err)
+		stopped, err := s.stopService(service)
+		if !stopped {
+			log.Errorf("Could not stop the following service: %v, service did not stop within %v", kind, s.stopTimeout)
+			running = append(running, kind)
+			continue
+		}
+		if err != nil {
+			log.Errorf("Could not stop the following service: %v, %v", kind, err)
 		}
 	}
+	if len(running) > 0 {
+		return fmt.Errorf("services did not stop within %v: %v", s.stopTimeout, running)
+	}
+	return nil
+}
+
+// stopService stops a service, giving up on waiting for it once the stop timeout
+// elapses, and reports whether the service stopped. A zero timeout waits until
+// the service stops.
+func (s *ServiceRegistry) stopService(service Service) (bool, error) {
+	if s.stopTimeout == 0 {
+		return true, service.Stop()
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- service.Stop()
+	}()
+	select {
+	case err := <-errs:
+		return true, err
+	case <-time.After(s.stopTimeout):
+		return false, nil
+	}
 }
 
 // Statuses returns a map of Service type -> error. The map will be populated
@@ -68,17 +112,67 @@ func (s *ServiceRegistry) Statuses() map[reflect.Type]error {
 }
 
 // RegisterService appends a service constructor function to the service
-// registry.
-func (s *ServiceRegistry) RegisterService(service Service) error {
+// registry, along with the services it depends on. A service is started after,
+// and stopped before, the services it depends on, whatever order they are
+// registered in. Dependencies can be given as typed nil pointers, and the ones
+// that are never registered are ignored so that optional services can be listed.
+func (s *ServiceRegistry) RegisterService(service Service, dependencies ...Service) error {
 	kind := reflect.TypeOf(service)
 	if _, exists := s.services[kind]; exists {
 		return fmt.Errorf("service already exists: %v", kind)
 	}
+	var dependencyTypes []reflect.Type
+	for _, dependency := range dependencies {
+		dependencyType := reflect.TypeOf(dependency)
+		if dependencyType == kind || s.dependsOn(dependencyType, kind) {
+			return fmt.Errorf("service %v cannot depend on %v: dependency cycle", kind, dependencyType)
+		}
+		dependencyTypes = append(dependencyTypes, dependencyType)
+	}
+	if s.dependencies == nil {
+		s.dependencies = make(map[reflect.Type][]reflect.Type)
+	}
 	s.services[kind] = service
 	s.serviceTypes = append(s.serviceTypes, kind)
+	s.dependencies[kind] = dependencyTypes
 	return nil
 }
 
+// dependsOn returns true if the service of the given type depends on the other
+// type, directly or through its dependencies.
+func (s *ServiceRegistry) dependsOn(kind reflect.Type, other reflect.Type) bool {
+	for _, dependency := range s.dependencies[kind] {
+		if dependency == other || s.dependsOn(dependency, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// startOrder returns the registered service types with every service after the
+// services it depends on, keeping the order of registration otherwise.
+func (s *ServiceRegistry) startOrder() []reflect.Type {
+	order := make([]reflect.Type, 0, len(s.serviceTypes))
+	visited := make(map[reflect.Type]bool)
+	var visit func(kind reflect.Type)
+	visit = func(kind reflect.Type) {
+		if visited[kind] {
+			return
+		}
+		visited[kind] = true
+		for _, dependency := range s.dependencies[kind] {
+			if _, ok := s.services[dependency]; ok {
+				visit(dependency)
+			}
+		}
+		order = append(order, kind)
+	}
+	for _, kind := range s.serviceTypes {
+		visit(kind)
+	}
+	return order
+}
+
 // FetchService takes in a struct pointer and sets the value of that pointer
 // to a service currently stored in the service registry. This ensures the input argument is
 // set to the right pointer that refers to the originally registered service.
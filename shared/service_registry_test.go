@@ -4,10 +4,16 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
 type mockService struct {
-	status error
+	status  error
+	stopErr error
+	stopped bool
 }
 type secondMockService struct {
 	status error
@@ -17,7 +23,8 @@ func (m *mockService) Start() {
 }
 
 func (m *mockService) Stop() error {
-	return nil
+	m.stopped = true
+	return m.stopErr
 }
 
 func (m *mockService) Status() error {
@@ -35,6 +42,22 @@ func (s *secondMockService) Status() error {
 	return s.status
 }
 
+type blockingMockService struct {
+	release chan struct{}
+}
+
+func (b *blockingMockService) Start() {
+}
+
+func (b *blockingMockService) Stop() error {
+	<-b.release
+	return nil
+}
+
+func (b *blockingMockService) Status() error {
+	return nil
+}
+
 func TestRegisterServiceTwice(t *testing.T) {
 	registry := &ServiceRegistry{
 		services: make(map[reflect.Type]Service),
@@ -142,3 +165,94 @@ func TestServiceStatus(t *testing.T) {
 		t.Errorf("Received unexpected status for %T = %v", s, sStatus)
 	}
 }
+
+func TestStopAll_ContinuesAfterFailedStop(t *testing.T) {
+	hook := logTest.NewGlobal()
+	registry := NewServiceRegistry()
+
+	m := &mockService{}
+	if err := registry.RegisterService(m); err != nil {
+		t.Fatalf("failed to register first service")
+	}
+	s := &secondMockService{}
+	if err := registry.RegisterService(s); err != nil {
+		t.Fatalf("failed to register second service")
+	}
+	b := &blockingMockService{release: make(chan struct{})}
+	defer close(b.release)
+	if err := registry.RegisterService(b); err != nil {
+		t.Fatalf("failed to register third service")
+	}
+	m.stopErr = errors.New("could not close connection")
+	registry.stopTimeout = 10 * time.Millisecond
+
+	if err := registry.StopAll(); err == nil {
+		t.Error("expected an error as the third service did not stop")
+	}
+
+	if !m.stopped {
+		t.Error("expected first service to be stopped after the third one timed out")
+	}
+	testutil.AssertLogsContain(t, hook, "service did not stop within")
+	testutil.AssertLogsContain(t, hook, "could not close connection")
+}
+
+type recordingService struct {
+	name   string
+	events *[]string
+}
+
+func (r *recordingService) Start() {
+	*r.events = append(*r.events, "start "+r.name)
+}
+
+func (r *recordingService) Stop() error {
+	*r.events = append(*r.events, "stop "+r.name)
+	return nil
+}
+
+func (r *recordingService) Status() error {
+	return nil
+}
+
+type dependentRecordingService struct {
+	recordingService
+}
+
+func TestStartAll_StartsDependenciesFirst(t *testing.T) {
+	registry := NewServiceRegistry()
+	var events []string
+
+	dependent := &dependentRecordingService{recordingService{name: "dependent", events: &events}}
+	// The mock service is never registered, so the dependency on it is ignored.
+	if err := registry.RegisterService(dependent, (*recordingService)(nil), &mockService{}); err != nil {
+		t.Fatalf("failed to register dependent service: %v", err)
+	}
+	if err := registry.RegisterService(&recordingService{name: "dependency", events: &events}); err != nil {
+		t.Fatalf("failed to register dependency: %v", err)
+	}
+
+	registry.StartAll()
+	if err := registry.StopAll(); err != nil {
+		t.Fatalf("failed to stop services: %v", err)
+	}
+
+	want := []string{"start dependency", "start dependent", "stop dependent", "stop dependency"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected events %v, received %v", want, events)
+	}
+}
+
+func TestRegisterService_DependencyCycle(t *testing.T) {
+	registry := NewServiceRegistry()
+
+	if err := registry.RegisterService(&mockService{}, (*secondMockService)(nil)); err != nil {
+		t.Fatalf("failed to register first service: %v", err)
+	}
+	if err := registry.RegisterService(&secondMockService{}, (*mockService)(nil)); err == nil {
+		t.Error("expected registering a dependency cycle to fail")
+	}
+	if err := registry.RegisterService(&recordingService{}, (*recordingService)(nil)); err == nil {
+		t.Error("expected a service depending on itself to fail")
+	}
+}
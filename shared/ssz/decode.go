@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 )
 
@@ -59,6 +60,8 @@ func makeDecoder(typ reflect.Type) (dec decoder, err error) {
 		return decodeUint32, nil
 	case kind == reflect.Uint64:
 		return decodeUint64, nil
+	case isEnum(typ):
+		return decodeEnum, nil
 	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
 		return decodeBytes, nil
 	case kind == reflect.Slice:
@@ -128,6 +131,19 @@ func decodeUint64(r io.Reader, val reflect.Value) (uint32, error) {
 	return 8, nil
 }
 
+func decodeEnum(r io.Reader, val reflect.Value) (uint32, error) {
+	b := make([]byte, 8)
+	if err := readBytes(r, 8, b); err != nil {
+		return 0, err
+	}
+	v := binary.LittleEndian.Uint64(b)
+	if v > math.MaxInt32 {
+		return 0, fmt.Errorf("enum value %d overflows int32", v)
+	}
+	val.SetInt(int64(v))
+	return 8, nil
+}
+
 func decodeBytes(r io.Reader, val reflect.Value) (uint32, error) {
 	sizeEnc := make([]byte, lengthBytes)
 	if err := readBytes(r, lengthBytes, sizeEnc); err != nil {
@@ -70,6 +70,9 @@ var decodeTests = []decodeTest{
 	{input: "FFFFFFFF00000000", ptr: new(uint64), value: uint64(4294967295)},
 	{input: "FFFFFFFFFFFFFFFF", ptr: new(uint64), value: uint64(18446744073709551615)},
 
+	// enum
+	{input: "0200000000000000", ptr: new(testEnum), value: testEnum(2)},
+
 	// bytes
 	{input: "00000000", ptr: new([]byte), value: []byte{}},
 	{input: "0100000001", ptr: new([]byte), value: []byte{1}},
@@ -159,6 +162,9 @@ var decodeTests = []decodeTest{
 	// error: unsupported type
 	{input: "00", ptr: new(string), error: "decode error: type string is not serializable for output type string"},
 
+	// error: enum: overflow
+	{input: "0000008000000000", ptr: new(testEnum), error: "decode error: enum value 2147483648 overflows int32 for output type ssz.testEnum"},
+
 	// error: bool: wrong input value
 	{input: "02", ptr: new(bool), error: "decode error: expect 0 or 1 for decoding bool but got 2 for output type bool"},
 
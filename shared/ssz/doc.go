@@ -15,6 +15,9 @@ struct
 
 Types that can be implicitly supported:
 
+enum:
+	protobuf enums, named int32 types, are encoded as uint64
+
 address:
 	use byte slice of length 20 instead
 hash:
@@ -92,6 +92,8 @@ func makeEncoder(typ reflect.Type) (encoder, encodeSizer, error) {
 		return encodeUint32, func(reflect.Value) (uint32, error) { return 4, nil }, nil
 	case kind == reflect.Uint64:
 		return encodeUint64, func(reflect.Value) (uint32, error) { return 8, nil }, nil
+	case isEnum(typ):
+		return encodeEnum, func(reflect.Value) (uint32, error) { return 8, nil }, nil
 	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
 		return makeBytesEncoder()
 	case kind == reflect.Slice:
@@ -148,6 +150,23 @@ func encodeUint64(val reflect.Value, w *encbuf) error {
 	return nil
 }
 
+// isEnum reports whether the type is a protobuf enum, which are named int32 types.
+// The spec defines such flags as uint64, so enums are encoded as uint64 as well.
+func isEnum(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Int32 && typ.PkgPath() != ""
+}
+
+func encodeEnum(val reflect.Value, w *encbuf) error {
+	v := val.Int()
+	if v < 0 {
+		return fmt.Errorf("negative enum value %d", v)
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	w.str = append(w.str, b...)
+	return nil
+}
+
 func makeBytesEncoder() (encoder, encodeSizer, error) {
 	encoder := func(val reflect.Value, w *encbuf) error {
 		b := val.Bytes()
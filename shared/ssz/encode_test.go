@@ -20,6 +20,9 @@ type encSizeTest struct {
 	error string
 }
 
+// testEnum mirrors the named int32 type of a protobuf enum.
+type testEnum int32
+
 type encEncodableTest struct {
 	i [4]byte
 }
@@ -73,6 +76,10 @@ var encodeTests = []encTest{
 	{val: uint64(4294967295), output: "FFFFFFFF00000000"},
 	{val: uint64(18446744073709551615), output: "FFFFFFFFFFFFFFFF"},
 
+	// enum
+	{val: testEnum(0), output: "0000000000000000"},
+	{val: testEnum(2), output: "0200000000000000"},
+
 	// bytes
 	{val: []byte{}, output: "00000000"},
 	{val: []byte{1}, output: "01000000 01"},
@@ -146,6 +153,10 @@ var encodeTests = []encTest{
 
 	// error: unsupported type
 	{val: string("abc"), error: "encode error: type string is not serializable for input type string"},
+	{val: int32(1), error: "encode error: type int32 is not serializable for input type int32"},
+
+	// error: negative enum
+	{val: testEnum(-1), error: "encode error: negative enum value -1 for input type ssz.testEnum"},
 }
 
 var encodeSizeTests = []encSizeTest{
@@ -168,6 +179,9 @@ var encodeSizeTests = []encSizeTest{
 	{val: uint64(0), size: 8},
 	{val: uint64(65535), size: 8},
 
+	// enum
+	{val: testEnum(2), size: 8},
+
 	// bytes
 	{val: []byte{}, size: 4},
 	{val: []byte{1}, size: 5},
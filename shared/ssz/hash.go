@@ -65,6 +65,7 @@ func makeHasher(typ reflect.Type) (hasher, error) {
 		kind == reflect.Uint16 ||
 		kind == reflect.Uint32 ||
 		kind == reflect.Uint64 ||
+		isEnum(typ) ||
 		isEncodable:
 		return getEncoding, nil
 	case kind == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 ||
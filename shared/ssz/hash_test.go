@@ -71,6 +71,9 @@ var hashTests = []hashTest{
 	{val: uint64(4294967295), output: "FFFFFFFF00000000000000000000000000000000000000000000000000000000"},
 	{val: uint64(18446744073709551615), output: "FFFFFFFFFFFFFFFF000000000000000000000000000000000000000000000000"},
 
+	// enum
+	{val: testEnum(2), output: "0200000000000000000000000000000000000000000000000000000000000000"},
+
 	// bytes
 	{val: []byte{}, output: "E8E77626586F73B955364C7B4BBF0BB7F7685EBD40E852B164633A4ACBD3244C"},
 	{val: []byte{1}, output: "B2559FED89F0EC17542C216683DC6B75506F3754E0C045742936742CAE6343CA"},
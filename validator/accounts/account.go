@@ -3,10 +3,16 @@ package accounts
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/sirupsen/logrus"
@@ -14,55 +20,126 @@ import (
 
 var log = logrus.WithField("prefix", "accounts")
 
-// VerifyAccountNotExists checks if a validator has not yet created an account
-// and keystore in the provided directory string.
-func VerifyAccountNotExists(directory string, password string) error {
-	if directory == "" || password == "" {
-		return errors.New("expected a path to the validator keystore and password to be provided, received nil")
-	}
-	shardWithdrawalKeyFile := directory + "/shardwithdrawalkey"
-	validatorKeyFile := directory + "/validatorprivatekey"
-	// First, if the keystore already exists, throws an error as there can only be
-	// one keystore per validator client.
-	ks := keystore.NewKeystore(directory)
-	if _, err := ks.GetKey(shardWithdrawalKeyFile, password); err == nil {
-		return fmt.Errorf("keystore at path already exists: %s", shardWithdrawalKeyFile)
-	}
-	if _, err := ks.GetKey(validatorKeyFile, password); err == nil {
-		return fmt.Errorf("keystore at path already exists: %s", validatorKeyFile)
-	}
-	return nil
+const (
+	// legacyValidatorKeyFile is the fixed name of the validator key of keystores
+	// created before a keystore could hold several accounts.
+	legacyValidatorKeyFile = "validatorprivatekey"
+	// legacyShardWithdrawalKeyFile is the fixed name of the shard withdrawal key of
+	// keystores created before a keystore could hold several accounts.
+	legacyShardWithdrawalKeyFile = "shardwithdrawalkey"
+	// shardWithdrawalKeyDir is the subdirectory of a keystore the shard withdrawal
+	// keys of its accounts are stored in, so they are not mistaken for validator keys.
+	shardWithdrawalKeyDir = "shardwithdrawal"
+)
+
+// The scrypt parameters keys are encrypted with.
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
+// Account is a validator key stored in a keystore directory.
+type Account struct {
+	// Path of the file the key is stored in.
+	Path string
+	// PublicKey of the validator, as registered in its deposit.
+	PublicKey []byte
+	key       *keystore.Key
+}
+
+// SecretKey returns the secret key the validator of the account signs with.
+func (a *Account) SecretKey() *bls.SecretKey {
+	return a.key.SecretKey
 }
 
 // NewValidatorAccount sets up a validator client's secrets and generates the necessary deposit data
 // parameters needed to deposit into the deposit contract on the ETH1.0 chain. Specifically, this
 // generates a BLS private and public key, and then logs the serialized deposit input hex string
-// to be used in an ETH1.0 transaction by the validator.
+// to be used in an ETH1.0 transaction by the validator. A keystore directory can hold several
+// accounts, which are all encrypted with the same password.
 func NewValidatorAccount(directory string, password string) error {
-	// First, if the keystore already exists, throws an error as there can only be
-	// one keystore per validator client.
-	if err := VerifyAccountNotExists(directory, password); err != nil {
-		return fmt.Errorf("validator account exists: %v", err)
-	}
-	shardWithdrawalKeyFile := directory + "/shardwithdrawalkey"
-	validatorKeyFile := directory + "/validatorprivatekey"
-	ks := keystore.NewKeystore(directory)
-	// If the keystore does not exists at the path, we create a new one for the validator.
+	// The keys of the accounts already in the keystore have to be unlocked by the password,
+	// so that the validator client can load every account of the keystore.
+	if _, err := Accounts(directory, password); err != nil {
+		return err
+	}
 	shardWithdrawalKey, err := keystore.NewKey(rand.Reader)
 	if err != nil {
 		return err
 	}
-	if err := ks.StoreKey(shardWithdrawalKeyFile, shardWithdrawalKey, password); err != nil {
+	validatorKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	return storeAccount(directory, password, validatorKey, shardWithdrawalKey)
+}
+
+// ReadMnemonicFile reads the mnemonic stored in the file, which must only be accessible
+// by its owner as the mnemonic backs up every key derived from it.
+func ReadMnemonicFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read mnemonic file: %v", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return "", fmt.Errorf("mnemonic file %s must only be accessible by its owner, its permissions are %v", path, info.Mode().Perm())
+	}
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read mnemonic file: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// RecoverAccounts derives the keys of the first given number of validator accounts of
+// the mnemonic, and stores the accounts which are not in the keystore directory yet.
+func RecoverAccounts(directory string, password string, mnemonic string, numAccounts uint32) error {
+	if numAccounts == 0 {
+		return errors.New("expected at least one account to be derived")
+	}
+	accounts, err := Accounts(directory, password)
+	if err != nil {
+		return err
+	}
+	seed, err := keystore.MnemonicToSeed(mnemonic, "")
+	if err != nil {
+		return fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	for i := uint32(0); i < numAccounts; i++ {
+		validatorKey, err := keystore.NewKeyFromSeed(seed, keystore.SigningKeyPath(i))
+		if err != nil {
+			return fmt.Errorf("could not derive validator key: %v", err)
+		}
+		if account := findAccount(accounts, publicKey(validatorKey)); account != nil {
+			log.WithField("path", account.Path).Infof("Account %d is already in the keystore", i)
+			continue
+		}
+		shardWithdrawalKey, err := keystore.NewKeyFromSeed(seed, keystore.WithdrawalKeyPath(i))
+		if err != nil {
+			return fmt.Errorf("could not derive shard withdrawal key: %v", err)
+		}
+		if err := storeAccount(directory, password, validatorKey, shardWithdrawalKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeAccount stores the keys of a new account in the keystore directory, and logs
+// the deposit data activating its validator.
+func storeAccount(directory string, password string, validatorKey *keystore.Key, shardWithdrawalKey *keystore.Key) error {
+	withdrawalKs := newKeystore(filepath.Join(directory, shardWithdrawalKeyDir))
+	shardWithdrawalKeyFile := withdrawalKs.KeyFilePath(shardWithdrawalKey)
+	if err := withdrawalKs.StoreKey(shardWithdrawalKeyFile, shardWithdrawalKey, password); err != nil {
 		return fmt.Errorf("unable to store key %v", err)
 	}
 	log.WithField(
 		"path",
 		shardWithdrawalKeyFile,
 	).Info("Keystore generated for shard withdrawals at path")
-	validatorKey, err := keystore.NewKey(rand.Reader)
-	if err != nil {
-		return err
-	}
+	ks := newKeystore(directory)
+	validatorKeyFile := ks.KeyFilePath(validatorKey)
 	if err := ks.StoreKey(validatorKeyFile, validatorKey, password); err != nil {
 		return fmt.Errorf("unable to store key %v", err)
 	}
@@ -72,7 +149,7 @@ func NewValidatorAccount(directory string, password string) error {
 	).Info("Keystore generated for validator signatures at path")
 
 	data := &pb.DepositInput{
-		Pubkey:                      validatorKey.SecretKey.K.Bytes(), // TODO(#1367): Use real BLS public key here.
+		Pubkey:                      publicKey(validatorKey),
 		ProofOfPossession:           []byte("pop"),
 		WithdrawalCredentialsHash32: []byte("withdraw"),
 	}
@@ -85,3 +162,198 @@ func NewValidatorAccount(directory string, password string) error {
 	log.Infof("%#x", serializedData)
 	return nil
 }
+
+// Accounts decrypts the validator keys stored in the keystore directory with the
+// password, and returns their accounts in lexical order of their path.
+func Accounts(directory string, password string) ([]*Account, error) {
+	if directory == "" || password == "" {
+		return nil, errors.New("expected a path to the validator keystore and password to be provided, received nil")
+	}
+	ks := newKeystore(directory)
+	paths, err := ks.KeyFiles()
+	if err != nil {
+		return nil, fmt.Errorf("could not list keystore %s: %v", directory, err)
+	}
+	if legacy := ks.JoinPath(legacyValidatorKeyFile); fileExists(legacy) {
+		paths = append([]string{legacy}, paths...)
+	}
+	accounts := make([]*Account, len(paths))
+	for i, path := range paths {
+		key, err := ks.GetKey(path, password)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt keystore %s: %v", path, err)
+		}
+		accounts[i] = &Account{
+			Path:      path,
+			PublicKey: publicKey(key),
+			key:       key,
+		}
+	}
+	return accounts, nil
+}
+
+// SelectAccount returns the account of the keystore directory whose key is stored at the
+// given path, either as listed by Accounts or relative to the keystore directory. If no
+// path is given, the keystore must hold a single account.
+func SelectAccount(directory string, password string, path string) (*Account, error) {
+	accounts, err := Accounts(directory, password)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		if len(accounts) != 1 {
+			return nil, fmt.Errorf("expected a key file path to select one of the %d accounts in %s", len(accounts), directory)
+		}
+		return accounts[0], nil
+	}
+	ks := newKeystore(directory)
+	for _, account := range accounts {
+		accountPath := filepath.Clean(account.Path)
+		if accountPath == filepath.Clean(path) || accountPath == filepath.Clean(ks.JoinPath(path)) {
+			return account, nil
+		}
+	}
+	return nil, fmt.Errorf("no account with key file %s in %s", path, directory)
+}
+
+// ImportKey stores a validator key in the keystore directory, encrypted with the keystore
+// password. The key data is either a hex encoded secret key, or an encrypted key file
+// unlocked by the key password.
+func ImportKey(directory string, password string, keyData []byte, keyPassword string) (*Account, error) {
+	accounts, err := Accounts(directory, password)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parseKey(keyData, keyPassword)
+	if err != nil {
+		return nil, err
+	}
+	if account := findAccount(accounts, publicKey(key)); account != nil {
+		return nil, fmt.Errorf("account with the same key already exists at %s", account.Path)
+	}
+	ks := newKeystore(directory)
+	path := ks.KeyFilePath(key)
+	if err := ks.StoreKey(path, key, password); err != nil {
+		return nil, fmt.Errorf("unable to store key %v", err)
+	}
+	return &Account{
+		Path:      path,
+		PublicKey: publicKey(key),
+		key:       key,
+	}, nil
+}
+
+// ExportKey returns the secret key of the account, either hex encoded or as a key
+// file encrypted with the key password.
+func ExportKey(account *Account, keyPassword string, raw bool) ([]byte, error) {
+	if raw {
+		return []byte(hex.EncodeToString(account.key.SecretKey.BufferedSecretKey())), nil
+	}
+	if keyPassword == "" {
+		return nil, errors.New("expected a password to encrypt the exported key, received nil")
+	}
+	return keystore.EncryptKey(account.key, keyPassword, scryptN, scryptP)
+}
+
+// ChangePassword encrypts every validator and shard withdrawal key of the keystore
+// directory again with the new password and the given scrypt parameters, as the keys
+// of a keystore all share the same password. The keys are all encrypted to temporary
+// files before any key file is replaced, so that the keystore is left unchanged if a
+// key can not be decrypted or encrypted.
+func ChangePassword(directory string, password string, newPassword string, newScryptN int, newScryptP int) error {
+	if newPassword == "" {
+		return errors.New("expected a new password to be provided, received nil")
+	}
+	accounts, err := Accounts(directory, password)
+	if err != nil {
+		return err
+	}
+	withdrawalKs := newKeystore(filepath.Join(directory, shardWithdrawalKeyDir))
+	paths, err := withdrawalKs.KeyFiles()
+	if err != nil {
+		return fmt.Errorf("could not list shard withdrawal keys: %v", err)
+	}
+	if legacy := filepath.Join(directory, legacyShardWithdrawalKeyFile); fileExists(legacy) {
+		paths = append(paths, legacy)
+	}
+	var keys []*keystore.Key
+	for _, path := range paths {
+		key, err := withdrawalKs.GetKey(path, password)
+		if err != nil {
+			return fmt.Errorf("could not decrypt keystore %s: %v", path, err)
+		}
+		keys = append(keys, key)
+	}
+	for _, account := range accounts {
+		paths = append(paths, account.Path)
+		keys = append(keys, account.key)
+	}
+
+	ks := keystore.NewKeystoreWithScrypt(directory, newScryptN, newScryptP)
+	tmpPaths := make([]string, 0, len(paths))
+	for i, path := range paths {
+		tmp, err := ks.StoreTempKey(path, keys[i], newPassword)
+		if err != nil {
+			removeFiles(tmpPaths)
+			return fmt.Errorf("could not encrypt %s with the new password: %v", path, err)
+		}
+		tmpPaths = append(tmpPaths, tmp)
+	}
+	for i, tmp := range tmpPaths {
+		if err := os.Rename(tmp, paths[i]); err != nil {
+			removeFiles(tmpPaths[i:])
+			return fmt.Errorf("could not replace %s, the keys before it are encrypted with the new password: %v", paths[i], err)
+		}
+		log.WithField("path", paths[i]).Info("Changed keystore password")
+	}
+	return nil
+}
+
+// parseKey decodes a hex encoded secret key, or decrypts an encrypted key file.
+func parseKey(keyData []byte, keyPassword string) (*keystore.Key, error) {
+	keyData = bytes.TrimSpace(keyData)
+	if bytes.HasPrefix(keyData, []byte("{")) {
+		key, err := keystore.DecryptKey(keyData, keyPassword)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt key file: %v", err)
+		}
+		return key, nil
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(string(keyData), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode hex secret key: %v", err)
+	}
+	return keystore.NewKeyFromSecret(secret)
+}
+
+func findAccount(accounts []*Account, pubKey []byte) *Account {
+	for _, account := range accounts {
+		if bytes.Equal(account.PublicKey, pubKey) {
+			return account
+		}
+	}
+	return nil
+}
+
+// publicKey returns the public key of the validator key, as used in its deposit.
+func publicKey(key *keystore.Key) []byte {
+	return key.SecretKey.K.Bytes() // TODO(#1367): Use real BLS public key here.
+}
+
+func newKeystore(directory string) keystore.Store {
+	return keystore.NewKeystoreWithScrypt(directory, scryptN, scryptP)
+}
+
+// removeFiles removes the files, logging the ones which could not be removed.
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil {
+			log.WithField("path", path).Errorf("Could not remove file: %v", err)
+		}
+	}
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -3,8 +3,12 @@ package accounts
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -14,18 +18,37 @@ import (
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
-func TestNewValidatorAccount_AccountExists(t *testing.T) {
+func init() {
+	scryptN = keystore.LightScryptN
+	scryptP = keystore.LightScryptP
+}
+
+func TestNewValidatorAccount_SeveralAccounts(t *testing.T) {
 	directory := "/tmp/testkeystore"
-	validatorKey, err := keystore.NewKey(rand.Reader)
+	if err := NewValidatorAccount(directory, ""); err == nil {
+		t.Error("Expected new validator account without password to throw error, received nil")
+	}
+	for i := 0; i < 2; i++ {
+		if err := NewValidatorAccount(directory, "1234"); err != nil {
+			t.Fatalf("Expected new account to be created: %v", err)
+		}
+	}
+	if err := NewValidatorAccount(directory, "5678"); err == nil {
+		t.Error("Expected new validator account with another password to throw error, received nil")
+	}
+	accounts, err := Accounts(directory, "1234")
 	if err != nil {
-		t.Fatalf("Cannot create new key: %v", err)
+		t.Fatalf("Could not list accounts: %v", err)
 	}
-	ks := keystore.NewKeystore(directory)
-	if err := ks.StoreKey(directory+"/validatorprivatekey", validatorKey, ""); err != nil {
-		t.Fatalf("Unable to store key %v", err)
+	if len(accounts) != 2 {
+		t.Errorf("Expected 2 accounts, received %d", len(accounts))
 	}
-	if err := NewValidatorAccount(directory, ""); err == nil {
-		t.Error("Expected new validator account to throw error, received nil")
+	withdrawalKeys, err := newKeystore(filepath.Join(directory, shardWithdrawalKeyDir)).KeyFiles()
+	if err != nil {
+		t.Fatalf("Could not list shard withdrawal keys: %v", err)
+	}
+	if len(withdrawalKeys) != 2 {
+		t.Errorf("Expected 2 shard withdrawal keys, received %d", len(withdrawalKeys))
 	}
 	if err := os.RemoveAll(directory); err != nil {
 		t.Fatalf("Could not remove directory: %v", err)
@@ -38,13 +61,12 @@ func TestNewValidatorAccount_PrintsDepositData(t *testing.T) {
 	if err := NewValidatorAccount(directory, "1234"); err != nil {
 		t.Errorf("Expected new account to be created: %v", err)
 	}
-	ks := keystore.NewKeystore(directory)
-	valKey, err := ks.GetKey(directory+"/validatorprivatekey", "1234")
+	account, err := SelectAccount(directory, "1234", "")
 	if err != nil {
-		t.Fatalf("Could not retrieve key: %v", err)
+		t.Fatalf("Could not retrieve account: %v", err)
 	}
 	data := &pb.DepositInput{
-		Pubkey:                      valKey.SecretKey.K.Bytes(), // TODO(#1367): Use real BLS public key here.
+		Pubkey:                      account.key.SecretKey.K.Bytes(), // TODO(#1367): Use real BLS public key here.
 		ProofOfPossession:           []byte("pop"),
 		WithdrawalCredentialsHash32: []byte("withdraw"),
 	}
@@ -58,3 +80,255 @@ func TestNewValidatorAccount_PrintsDepositData(t *testing.T) {
 		t.Fatalf("Could not remove directory: %v", err)
 	}
 }
+
+func TestReadMnemonicFile_RequiresOwnerOnlyPermissions(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	file, err := ioutil.TempFile("", "mnemonic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(mnemonic + "\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(file.Name(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadMnemonicFile(file.Name()); err == nil {
+		t.Error("Expected error when reading a mnemonic file readable by other users")
+	}
+	if err := os.Chmod(file.Name(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadMnemonicFile(file.Name())
+	if err != nil {
+		t.Fatalf("Could not read mnemonic file: %v", err)
+	}
+	if read != mnemonic {
+		t.Errorf("Expected mnemonic %q, received %q", mnemonic, read)
+	}
+}
+
+func TestRecoverAccounts_DerivesMnemonicAccounts(t *testing.T) {
+	directory := "/tmp/testkeystore"
+	otherDirectory := "/tmp/testkeystore2"
+	mnemonic, err := keystore.NewMnemonic(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not generate mnemonic: %v", err)
+	}
+	if err := RecoverAccounts(directory, "1234", mnemonic, 2); err != nil {
+		t.Fatalf("Could not create mnemonic accounts: %v", err)
+	}
+	if err := RecoverAccounts(otherDirectory, "5678", "abandon "+mnemonic, 2); err == nil {
+		t.Error("Expected error when recovering accounts from an invalid mnemonic")
+	}
+	if err := RecoverAccounts(otherDirectory, "5678", mnemonic, 0); err == nil {
+		t.Error("Expected error when recovering no accounts")
+	}
+	if err := RecoverAccounts(otherDirectory, "5678", mnemonic, 3); err != nil {
+		t.Fatalf("Could not recover accounts: %v", err)
+	}
+	// Accounts already in the keystore are not stored twice.
+	if err := RecoverAccounts(directory, "1234", mnemonic, 3); err != nil {
+		t.Fatalf("Could not recover accounts: %v", err)
+	}
+
+	accounts, err := Accounts(directory, "1234")
+	if err != nil {
+		t.Fatalf("Could not list accounts: %v", err)
+	}
+	recovered, err := Accounts(otherDirectory, "5678")
+	if err != nil {
+		t.Fatalf("Could not list recovered accounts: %v", err)
+	}
+	if len(accounts) != 3 || len(recovered) != 3 {
+		t.Fatalf("Expected 3 accounts in both keystores, received %d and %d", len(accounts), len(recovered))
+	}
+	for i := uint32(0); i < 3; i++ {
+		seed, err := keystore.MnemonicToSeed(mnemonic, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := keystore.NewKeyFromSeed(seed, keystore.SigningKeyPath(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if findAccount(accounts, publicKey(key)) == nil || findAccount(recovered, publicKey(key)) == nil {
+			t.Errorf("Expected account %d to be in both keystores", i)
+		}
+	}
+	withdrawalKeys, err := newKeystore(filepath.Join(directory, shardWithdrawalKeyDir)).KeyFiles()
+	if err != nil {
+		t.Fatalf("Could not list shard withdrawal keys: %v", err)
+	}
+	if len(withdrawalKeys) != 3 {
+		t.Errorf("Expected 3 shard withdrawal keys, received %d", len(withdrawalKeys))
+	}
+
+	for _, dir := range []string{directory, otherDirectory} {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatalf("Could not remove directory: %v", err)
+		}
+	}
+}
+
+func TestAccounts_IncludesLegacyKey(t *testing.T) {
+	directory := "/tmp/testkeystore"
+	legacyKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Cannot create new key: %v", err)
+	}
+	ks := newKeystore(directory)
+	if err := ks.StoreKey(directory+"/"+legacyValidatorKeyFile, legacyKey, "1234"); err != nil {
+		t.Fatalf("Unable to store key %v", err)
+	}
+	if err := NewValidatorAccount(directory, "1234"); err != nil {
+		t.Fatalf("Expected new account to be created: %v", err)
+	}
+
+	accounts, err := Accounts(directory, "1234")
+	if err != nil {
+		t.Fatalf("Could not list accounts: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("Expected 2 accounts, received %d", len(accounts))
+	}
+	if !bytes.Equal(accounts[0].PublicKey, legacyKey.SecretKey.K.Bytes()) {
+		t.Errorf("Expected legacy account first, received %#x", accounts[0].PublicKey)
+	}
+	if _, err := SelectAccount(directory, "1234", ""); err == nil {
+		t.Error("Expected error when selecting an account without key file path among several")
+	}
+	account, err := SelectAccount(directory, "1234", legacyValidatorKeyFile)
+	if err != nil {
+		t.Fatalf("Could not select account: %v", err)
+	}
+	if !bytes.Equal(account.PublicKey, legacyKey.SecretKey.K.Bytes()) {
+		t.Errorf("Expected legacy account, received %#x", account.PublicKey)
+	}
+	account, err = SelectAccount(directory, "1234", accounts[1].Path)
+	if err != nil {
+		t.Fatalf("Could not select account: %v", err)
+	}
+	if account.Path != accounts[1].Path {
+		t.Errorf("Expected account at %s, received %s", accounts[1].Path, account.Path)
+	}
+	if _, err := SelectAccount(directory, "1234", "missing"); err == nil {
+		t.Error("Expected error when selecting an account with an unknown key file path")
+	}
+	if err := os.RemoveAll(directory); err != nil {
+		t.Fatalf("Could not remove directory: %v", err)
+	}
+}
+
+func TestImportExportKey(t *testing.T) {
+	directory := "/tmp/testkeystore"
+	secret := []byte{1, 2, 3, 4}
+
+	account, err := ImportKey(directory, "1234", []byte("0x"+hex.EncodeToString(secret)+"\n"), "")
+	if err != nil {
+		t.Fatalf("Could not import hex key: %v", err)
+	}
+	if !bytes.Equal(account.PublicKey, secret) {
+		t.Errorf("Expected public key %#x, received %#x", secret, account.PublicKey)
+	}
+	if _, err := ImportKey(directory, "1234", []byte(hex.EncodeToString(secret)), ""); err == nil {
+		t.Error("Expected error when importing an existing key")
+	}
+
+	raw, err := ExportKey(account, "", true)
+	if err != nil {
+		t.Fatalf("Could not export raw key: %v", err)
+	}
+	if string(raw) != hex.EncodeToString(secret) {
+		t.Errorf("Expected raw key %x, received %s", secret, raw)
+	}
+	keyJSON, err := ExportKey(account, "5678", false)
+	if err != nil {
+		t.Fatalf("Could not export key file: %v", err)
+	}
+
+	otherDirectory := "/tmp/testkeystore2"
+	if _, err := ImportKey(otherDirectory, "1234", keyJSON, "wrong"); err == nil {
+		t.Error("Expected error when importing a key file with the wrong password")
+	}
+	imported, err := ImportKey(otherDirectory, "1234", keyJSON, "5678")
+	if err != nil {
+		t.Fatalf("Could not import key file: %v", err)
+	}
+	if !bytes.Equal(imported.PublicKey, secret) {
+		t.Errorf("Expected public key %#x, received %#x", secret, imported.PublicKey)
+	}
+	if _, err := SelectAccount(otherDirectory, "1234", imported.Path); err != nil {
+		t.Errorf("Could not select imported account: %v", err)
+	}
+
+	for _, dir := range []string{directory, otherDirectory} {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatalf("Could not remove directory: %v", err)
+		}
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	directory := "/tmp/testkeystore"
+	if err := NewValidatorAccount(directory, "1234"); err != nil {
+		t.Fatalf("Expected new account to be created: %v", err)
+	}
+	if err := ChangePassword(directory, "wrong", "5678", scryptN, scryptP); err == nil {
+		t.Error("Expected error when changing password with the wrong password")
+	}
+	if err := ChangePassword(directory, "1234", "5678", scryptN, scryptP); err != nil {
+		t.Fatalf("Could not change password: %v", err)
+	}
+
+	if _, err := Accounts(directory, "1234"); err == nil {
+		t.Error("Expected error when decrypting accounts with the old password")
+	}
+	if _, err := SelectAccount(directory, "5678", ""); err != nil {
+		t.Errorf("Could not decrypt account with the new password: %v", err)
+	}
+	withdrawalKs := newKeystore(filepath.Join(directory, shardWithdrawalKeyDir))
+	withdrawalKeys, err := withdrawalKs.KeyFiles()
+	if err != nil || len(withdrawalKeys) != 1 {
+		t.Fatalf("Expected 1 shard withdrawal key, received %v: %v", withdrawalKeys, err)
+	}
+	if _, err := withdrawalKs.GetKey(withdrawalKeys[0], "5678"); err != nil {
+		t.Errorf("Could not decrypt shard withdrawal key with the new password: %v", err)
+	}
+	if err := os.RemoveAll(directory); err != nil {
+		t.Fatalf("Could not remove directory: %v", err)
+	}
+}
+
+func TestChangePassword_LeavesKeystoreUnchangedOnFailure(t *testing.T) {
+	directory := "/tmp/testkeystore"
+	if err := NewValidatorAccount(directory, "1234"); err != nil {
+		t.Fatalf("Expected new account to be created: %v", err)
+	}
+	// Scrypt rejects a cost parameter which is not a power of 2.
+	if err := ChangePassword(directory, "1234", "5678", 3, scryptP); err == nil {
+		t.Fatal("Expected error when encrypting keys with invalid scrypt parameters")
+	}
+	if _, err := SelectAccount(directory, "1234", ""); err != nil {
+		t.Errorf("Could not decrypt account with the old password: %v", err)
+	}
+	for _, dir := range []string{directory, filepath.Join(directory, shardWithdrawalKeyDir)} {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("Could not list %s: %v", dir, err)
+		}
+		for _, f := range files {
+			if strings.HasPrefix(f.Name(), ".") {
+				t.Errorf("Expected temporary key file %s to be removed", f.Name())
+			}
+		}
+	}
+	if err := os.RemoveAll(directory); err != nil {
+		t.Fatalf("Could not remove directory: %v", err)
+	}
+}
@@ -10,32 +10,41 @@ var _ = Validator(&fakeValidator{})
 
 type fakeValidator struct {
 	DoneCalled              bool
+	CheckChainConfigCalled  bool
 	WaitForActivationCalled bool
+	WaitForActivationRet    error
 	WaitForChainStartCalled bool
 	NextSlotRet             <-chan uint64
 	NextSlotCalled          bool
 	UpdateAssignmentsCalled bool
 	UpdateAssignmentsArg1   uint64
 	UpdateAssignmentsRet    error
-	RoleAtCalled            bool
-	RoleAtArg1              uint64
-	RoleAtRet               pb.ValidatorRole
+	RolesAtCalled           bool
+	RolesAtArg1             uint64
+	RolesAtRet              map[string]pb.ValidatorRole
 	AttestToBlockHeadCalled bool
 	AttestToBlockHeadArg1   uint64
+	AttestToBlockHeadArg2   []byte
 	ProposeBlockCalled      bool
 	ProposeBlockArg1        uint64
+	ProposeBlockArg2        []byte
 }
 
 func (fv *fakeValidator) Done() {
 	fv.DoneCalled = true
 }
 
+func (fv *fakeValidator) CheckChainConfig(_ context.Context) {
+	fv.CheckChainConfigCalled = true
+}
+
 func (fv *fakeValidator) WaitForChainStart(_ context.Context) {
 	fv.WaitForChainStartCalled = true
 }
 
-func (fv *fakeValidator) WaitForActivation(_ context.Context) {
+func (fv *fakeValidator) WaitForActivation(_ context.Context) error {
 	fv.WaitForActivationCalled = true
+	return fv.WaitForActivationRet
 }
 
 func (fv *fakeValidator) NextSlot() <-chan uint64 {
@@ -49,18 +58,20 @@ func (fv *fakeValidator) UpdateAssignments(_ context.Context, slot uint64) error
 	return fv.UpdateAssignmentsRet
 }
 
-func (fv *fakeValidator) RoleAt(slot uint64) pb.ValidatorRole {
-	fv.RoleAtCalled = true
-	fv.RoleAtArg1 = slot
-	return fv.RoleAtRet
+func (fv *fakeValidator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	fv.RolesAtCalled = true
+	fv.RolesAtArg1 = slot
+	return fv.RolesAtRet
 }
 
-func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64) {
+func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64, pubKey []byte) {
 	fv.AttestToBlockHeadCalled = true
 	fv.AttestToBlockHeadArg1 = slot
+	fv.AttestToBlockHeadArg2 = pubKey
 }
 
-func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64) {
+func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64, pubKey []byte) {
 	fv.ProposeBlockCalled = true
 	fv.ProposeBlockArg1 = slot
+	fv.ProposeBlockArg2 = pubKey
 }
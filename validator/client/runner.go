@@ -11,29 +11,35 @@ import (
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
+	CheckChainConfig(ctx context.Context)
 	WaitForChainStart(ctx context.Context)
-	WaitForActivation(ctx context.Context)
+	WaitForActivation(ctx context.Context) error
 	NextSlot() <-chan uint64
 	UpdateAssignments(ctx context.Context, slot uint64) error
-	RoleAt(slot uint64) pb.ValidatorRole
-	AttestToBlockHead(ctx context.Context, slot uint64)
-	ProposeBlock(ctx context.Context, slot uint64)
+	RolesAt(slot uint64) map[string]pb.ValidatorRole
+	AttestToBlockHead(ctx context.Context, slot uint64, pubKey []byte)
+	ProposeBlock(ctx context.Context, slot uint64, pubKey []byte)
 }
 
 // Run the main validator routine. This routine exits if the context is
 // cancelled.
 //
 // Order of operations:
-// 1 - Initialize validator data
-// 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 1 - Check the chain config matches the beacon node's
+// 2 - Initialize validator data
+// 3 - Wait for validator activation
+// 4 - Wait for the next slot start
+// 5 - Update assignments
+// 6 - Determine the role of each key at current slot
+// 7 - Perform assigned roles, if any
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
+	v.CheckChainConfig(ctx)
 	v.WaitForChainStart(ctx)
-	v.WaitForActivation(ctx)
+	if err := v.WaitForActivation(ctx); err != nil {
+		log.WithField("error", err).Error("Validator was not activated, stopping validator")
+		return
+	}
 	span, ctx := opentracing.StartSpanFromContext(ctx, "processSlot")
 	defer span.Finish()
 	for {
@@ -46,21 +52,21 @@ func run(ctx context.Context, v Validator) {
 				log.WithField("error", err).Error("Failed to update assignments")
 				continue
 			}
-			role := v.RoleAt(slot)
-
-			switch role {
-			case pb.ValidatorRole_ATTESTER:
-				v.AttestToBlockHead(ctx, slot)
-			case pb.ValidatorRole_PROPOSER:
-				v.ProposeBlock(ctx, slot)
-			case pb.ValidatorRole_UNKNOWN:
-				// This shouldn't happen normally, so it is considered a warning.
-				log.WithFields(logrus.Fields{
-					"slot": slot,
-					"role": role,
-				}).Warn("Unknown role, doing nothing")
-			default:
-				// Do nothing :)
+			for pubKey, role := range v.RolesAt(slot) {
+				switch role {
+				case pb.ValidatorRole_ATTESTER:
+					v.AttestToBlockHead(ctx, slot, []byte(pubKey))
+				case pb.ValidatorRole_PROPOSER:
+					v.ProposeBlock(ctx, slot, []byte(pubKey))
+				case pb.ValidatorRole_UNKNOWN:
+					// This shouldn't happen normally, so it is considered a warning.
+					log.WithFields(logrus.Fields{
+						"slot": slot,
+						"role": role,
+					}).Warn("Unknown role, doing nothing")
+				default:
+					// Do nothing :)
+				}
 			}
 		}
 	}
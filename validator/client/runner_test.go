@@ -24,6 +24,14 @@ func TestRunCleansUpValidator(t *testing.T) {
 	}
 }
 
+func TestRunChecksChainConfig(t *testing.T) {
+	v := &fakeValidator{}
+	run(cancelledContext(), v)
+	if !v.CheckChainConfigCalled {
+		t.Error("Expected CheckChainConfig() to be called")
+	}
+}
+
 func TestRunWaitsForChainStart(t *testing.T) {
 	v := &fakeValidator{}
 	run(cancelledContext(), v)
@@ -40,6 +48,17 @@ func TestRunWaitsForActivation(t *testing.T) {
 	}
 }
 
+func TestRunStopsIfNotActivated(t *testing.T) {
+	v := &fakeValidator{WaitForActivationRet: errors.New("exited")}
+	run(context.Background(), v)
+	if v.NextSlotCalled {
+		t.Error("Expected the validator to stop before waiting for the next slot")
+	}
+	if !v.DoneCalled {
+		t.Error("Expected Done() to be called")
+	}
+}
+
 func TestRunOnNextSlotUpdatesAssignments(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -98,11 +117,11 @@ func TestRunOnNextSlotDeterminesRole(t *testing.T) {
 
 	run(ctx, v)
 
-	if !v.RoleAtCalled {
-		t.Fatalf("Expected RoleAt(%d) to be called", slot)
+	if !v.RolesAtCalled {
+		t.Fatalf("Expected RolesAt(%d) to be called", slot)
 	}
-	if v.RoleAtArg1 != slot {
-		t.Errorf("RoleAt called with the wrong arg. Want=%d, got=%d", slot, v.RoleAtArg1)
+	if v.RolesAtArg1 != slot {
+		t.Errorf("RolesAt called with the wrong arg. Want=%d, got=%d", slot, v.RolesAtArg1)
 	}
 }
 
@@ -113,7 +132,7 @@ func TestRunOnNextSlotActsAsAttester(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_ATTESTER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"attester": pb.ValidatorRole_ATTESTER}
 	go func() {
 		ticker <- slot
 
@@ -128,6 +147,9 @@ func TestRunOnNextSlotActsAsAttester(t *testing.T) {
 	if v.AttestToBlockHeadArg1 != slot {
 		t.Errorf("AttestToBlockHead was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if string(v.AttestToBlockHeadArg2) != "attester" {
+		t.Errorf("AttestToBlockHead was called with wrong public key. Want=attester, got=%s", v.AttestToBlockHeadArg2)
+	}
 }
 
 func TestRunOnNextSlotActsAsProposer(t *testing.T) {
@@ -137,7 +159,7 @@ func TestRunOnNextSlotActsAsProposer(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_PROPOSER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"proposer": pb.ValidatorRole_PROPOSER}
 	go func() {
 		ticker <- slot
 
@@ -152,4 +174,7 @@ func TestRunOnNextSlotActsAsProposer(t *testing.T) {
 	if v.ProposeBlockArg1 != slot {
 		t.Errorf("ProposeBlock was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if string(v.ProposeBlockArg2) != "proposer" {
+		t.Errorf("ProposeBlock was called with wrong public key. Want=proposer, got=%s", v.ProposeBlockArg2)
+	}
 }
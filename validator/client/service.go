@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"github.com/sirupsen/logrus"
 )
 
@@ -22,12 +23,16 @@ type ValidatorService struct {
 	conn      *grpc.ClientConn
 	endpoint  string
 	withCert  string
+	keystore  string
+	password  string
 }
 
 // Config for the validator service.
 type Config struct {
-	Endpoint string
-	CertFlag string
+	Endpoint     string
+	CertFlag     string
+	KeystorePath string
+	Password     string
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -39,23 +44,18 @@ func NewValidatorService(ctx context.Context, cfg *Config) *ValidatorService {
 		cancel:   cancel,
 		endpoint: cfg.Endpoint,
 		withCert: cfg.CertFlag,
+		keystore: cfg.KeystorePath,
+		password: cfg.Password,
 	}
 }
 
 // Start the validator service. Launches the main go routine for the validator
-// client.
+// client, performing the duties of every account of the keystore.
 func (v *ValidatorService) Start() {
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
-		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
-			return
-		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	dialOpt, err := dialOption(v.withCert)
+	if err != nil {
+		log.Errorf("Could not get valid credentials: %v", err)
+		return
 	}
 	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt)
 	if err != nil {
@@ -64,14 +64,43 @@ func (v *ValidatorService) Start() {
 	}
 	log.Info("Successfully started gRPC connection")
 	v.conn = conn
+	validatorAccounts, err := accounts.Accounts(v.keystore, v.password)
+	if err != nil {
+		log.Errorf("Could not load validator accounts: %v", err)
+		return
+	}
+	if len(validatorAccounts) == 0 {
+		log.Errorf("No validator account found in keystore %s", v.keystore)
+		return
+	}
+	pubKeys := make([][]byte, len(validatorAccounts))
+	for i, account := range validatorAccounts {
+		pubKeys[i] = account.PublicKey
+	}
 	v.validator = &validator{
 		beaconClient:    pb.NewBeaconServiceClient(v.conn),
 		validatorClient: pb.NewValidatorServiceClient(v.conn),
 		attesterClient:  pb.NewAttesterServiceClient(v.conn),
+		proposerClient:  pb.NewProposerServiceClient(v.conn),
+		pubKeys:         pubKeys,
 	}
 	go run(v.ctx, v.validator)
 }
 
+// dialOption returns the transport credentials to dial a beacon node with, using
+// the given TLS certificate if any.
+func dialOption(withCert string) (grpc.DialOption, error) {
+	if withCert == "" {
+		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+		return grpc.WithInsecure(), nil
+	}
+	creds, err := credentials.NewClientTLSFromFile(withCert, "")
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(creds), nil
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
@@ -1,12 +1,22 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
 	"strings"
 	"testing"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
 
 	"github.com/prysmaticlabs/prysm/shared"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -81,3 +91,72 @@ func TestStatus(t *testing.T) {
 		t.Errorf("Expected status check to fail if no connection is found, received: %v", err)
 	}
 }
+
+type startedBeaconServer struct {
+	pb.BeaconServiceServer
+}
+
+func (s *startedBeaconServer) WaitForChainStart(_ *ptypes.Empty, stream pb.BeaconService_WaitForChainStartServer) error {
+	return stream.Send(&pb.ChainStartResponse{Started: true, GenesisTime: uint64(time.Now().Unix())})
+}
+
+func (s *startedBeaconServer) ChainConfig(_ context.Context, _ *ptypes.Empty) (*pb.ChainConfigResponse, error) {
+	return nil, errors.New("no chain config")
+}
+
+type statusValidatorServer struct {
+	pb.ValidatorServiceServer
+	requests chan *pb.ValidatorStatusRequest
+}
+
+func (s *statusValidatorServer) ValidatorStatus(req *pb.ValidatorStatusRequest, stream pb.ValidatorService_ValidatorStatusServer) error {
+	s.requests <- req
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestStart_WaitsForActivationOfKeystoreKeys(t *testing.T) {
+	directory, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeystoreWithScrypt(directory, keystore.LightScryptN, keystore.LightScryptP)
+	if err := ks.StoreKey(ks.KeyFilePath(key), key, "password"); err != nil {
+		t.Fatalf("Could not store key: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	validatorServer := &statusValidatorServer{requests: make(chan *pb.ValidatorStatusRequest, 1)}
+	pb.RegisterBeaconServiceServer(server, &startedBeaconServer{})
+	pb.RegisterValidatorServiceServer(server, validatorServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	validatorService := NewValidatorService(context.Background(), &Config{
+		Endpoint:     lis.Addr().String(),
+		KeystorePath: directory,
+		Password:     "password",
+	})
+	validatorService.Start()
+	defer validatorService.Stop()
+
+	select {
+	case req := <-validatorServer.requests:
+		// TODO(#1367): The public key of a validator is derived from its secret key
+		// until BLS keys are available.
+		if len(req.PublicKeys) != 1 || !bytes.Equal(req.PublicKeys[0], key.SecretKey.K.Bytes()) {
+			t.Errorf("Expected the status of the keystore key to be requested, received %v", req.PublicKeys)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Validator status was not requested within 5s")
+	}
+}
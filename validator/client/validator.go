@@ -3,38 +3,33 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
 
-	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
-// AttestationPool STUB interface. Final attestation pool pending design.
-// TODO(1323): Replace with actual attestation pool.
-type AttestationPool interface {
-	PendingAttestations() []*pbp2p.Attestation
-}
-
 // validator
 //
 // WIP - not done.
 type validator struct {
 	genesisTime     uint64
 	ticker          *slotutil.SlotTicker
-	assignment      *pb.Assignment
+	assignments     map[string]*pb.Assignment
 	proposerClient  pb.ProposerServiceClient
 	validatorClient pb.ValidatorServiceClient
 	beaconClient    pb.BeaconServiceClient
 	attesterClient  pb.AttesterServiceClient
-	pubKey          []byte
-	attestationPool AttestationPool
+	pubKeys         [][]byte
 }
 
 // Done cleans up the validator.
@@ -42,6 +37,42 @@ func (v *validator) Done() {
 	v.ticker.Done()
 }
 
+// CheckChainConfig compares the chain config of the validator client with the one of
+// the beacon node, logging every value which differs. The genesis time is left out as
+// it is agreed upon through the ChainStart log instead.
+func (v *validator) CheckChainConfig(ctx context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.CheckChainConfig")
+	defer span.Finish()
+	res, err := v.beaconClient.ChainConfig(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Could not fetch the beacon node chain config: %v", err)
+		return
+	}
+	mismatches := logConfigMismatches(params.ConfigEntries(params.BeaconConfig()), res.BeaconChainConfig)
+	mismatches += logConfigMismatches(params.ConfigEntries(params.ContractConfig()), res.DepositContractConfig)
+	if mismatches == 0 {
+		log.Info("Chain config matches the beacon node")
+	}
+}
+
+// logConfigMismatches logs every node config entry which differs from the local
+// config entries, returning the number of mismatches.
+func logConfigMismatches(local map[string]string, node []*pb.ChainConfigEntry) int {
+	var mismatches int
+	for _, entry := range node {
+		if entry.Key == "genesis_time" || local[entry.Key] == entry.Value {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"key":       entry.Key,
+			"validator": local[entry.Key],
+			"node":      entry.Value,
+		}).Error("Chain config of the validator client does not match the beacon node, use --chain-config-file to match it")
+		mismatches++
+	}
+	return mismatches
+}
+
 // WaitForChainStart checks whether the beacon node has started its runtime. That is,
 // it calls to the beacon node which then verifies the ETH1.0 deposit contract logs to check
 // for the ChainStart log to have been emitted. If so, it starts a ticker based on the ChainStart
@@ -80,17 +111,85 @@ func (v *validator) WaitForChainStart(ctx context.Context) {
 	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SlotDuration)
 }
 
-// WaitForActivation checks whether the validator pubkey is in the active
-// validator set. If not, this operation will block until an activation message is
-// received.
-//
-// WIP - not done.
-func (v *validator) WaitForActivation(ctx context.Context) {
+// WaitForActivation checks whether the validator pubkeys are in the active
+// validator set. If not, this operation will block until every key is activated or
+// can no longer be activated, logging the status of each validator and its estimated
+// activation epoch as the beacon node observes its deposit being processed. Keys which
+// can no longer be activated are left out of the validator duties. An error is
+// returned if the status can not be streamed, or if none of the keys were activated.
+func (v *validator) WaitForActivation(ctx context.Context) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.WaitForActivation")
 	defer span.Finish()
-	// First, check if the validator has deposited into the Deposit Contract.
-	// If the validator has deposited, subscribe to a stream receiving the activation status.
-	// of the validator until a final ACTIVATED check if received, then this function can return.
+	req := &pb.ValidatorStatusRequest{PublicKeys: v.pubKeys}
+	stream, err := v.validatorClient.ValidatorStatus(ctx, req)
+	if err != nil {
+		return fmt.Errorf("could not setup validator status streaming client: %v", err)
+	}
+	for {
+		res, err := stream.Recv()
+		// If the stream is closed, the validators were never activated.
+		if err == io.EOF {
+			return errors.New("validator status stream closed before the validators were activated")
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return fmt.Errorf("could not receive validator status from stream: %v", err)
+		}
+		var activeKeys [][]byte
+		pending := false
+		for _, info := range res.Validators {
+			active, err := logActivationStatus(res.Epoch, info)
+			if err != nil {
+				log.WithField("error", err).Warn("Validator will not perform any duty")
+				continue
+			}
+			if active {
+				activeKeys = append(activeKeys, info.PublicKey)
+			} else {
+				pending = true
+			}
+		}
+		if pending {
+			continue
+		}
+		if len(activeKeys) == 0 {
+			return errors.New("none of the validators can be activated")
+		}
+		v.pubKeys = activeKeys
+		return nil
+	}
+}
+
+// logActivationStatus logs the activation progress of a validator and returns true
+// once the validator is active. An error is returned if the validator exited or was
+// penalized, as it can then no longer be activated.
+func logActivationStatus(epoch uint64, info *pb.ValidatorInfo) (bool, error) {
+	fields := logrus.Fields{
+		"pubKey": fmt.Sprintf("%#x", info.PublicKey),
+		"status": info.Status,
+		"epoch":  epoch,
+	}
+	switch info.Status {
+	case pb.ValidatorStatus_UNKNOWN_STATUS:
+		log.WithFields(fields).Info("Waiting for the validator deposit to be seen by the beacon node...")
+	case pb.ValidatorStatus_DEPOSITED:
+		log.WithFields(fields).Info("Deposit seen, waiting for it to be included in the validator registry...")
+	case pb.ValidatorStatus_PENDING_ACTIVATION:
+		fields["index"] = info.Index
+		log.WithFields(fields).Infof("Waiting for validator activation, estimated to happen at epoch %d...",
+			info.EstimatedActivationEpoch)
+	case pb.ValidatorStatus_ACTIVE, pb.ValidatorStatus_EXITING:
+		fields["index"] = info.Index
+		fields["activationEpoch"] = info.ActivationEpoch
+		log.WithFields(fields).Info("Validator is active")
+		return true, nil
+	default:
+		return false, fmt.Errorf("validator %#x with index %d can no longer be activated, its status is %v since exit epoch %d",
+			info.PublicKey, info.Index, info.Status, info.ExitEpoch)
+	}
+	return false, nil
 }
 
 // NextSlot emits the next slot number at the start time of that slot.
@@ -98,8 +197,8 @@ func (v *validator) NextSlot() <-chan uint64 {
 	return v.ticker.C()
 }
 
-// UpdateAssignments checks the slot number to determine if the validator's
-// list of upcoming assignments needs to be updated. For example, at the
+// UpdateAssignments checks the slot number to determine if the assignments
+// of the validator keys need to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.UpdateAssignments")
@@ -112,29 +211,41 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 
 	req := &pb.ValidatorEpochAssignmentsRequest{
 		EpochStart: slot,
-		PublicKey:  v.pubKey,
+		PublicKeys: v.pubKeys,
 	}
 
 	resp, err := v.validatorClient.ValidatorEpochAssignments(ctx, req)
 	if err != nil {
 		return err
 	}
+	if len(resp.Assignments) != len(v.pubKeys) {
+		return fmt.Errorf("expected %d assignments, received %d", len(v.pubKeys), len(resp.Assignments))
+	}
 
-	v.assignment = resp.Assignment
+	v.assignments = make(map[string]*pb.Assignment, len(v.pubKeys))
+	for i, assignment := range resp.Assignments {
+		v.assignments[string(v.pubKeys[i])] = assignment
+	}
 	return nil
 }
 
-// RoleAt slot returns the validator role at the given slot. Returns nil if the
-// validator is known to not have a role at the at slot. Returns UNKNOWN if the
-// validator assignments are unknown. Otherwise returns a valid ValidatorRole.
-func (v *validator) RoleAt(slot uint64) pb.ValidatorRole {
-	if v.assignment == nil {
-		return pb.ValidatorRole_UNKNOWN
-	}
-	if v.assignment.AttesterSlot == slot {
-		return pb.ValidatorRole_ATTESTER
-	} else if v.assignment.ProposerSlot == slot {
-		return pb.ValidatorRole_PROPOSER
+// RolesAt slot returns the role of every validator key at the given slot, keyed by
+// the public key. A key's role is UNKNOWN if its assignment is unknown or if it has
+// no role at the slot. Otherwise it is a valid ValidatorRole.
+func (v *validator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	roles := make(map[string]pb.ValidatorRole, len(v.pubKeys))
+	for _, pubKey := range v.pubKeys {
+		assignment, ok := v.assignments[string(pubKey)]
+		switch {
+		case !ok:
+			roles[string(pubKey)] = pb.ValidatorRole_UNKNOWN
+		case assignment.AttesterSlot == slot:
+			roles[string(pubKey)] = pb.ValidatorRole_ATTESTER
+		case assignment.ProposerSlot == slot:
+			roles[string(pubKey)] = pb.ValidatorRole_PROPOSER
+		default:
+			roles[string(pubKey)] = pb.ValidatorRole_UNKNOWN
+		}
 	}
-	return pb.ValidatorRole_UNKNOWN
+	return roles
 }
@@ -12,11 +12,12 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 )
 
-// AttestToBlockHead completes the validator client's attester responsibility at a given slot.
+// AttestToBlockHead completes the attester responsibility of the validator with the given
+// public key at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
 // participation in voting on the block.
-func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
+func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pubKey []byte) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.AttestToBlockHead")
 	defer span.Finish()
 	// First the validator should construct attestation_data, an AttestationData
@@ -83,7 +84,7 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 
 	// We set the aggregation bitfield for the attestation.
 	idxReq := &pb.ValidatorIndexRequest{
-		PublicKey: v.pubKey,
+		PublicKey: pubKey,
 	}
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
@@ -25,7 +25,7 @@ func TestAttestToBlockHead_CrosslinkCommitteeRequestFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.CrosslinkCommitteeRequest{}),
 	).Return(nil /*Crosslinks Response*/, errors.New("something bad happened"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch crosslink committees at slot 30")
 }
 
@@ -41,7 +41,7 @@ func TestAttestToBlockHead_CrosslinkCommitteeRequestEmptyCommittee(t *testing.T)
 		Committee: []uint64{},
 	}, nil)
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "Received an empty committee assignment")
 }
 
@@ -62,7 +62,7 @@ func TestAttestToBlockHead_AttestationInfoAtSlotRequestFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.AttestationInfoRequest{}),
 	).Return(nil /* Attestation Info Response*/, errors.New("something bad happened"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch necessary info to produce attestation at slot 30")
 }
 
@@ -93,7 +93,7 @@ func TestAttestToBlockHead_ValidatorIndexRequestFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
 	).Return(nil /* Validator Index Response*/, errors.New("something bad happened"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch validator index")
 }
 
@@ -130,7 +130,7 @@ func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
 	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "Could not submit attestation to beacon node")
 }
 
@@ -173,7 +173,7 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		generatedAttestation = att
 	}).Return(&pb.AttestResponse{}, nil /* error */)
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, fakePubKey)
 
 	aggregationBitfield := make([]byte, (len(committee)+7)/8)
 	// Validator index is at index 4 in the mocked committee defined in this test.
@@ -0,0 +1,84 @@
+package client
+
+// Validator client exit functions.
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/opentracing/opentracing-go"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// domainExit is the signature domain of exits, as defined in the Ethereum Serenity
+// specification.
+const domainExit uint64 = 3
+
+// ProposeExit dials the beacon node at the given endpoint and proposes the voluntary
+// exit of the validator with the given public key, signed with its secret key, returning
+// the beacon node's response.
+func ProposeExit(ctx context.Context, endpoint string, withCert string, pubKey []byte, secretKey *bls.SecretKey) (*pb.ProposeExitResponse, error) {
+	dialOpt, err := dialOption(withCert)
+	if err != nil {
+		return nil, fmt.Errorf("could not get valid credentials: %v", err)
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpt)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial endpoint %s: %v", endpoint, err)
+	}
+	defer conn.Close()
+	v := &validator{
+		beaconClient:    pb.NewBeaconServiceClient(conn),
+		validatorClient: pb.NewValidatorServiceClient(conn),
+		proposerClient:  pb.NewProposerServiceClient(conn),
+	}
+	return v.ProposeExit(ctx, pubKey, secretKey)
+}
+
+// ProposeExit builds an exit of the validator with the given public key at the slot of
+// the current head, signs it with the secret key of the validator and sends it to the
+// beacon node which verifies it and broadcasts it to the network.
+func (v *validator) ProposeExit(ctx context.Context, pubKey []byte, secretKey *bls.SecretKey) (*pb.ProposeExitResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.ProposeExit")
+	defer span.Finish()
+
+	headBlock, err := v.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch canonical head: %v", err)
+	}
+	indexRes, err := v.validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{
+		PublicKey: pubKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch validator index: %v", err)
+	}
+
+	exit := &pbp2p.Exit{
+		Slot:           headBlock.Slot,
+		ValidatorIndex: indexRes.Index,
+	}
+	// The fork version never changes from the genesis fork version yet, so the exit is
+	// signed in the domain of the genesis fork.
+	domain := params.BeaconConfig().GenesisForkVersion<<32 + domainExit
+	sig, err := bls.Sign(secretKey, params.BeaconConfig().ZeroHash[:], domain)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign exit: %v", err)
+	}
+	exit.Signature = sig.BufferedSignature()
+
+	res, err := v.proposerClient.ProposeExit(ctx, exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not propose exit: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"exitHash":       fmt.Sprintf("%#x", res.ExitHash),
+		"validatorIndex": exit.ValidatorIndex,
+	}).Info("Proposed exit")
+	return res, nil
+}
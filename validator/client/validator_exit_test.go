@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"google.golang.org/grpc"
+)
+
+func TestProposeExit_ValidatorIndexFailure(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: 20}, nil /*err*/)
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(nil /*res*/, errors.New("unknown public key"))
+
+	if _, err := validator.ProposeExit(context.Background(), []byte{'k'}, &bls.SecretKey{K: big.NewInt(7)}); err == nil ||
+		!strings.Contains(err.Error(), "unknown public key") {
+		t.Errorf("Expected validator index error, received %v", err)
+	}
+}
+
+func TestProposeExit_ProposesExitAtHeadSlot(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: 20}, nil /*err*/)
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorIndexRequest{PublicKey: []byte{'k'}}),
+	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil /*err*/)
+	var proposedExit *pbp2p.Exit
+	m.proposerClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Exit{}),
+	).Do(func(_ context.Context, exit *pbp2p.Exit, _ ...grpc.CallOption) {
+		proposedExit = exit
+	}).Return(&pb.ProposeExitResponse{ExitEpoch: 8}, nil /*err*/)
+
+	res, err := validator.ProposeExit(context.Background(), []byte{'k'}, &bls.SecretKey{K: big.NewInt(7)})
+	if err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	if res.ExitEpoch != 8 {
+		t.Errorf("Expected exit epoch 8, received %d", res.ExitEpoch)
+	}
+	if proposedExit.Slot != 20 || proposedExit.ValidatorIndex != 5 {
+		t.Errorf("Expected exit of validator 5 at slot 20, received %v", proposedExit)
+	}
+	if len(proposedExit.Signature) == 0 {
+		t.Error("Expected the proposed exit to be signed")
+	}
+}
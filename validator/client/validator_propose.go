@@ -9,15 +9,17 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/opentracing/opentracing-go"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 )
 
 // ProposeBlock A new beacon block for a given slot. This method collects the
-// previous beacon block, any pending deposits, and ETH1 data from the beacon
-// chain node to construct the new block. The new block is then processed with
-// the state root computation, and finally signed by the validator before being
-// sent back to the beacon node for broadcasting.
-func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
+// previous beacon block, any pending deposits, ETH1 data and the pending
+// attestations, slashings and exits from the beacon chain node to construct
+// the new block. The new block is then processed with
+// the state root computation, and finally signed by the validator with the given
+// public key before being sent back to the beacon node for broadcasting.
+func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey []byte) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "validator.ProposeBlock")
 	defer span.Finish()
 
@@ -50,6 +52,13 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 		return
 	}
 
+	// Get the pooled attestations, slashings and exits the block can include.
+	opsResp, err := v.proposerClient.PendingOperations(ctx, &pb.PendingOperationsRequest{Slot: slot})
+	if err != nil {
+		log.Errorf("Failed to get pending operations: %v", err)
+		return
+	}
+
 	// 2. Construct block.
 	block := &pbp2p.BeaconBlock{
 		Slot:               slot,
@@ -57,11 +66,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 		RandaoRevealHash32: nil, // TODO(1366): generate randao reveal from BLS
 		Eth1Data:           eth1DataResp.Eth1Data,
 		Body: &pbp2p.BeaconBlockBody{
-			Attestations:      v.attestationPool.PendingAttestations(),
-			ProposerSlashings: nil, // TODO(1438): Add after operations pool
-			AttesterSlashings: nil, // TODO(1438): Add after operations pool
+			Attestations:      opsResp.Attestations,
+			ProposerSlashings: opsResp.ProposerSlashings,
+			AttesterSlashings: opsResp.AttesterSlashings,
 			Deposits:          pDepResp.PendingDeposits,
-			Exits:             nil, // TODO(1323): Add validator exits
+			Exits:             opsResp.Exits,
 		},
 	}
 
@@ -32,7 +32,6 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 	}
 
 	validator := &validator{
-		attestationPool: &fakeAttestationPool{},
 		proposerClient:  m.proposerClient,
 		beaconClient:    m.beaconClient,
 		attesterClient:  m.attesterClient,
@@ -52,7 +51,7 @@ func TestProposeBlock_LogsCanonicalHeadFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*beaconBlock*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -72,7 +71,7 @@ func TestProposeBlock_PendingDepositsFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -100,6 +99,11 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -115,7 +119,7 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		broadcastedBlock = blk
 	}).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	if !bytes.Equal(broadcastedBlock.Body.Deposits[0].DepositData, []byte{'D', 'A', 'T', 'A'}) {
 		t.Errorf("Unexpected deposit data: %v", broadcastedBlock.Body.Deposits)
@@ -142,7 +146,7 @@ func TestProposeBlock_Eth1DataFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
@@ -168,6 +172,11 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
 	}, nil /*err*/)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -183,13 +192,99 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 		broadcastedBlock = blk
 	}).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	if !bytes.Equal(broadcastedBlock.Eth1Data.BlockHash32, []byte{'B', 'L', 'O', 'C', 'K'}) {
 		t.Errorf("Unexpected ETH1 data: %v", broadcastedBlock.Eth1Data)
 	}
 }
 
+func TestProposeBlock_PendingOperationsFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(nil /*response*/, errors.New("something bad happened"))
+
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
+
+	testutil.AssertLogsContain(t, hook, "something bad happened")
+}
+
+func TestProposeBlock_UsesPendingOperations(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().PendingDeposits(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
+
+	m.beaconClient.EXPECT().Eth1Data(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
+
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{
+		Attestations:      []*pbp2p.Attestation{{AggregationBitfield: []byte{1}}},
+		ProposerSlashings: []*pbp2p.ProposerSlashing{{ProposerIndex: 2}},
+		AttesterSlashings: []*pbp2p.AttesterSlashing{{}},
+		Exits:             []*pbp2p.Exit{{ValidatorIndex: 3}},
+	}, nil /*err*/)
+
+	m.proposerClient.EXPECT().ComputeStateRoot(
+		gomock.Any(), // context
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Return(&pb.StateRootResponse{
+		StateRoot: []byte{'F'},
+	}, nil /*err*/)
+
+	var broadcastedBlock *pbp2p.BeaconBlock
+	m.proposerClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
+
+	body := broadcastedBlock.Body
+	if len(body.Attestations) != 1 || len(body.ProposerSlashings) != 1 ||
+		len(body.AttesterSlashings) != 1 || len(body.Exits) != 1 {
+		t.Errorf("Expected the pending operations to be included in the block, received %v", body)
+	}
+	if body.ProposerSlashings[0].ProposerIndex != 2 || body.Exits[0].ValidatorIndex != 3 {
+		t.Errorf("Unexpected operations in block: %v", body)
+	}
+}
+
 func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
@@ -210,6 +305,11 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -220,7 +320,7 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
 
@@ -243,6 +343,11 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -262,7 +367,7 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		nil, // err
 	)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 
 	if !bytes.Equal(broadcastedBlock.StateRootHash32, computedStateRoot) {
 		t.Errorf("Unexpected state root hash. want=%#x got=%#x", computedStateRoot, broadcastedBlock.StateRootHash32)
@@ -288,6 +393,11 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.Eq(&ptypes.Empty{}),
 	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
 
+	m.proposerClient.EXPECT().PendingOperations(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.PendingOperationsRequest{Slot: 55}),
+	).Return(&pb.PendingOperationsResponse{}, nil /*err*/)
+
 	m.proposerClient.EXPECT().ComputeStateRoot(
 		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
@@ -300,5 +410,5 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, fakePubKey)
 }
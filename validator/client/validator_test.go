@@ -5,6 +5,9 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,7 +17,6 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 
 	"github.com/golang/mock/gomock"
-	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/internal"
@@ -28,14 +30,55 @@ func init() {
 
 var _ = Validator(&validator{})
 
-// TODO(1323): remove this
-type fakeAttestationPool struct{}
+var fakePubKey = []byte{1}
+
+func TestCheckChainConfig_LogsMismatches(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconServiceClient(ctrl)
 
-func (f *fakeAttestationPool) PendingAttestations() []*pbp2p.Attestation {
-	return nil
+	v := validator{
+		pubKeys:      [][]byte{fakePubKey},
+		beaconClient: client,
+	}
+	client.EXPECT().ChainConfig(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(&pb.ChainConfigResponse{
+		BeaconChainConfig: []*pb.ChainConfigEntry{
+			{Key: "epoch_length", Value: "1"},
+			{Key: "genesis_time", Value: "1970-01-01T00:00:00Z"},
+		},
+	}, nil)
+	v.CheckChainConfig(context.Background())
+	testutil.AssertLogsContain(t, hook, "does not match the beacon node")
+	if len(hook.AllEntries()) != 1 {
+		t.Errorf("Expected only the epoch length mismatch to be logged, received %d entries", len(hook.AllEntries()))
+	}
 }
 
-var fakePubKey = []byte{1}
+func TestCheckChainConfig_Matches(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockBeaconServiceClient(ctrl)
+
+	v := validator{
+		pubKeys:      [][]byte{fakePubKey},
+		beaconClient: client,
+	}
+	client.EXPECT().ChainConfig(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(&pb.ChainConfigResponse{
+		BeaconChainConfig: []*pb.ChainConfigEntry{
+			{Key: "epoch_length", Value: strconv.FormatUint(params.BeaconConfig().EpochLength, 10)},
+		},
+	}, nil)
+	v.CheckChainConfig(context.Background())
+	testutil.AssertLogsContain(t, hook, "Chain config matches the beacon node")
+}
 
 func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -43,7 +86,7 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		pubKey:       fakePubKey,
+		pubKeys:      [][]byte{fakePubKey},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -75,7 +118,7 @@ func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		pubKey:       fakePubKey,
+		pubKeys:      [][]byte{fakePubKey},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -104,7 +147,7 @@ func TestWaitForChainStart_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		pubKey:       fakePubKey,
+		pubKeys:      [][]byte{fakePubKey},
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -123,7 +166,7 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		pubKey:       fakePubKey,
+		pubKeys:      [][]byte{fakePubKey},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -147,6 +190,146 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Could not receive ChainStart from stream")
 }
 
+func TestWaitForActivation_BlocksUntilActive(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey},
+		validatorClient: client,
+	}
+	clientStream := internal.NewMockValidatorService_ValidatorStatusClient(ctrl)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		&pb.ValidatorStatusRequest{PublicKeys: [][]byte{fakePubKey}},
+	).Return(clientStream, nil)
+	statuses := []*pb.ValidatorInfo{
+		{PublicKey: fakePubKey, Status: pb.ValidatorStatus_DEPOSITED},
+		{PublicKey: fakePubKey, Status: pb.ValidatorStatus_PENDING_ACTIVATION, EstimatedActivationEpoch: 7},
+		{PublicKey: fakePubKey, Status: pb.ValidatorStatus_ACTIVE, ActivationEpoch: 7},
+	}
+	for _, status := range statuses {
+		clientStream.EXPECT().Recv().Return(
+			&pb.ValidatorStatusResponse{Validators: []*pb.ValidatorInfo{status}},
+			nil,
+		)
+	}
+	if err := v.WaitForActivation(context.Background()); err != nil {
+		t.Fatalf("Could not wait for activation: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Deposit seen")
+	testutil.AssertLogsContain(t, hook, "estimated to happen at epoch 7")
+	testutil.AssertLogsContain(t, hook, "Validator is active")
+}
+
+func TestWaitForActivation_StreamSetupFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey},
+		validatorClient: client,
+	}
+	clientStream := internal.NewMockValidatorService_ValidatorStatusClient(ctrl)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(clientStream, errors.New("failed stream"))
+	err := v.WaitForActivation(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "could not setup validator status streaming client") {
+		t.Errorf("Expected stream setup error, received %v", err)
+	}
+}
+
+func TestWaitForActivation_ReceiveErrorFromStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey},
+		validatorClient: client,
+	}
+	clientStream := internal.NewMockValidatorService_ValidatorStatusClient(ctrl)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(clientStream, nil)
+	clientStream.EXPECT().Recv().Return(
+		nil,
+		errors.New("fails"),
+	)
+	err := v.WaitForActivation(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "could not receive validator status from stream") {
+		t.Errorf("Expected stream receive error, received %v", err)
+	}
+}
+
+func TestWaitForActivation_ExitedValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey},
+		validatorClient: client,
+	}
+	clientStream := internal.NewMockValidatorService_ValidatorStatusClient(ctrl)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(clientStream, nil)
+	clientStream.EXPECT().Recv().Return(
+		&pb.ValidatorStatusResponse{Validators: []*pb.ValidatorInfo{
+			{PublicKey: fakePubKey, Status: pb.ValidatorStatus_EXITED},
+		}},
+		nil,
+	)
+	if err := v.WaitForActivation(context.Background()); err == nil {
+		t.Error("Expected error for a validator which can no longer be activated")
+	}
+}
+
+func TestWaitForActivation_DropsKeysWhichCanNoLongerBeActivated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	exitedKey := []byte{2}
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey, exitedKey},
+		validatorClient: client,
+	}
+	clientStream := internal.NewMockValidatorService_ValidatorStatusClient(ctrl)
+	client.EXPECT().ValidatorStatus(
+		gomock.Any(),
+		&pb.ValidatorStatusRequest{PublicKeys: [][]byte{fakePubKey, exitedKey}},
+	).Return(clientStream, nil)
+	clientStream.EXPECT().Recv().Return(
+		&pb.ValidatorStatusResponse{Validators: []*pb.ValidatorInfo{
+			{PublicKey: fakePubKey, Status: pb.ValidatorStatus_PENDING_ACTIVATION},
+			{PublicKey: exitedKey, Status: pb.ValidatorStatus_EXITED},
+		}},
+		nil,
+	)
+	clientStream.EXPECT().Recv().Return(
+		&pb.ValidatorStatusResponse{Validators: []*pb.ValidatorInfo{
+			{PublicKey: fakePubKey, Status: pb.ValidatorStatus_ACTIVE},
+			{PublicKey: exitedKey, Status: pb.ValidatorStatus_EXITED},
+		}},
+		nil,
+	)
+	if err := v.WaitForActivation(context.Background()); err != nil {
+		t.Fatalf("Could not wait for activation: %v", err)
+	}
+	if !reflect.DeepEqual(v.pubKeys, [][]byte{fakePubKey}) {
+		t.Errorf("Expected only the active key to perform duties, received %v", v.pubKeys)
+	}
+}
+
 func TestUpdateAssignments_DoesNothingWhenNotEpochStart(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -154,7 +337,7 @@ func TestUpdateAssignments_DoesNothingWhenNotEpochStart(t *testing.T) {
 
 	slot := uint64(1)
 	v := validator{
-		pubKey:          fakePubKey,
+		pubKeys:         [][]byte{fakePubKey},
 		validatorClient: client,
 	}
 	client.EXPECT().ValidatorEpochAssignments(
@@ -173,7 +356,7 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		pubKey:          fakePubKey,
+		pubKeys:         [][]byte{fakePubKey},
 		validatorClient: client,
 	}
 
@@ -197,13 +380,15 @@ func TestUpdateAssignments_DoesUpdateAssignments(t *testing.T) {
 
 	slot := params.BeaconConfig().EpochLength
 	resp := &pb.ValidatorEpochAssignmentsResponse{
-		Assignment: &pb.Assignment{
-			ProposerSlot: 67,
-			AttesterSlot: 78,
+		Assignments: []*pb.Assignment{
+			{
+				ProposerSlot: 67,
+				AttesterSlot: 78,
+			},
 		},
 	}
 	v := validator{
-		pubKey:          fakePubKey,
+		pubKeys:         [][]byte{fakePubKey},
 		validatorClient: client,
 	}
 	client.EXPECT().ValidatorEpochAssignments(
@@ -215,10 +400,44 @@ func TestUpdateAssignments_DoesUpdateAssignments(t *testing.T) {
 		t.Fatalf("Could not update assignments: %v", err)
 	}
 
-	if v.assignment.ProposerSlot != 67 {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", 67, v.assignment.ProposerSlot)
+	assignment := v.assignments[string(fakePubKey)]
+	if assignment.ProposerSlot != 67 {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", 67, assignment.ProposerSlot)
+	}
+	if assignment.AttesterSlot != 78 {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", 78, assignment.AttesterSlot)
+	}
+}
+
+func TestUpdateAssignments_RequestsEveryKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	otherKey := []byte{2}
+	slot := params.BeaconConfig().EpochLength
+	v := validator{
+		pubKeys:         [][]byte{fakePubKey, otherKey},
+		validatorClient: client,
+	}
+	client.EXPECT().ValidatorEpochAssignments(
+		gomock.Any(),
+		&pb.ValidatorEpochAssignmentsRequest{EpochStart: slot, PublicKeys: [][]byte{fakePubKey, otherKey}},
+	).Return(&pb.ValidatorEpochAssignmentsResponse{
+		Assignments: []*pb.Assignment{
+			{PublicKey: fakePubKey, AttesterSlot: slot + 1, ProposerSlot: slot + 3},
+			{PublicKey: otherKey, AttesterSlot: slot + 2, ProposerSlot: slot + 1},
+		},
+	}, nil)
+
+	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+	roles := v.RolesAt(slot + 1)
+	if roles[string(fakePubKey)] != pb.ValidatorRole_ATTESTER {
+		t.Errorf("Expected the first key to attest, received role %v", roles[string(fakePubKey)])
 	}
-	if v.assignment.AttesterSlot != 78 {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", 78, v.assignment.AttesterSlot)
+	if roles[string(otherKey)] != pb.ValidatorRole_PROPOSER {
+		t.Errorf("Expected the second key to propose, received role %v", roles[string(otherKey)])
 	}
 }
@@ -57,6 +57,24 @@ func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
 }
 
+// ChainConfig mocks base method
+func (m *MockBeaconServiceClient) ChainConfig(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.ChainConfigResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChainConfig", varargs...)
+	ret0, _ := ret[0].(*v10.ChainConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainConfig indicates an expected call of ChainConfig
+func (mr *MockBeaconServiceClientMockRecorder) ChainConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainConfig", reflect.TypeOf((*MockBeaconServiceClient)(nil).ChainConfig), varargs...)
+}
+
 // Eth1Data mocks base method
 func (m *MockBeaconServiceClient) Eth1Data(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1DataResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -111,6 +129,24 @@ func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
 }
 
+// StreamChainEvents mocks base method
+func (m *MockBeaconServiceClient) StreamChainEvents(arg0 context.Context, arg1 *v10.ChainEventsRequest, arg2 ...grpc.CallOption) (v10.BeaconService_StreamChainEventsClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamChainEvents", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_StreamChainEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamChainEvents indicates an expected call of StreamChainEvents
+func (mr *MockBeaconServiceClientMockRecorder) StreamChainEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChainEvents", reflect.TypeOf((*MockBeaconServiceClient)(nil).StreamChainEvents), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -55,6 +55,24 @@ func (mr *MockProposerServiceClientMockRecorder) ComputeStateRoot(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeStateRoot", reflect.TypeOf((*MockProposerServiceClient)(nil).ComputeStateRoot), varargs...)
 }
 
+// PendingOperations mocks base method
+func (m *MockProposerServiceClient) PendingOperations(arg0 context.Context, arg1 *v10.PendingOperationsRequest, arg2 ...grpc.CallOption) (*v10.PendingOperationsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingOperations", varargs...)
+	ret0, _ := ret[0].(*v10.PendingOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingOperations indicates an expected call of PendingOperations
+func (mr *MockProposerServiceClientMockRecorder) PendingOperations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingOperations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingOperations), varargs...)
+}
+
 // ProposeBlock mocks base method
 func (m *MockProposerServiceClient) ProposeBlock(arg0 context.Context, arg1 *v1.BeaconBlock, arg2 ...grpc.CallOption) (*v10.ProposeResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -73,6 +91,24 @@ func (mr *MockProposerServiceClientMockRecorder) ProposeBlock(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).ProposeBlock), varargs...)
 }
 
+// ProposeExit mocks base method
+func (m *MockProposerServiceClient) ProposeExit(arg0 context.Context, arg1 *v1.Exit, arg2 ...grpc.CallOption) (*v10.ProposeExitResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposeExit", varargs...)
+	ret0, _ := ret[0].(*v10.ProposeExitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeExit indicates an expected call of ProposeExit
+func (mr *MockProposerServiceClientMockRecorder) ProposeExit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeExit", reflect.TypeOf((*MockProposerServiceClient)(nil).ProposeExit), varargs...)
+}
+
 // ProposerIndex mocks base method
 func (m *MockProposerServiceClient) ProposerIndex(arg0 context.Context, arg1 *v10.ProposerIndexRequest, arg2 ...grpc.CallOption) (*v10.ProposerIndexResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: ValidatorServiceClient,ValidatorService_ValidatorStatusClient)
 
 // Package internal is a generated GoMock package.
 package internal
@@ -9,8 +9,10 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockValidatorServiceClient is a mock of ValidatorServiceClient interface
@@ -37,13 +39,13 @@ func (m *MockValidatorServiceClient) EXPECT() *MockValidatorServiceClientMockRec
 }
 
 // ValidatorEpochAssignments mocks base method
-func (m *MockValidatorServiceClient) ValidatorEpochAssignments(arg0 context.Context, arg1 *v1.ValidatorEpochAssignmentsRequest, arg2 ...grpc.CallOption) (*v1.ValidatorEpochAssignmentsResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorEpochAssignments(arg0 context.Context, arg1 *v10.ValidatorEpochAssignmentsRequest, arg2 ...grpc.CallOption) (*v10.ValidatorEpochAssignmentsResponse, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorEpochAssignments", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorEpochAssignmentsResponse)
+	ret0, _ := ret[0].(*v10.ValidatorEpochAssignmentsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -55,13 +57,13 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorEpochAssignments(arg0
 }
 
 // ValidatorIndex mocks base method
-func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorIndexResponse, error) {
+func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v10.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v10.ValidatorIndexResponse, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ValidatorIndex", varargs...)
-	ret0, _ := ret[0].(*v1.ValidatorIndexResponse)
+	ret0, _ := ret[0].(*v10.ValidatorIndexResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -71,3 +73,148 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorIndex(arg0, arg1 inte
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIndex", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorIndex), varargs...)
 }
+
+// ValidatorPerformance mocks base method
+func (m *MockValidatorServiceClient) ValidatorPerformance(arg0 context.Context, arg1 *v10.ValidatorPerformanceRequest, arg2 ...grpc.CallOption) (*v1.EpochRewardReport, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorPerformance", varargs...)
+	ret0, _ := ret[0].(*v1.EpochRewardReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorPerformance indicates an expected call of ValidatorPerformance
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorPerformance), varargs...)
+}
+
+// ValidatorStatus mocks base method
+func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v10.ValidatorStatusRequest, arg2 ...grpc.CallOption) (v10.ValidatorService_ValidatorStatusClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorStatus", varargs...)
+	ret0, _ := ret[0].(v10.ValidatorService_ValidatorStatusClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorStatus indicates an expected call of ValidatorStatus
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorStatus", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorStatus), varargs...)
+}
+
+// MockValidatorService_ValidatorStatusClient is a mock of ValidatorService_ValidatorStatusClient interface
+type MockValidatorService_ValidatorStatusClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorService_ValidatorStatusClientMockRecorder
+}
+
+// MockValidatorService_ValidatorStatusClientMockRecorder is the mock recorder for MockValidatorService_ValidatorStatusClient
+type MockValidatorService_ValidatorStatusClientMockRecorder struct {
+	mock *MockValidatorService_ValidatorStatusClient
+}
+
+// NewMockValidatorService_ValidatorStatusClient creates a new mock instance
+func NewMockValidatorService_ValidatorStatusClient(ctrl *gomock.Controller) *MockValidatorService_ValidatorStatusClient {
+	mock := &MockValidatorService_ValidatorStatusClient{ctrl: ctrl}
+	mock.recorder = &MockValidatorService_ValidatorStatusClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockValidatorService_ValidatorStatusClient) EXPECT() *MockValidatorService_ValidatorStatusClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) CloseSend() error {
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) CloseSend() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) Context() context.Context {
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) Context() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) Header() (metadata.MD, error) {
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) Header() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) Recv() (*v10.ValidatorStatusResponse, error) {
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v10.ValidatorStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) Recv() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) RecvMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) SendMsg(arg0 interface{}) error {
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockValidatorService_ValidatorStatusClient) Trailer() metadata.MD {
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockValidatorService_ValidatorStatusClientMockRecorder) Trailer() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockValidatorService_ValidatorStatusClient)(nil).Trailer))
+}
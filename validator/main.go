@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"runtime"
 
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/client"
 
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/prysmaticlabs/prysm/validator/types"
@@ -21,7 +26,8 @@ import (
 func startNode(ctx *cli.Context) error {
 	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
-	if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
+	validatorAccounts, err := accounts.Accounts(keystoreDirectory, keystorePassword)
+	if err != nil || len(validatorAccounts) == 0 {
 		return errors.New("no account found, use `validator accounts create` to generate a new keystore")
 	}
 
@@ -44,12 +50,150 @@ func startNode(ctx *cli.Context) error {
 func createValidatorAccount(ctx *cli.Context) error {
 	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	if ctx.Bool(types.MnemonicFlag.Name) {
+		numAccounts := uint32(ctx.Uint(types.NumAccountsFlag.Name))
+		mnemonic, err := keystore.NewMnemonic(rand.Reader)
+		if err != nil {
+			return fmt.Errorf("could not generate mnemonic: %v", err)
+		}
+		if err := showMnemonic(mnemonic); err != nil {
+			return err
+		}
+		if err := accounts.RecoverAccounts(keystoreDirectory, keystorePassword, mnemonic, numAccounts); err != nil {
+			return fmt.Errorf("could not initialize validator accounts: %v", err)
+		}
+		return nil
+	}
 	if err := accounts.NewValidatorAccount(keystoreDirectory, keystorePassword); err != nil {
 		return fmt.Errorf("could not initialize validator account: %v", err)
 	}
 	return nil
 }
 
+func recoverValidatorAccounts(ctx *cli.Context) error {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	mnemonic, err := readMnemonic(ctx.String(types.MnemonicFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	numAccounts := uint32(ctx.Uint(types.NumAccountsFlag.Name))
+	if err := accounts.RecoverAccounts(keystoreDirectory, keystorePassword, mnemonic, numAccounts); err != nil {
+		return fmt.Errorf("could not recover validator accounts: %v", err)
+	}
+	return nil
+}
+
+func listValidatorAccounts(ctx *cli.Context) error {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	validatorAccounts, err := accounts.Accounts(keystoreDirectory, keystorePassword)
+	if err != nil {
+		return fmt.Errorf("could not load validator accounts: %v", err)
+	}
+	for _, account := range validatorAccounts {
+		fmt.Println(account.Path)
+	}
+	return nil
+}
+
+func importValidatorKey(ctx *cli.Context) error {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	keyFile := ctx.String(types.KeyFileFlag.Name)
+	if keyFile == "" {
+		return errors.New("expected a key file to import, received nil")
+	}
+	keyData, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return fmt.Errorf("could not read key file: %v", err)
+	}
+	account, err := accounts.ImportKey(keystoreDirectory, keystorePassword, keyData, keyFilePassword(ctx))
+	if err != nil {
+		return fmt.Errorf("could not import validator key: %v", err)
+	}
+	logrus.WithField("prefix", "main").WithField("path", account.Path).Info("Imported validator key")
+	return nil
+}
+
+func exportValidatorKey(ctx *cli.Context) error {
+	keyFile := ctx.String(types.KeyFileFlag.Name)
+	if keyFile == "" {
+		return errors.New("expected a key file to export to, received nil")
+	}
+	account, err := selectAccount(ctx)
+	if err != nil {
+		return err
+	}
+	keyData, err := accounts.ExportKey(account, keyFilePassword(ctx), ctx.Bool(types.RawKeyFlag.Name))
+	if err != nil {
+		return fmt.Errorf("could not export validator key: %v", err)
+	}
+	if err := ioutil.WriteFile(keyFile, keyData, 0600); err != nil {
+		return fmt.Errorf("could not write key file: %v", err)
+	}
+	logrus.WithField("prefix", "main").WithFields(logrus.Fields{
+		"account": account.Path,
+		"path":    keyFile,
+	}).Info("Exported validator key")
+	return nil
+}
+
+func changeKeystorePassword(ctx *cli.Context) error {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	newPassword := ctx.String(types.NewPasswordFlag.Name)
+	scryptN := ctx.Int(types.ScryptNFlag.Name)
+	scryptP := ctx.Int(types.ScryptPFlag.Name)
+	if err := accounts.ChangePassword(keystoreDirectory, keystorePassword, newPassword, scryptN, scryptP); err != nil {
+		return fmt.Errorf("could not change keystore password: %v", err)
+	}
+	return nil
+}
+
+func exitValidator(ctx *cli.Context) error {
+	account, err := selectAccount(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := client.ProposeExit(
+		context.Background(),
+		ctx.String(types.BeaconRPCProviderFlag.Name),
+		ctx.String(types.CertFlag.Name),
+		account.PublicKey,
+		account.SecretKey(),
+	)
+	if err != nil {
+		return fmt.Errorf("could not exit validator: %v", err)
+	}
+	logrus.WithField("prefix", "main").WithField(
+		"earliestExitEpoch", res.ExitEpoch,
+	).Info("Exit accepted by the beacon node, the validator exits at the given epoch at the earliest")
+	return nil
+}
+
+// selectAccount loads the account of the keystore given by the account flag, which
+// may be omitted if the keystore holds a single account.
+func selectAccount(ctx *cli.Context) (*accounts.Account, error) {
+	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+	keystorePassword := ctx.String(types.PasswordFlag.Name)
+	accountPath := ctx.String(types.AccountFlag.Name)
+	account, err := accounts.SelectAccount(keystoreDirectory, keystorePassword, accountPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not load validator account: %v", err)
+	}
+	return account, nil
+}
+
+// keyFilePassword returns the password of imported and exported key files, which
+// defaults to the keystore password.
+func keyFilePassword(ctx *cli.Context) string {
+	if ctx.IsSet(types.KeyFilePasswordFlag.Name) {
+		return ctx.String(types.KeyFilePasswordFlag.Name)
+	}
+	return ctx.String(types.PasswordFlag.Name)
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -97,10 +241,89 @@ contract in order to activate the validator client`,
 					Flags: []cli.Flag{
 						types.KeystorePathFlag,
 						types.PasswordFlag,
+						types.MnemonicFlag,
+						types.NumAccountsFlag,
 					},
 					Action: createValidatorAccount,
 				},
+				cli.Command{
+					Name: "recover",
+					Description: `derives the keys of validator accounts from the mnemonic they were created with, and 
+stores the accounts which are not in the keystore yet - the mnemonic is read from the mnemonic file, 
+a prompt on the terminal, or stdin`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.MnemonicFileFlag,
+						types.NumAccountsFlag,
+					},
+					Action: recoverValidatorAccounts,
+				},
+				cli.Command{
+					Name:        "list",
+					Description: `lists the key file paths of the validator accounts in the keystore`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+					},
+					Action: listValidatorAccounts,
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports a hex encoded or encrypted validator private key from the key file into the keystore, 
+encrypting it with the keystore password`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.KeyFileFlag,
+						types.KeyFilePasswordFlag,
+					},
+					Action: importValidatorKey,
+				},
+				cli.Command{
+					Name: "export",
+					Description: `exports the private key of a validator account of the keystore to the key file, either 
+encrypted with the key file password or hex encoded`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.AccountFlag,
+						types.KeyFileFlag,
+						types.KeyFilePasswordFlag,
+						types.RawKeyFlag,
+					},
+					Action: exportValidatorKey,
+				},
+				cli.Command{
+					Name: "change-password",
+					Description: `encrypts every key of the keystore again with the new password, using the given
+scrypt key derivation parameters`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.NewPasswordFlag,
+						types.ScryptNFlag,
+						types.ScryptPFlag,
+					},
+					Action: changeKeystorePassword,
+				},
+			},
+		},
+		{
+			Name:     "exit",
+			Category: "accounts",
+			Usage:    "voluntarily exits the validator of the account from the beacon chain",
+			Description: `builds and signs an exit for a validator key of the keystore, and submits it to
+the beacon node which verifies it against its head state and broadcasts it to the network - this
+command outputs the epoch the validator is expected to exit at`,
+			Flags: []cli.Flag{
+				types.BeaconRPCProviderFlag,
+				types.CertFlag,
+				types.KeystorePathFlag,
+				types.PasswordFlag,
+				types.AccountFlag,
 			},
+			Action: exitValidator,
 		},
 	}
 
@@ -115,6 +338,7 @@ contract in order to activate the validator client`,
 		cmd.TraceSampleFractionFlag,
 		cmd.KeystorePasswordFlag,
 		cmd.KeystoreDirectoryFlag,
+		cmd.ChainConfigFileFlag,
 		cmd.BootstrapNode,
 		cmd.MonitoringPortFlag,
 		debug.PProfFlag,
@@ -123,6 +347,8 @@ contract in order to activate the validator client`,
 		debug.MemProfileRateFlag,
 		debug.CPUProfileFlag,
 		debug.TraceFlag,
+		debug.DebugAPIFlag,
+		debug.DebugAPITokenFileFlag,
 	}
 
 	app.Before = func(ctx *cli.Context) error {
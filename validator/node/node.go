@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
@@ -43,6 +44,13 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		stop:     make(chan struct{}),
 	}
 
+	if configFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name); configFile != "" {
+		if err := params.LoadChainConfigFile(configFile); err != nil {
+			return nil, err
+		}
+		log.WithField("file", configFile).Info("Using chain config file")
+	}
+
 	if err := ValidatorClient.registerPrometheusService(ctx); err != nil {
 		return nil, err
 	}
@@ -93,7 +101,9 @@ func (s *ValidatorClient) Close() {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	s.services.StopAll()
+	if err := s.services.StopAll(); err != nil {
+		log.Errorf("Failed to stop all services: %v", err)
+	}
 	log.Info("Stopping sharding validator")
 
 	close(s.stop)
@@ -111,7 +121,9 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 func (s *ValidatorClient) registerClientService(ctx *cli.Context) error {
 	endpoint := ctx.GlobalString(types.BeaconRPCProviderFlag.Name)
 	v := client.NewValidatorService(context.TODO(), &client.Config{
-		Endpoint: endpoint,
+		Endpoint:     endpoint,
+		KeystorePath: ctx.GlobalString(types.KeystorePathFlag.Name),
+		Password:     ctx.GlobalString(types.PasswordFlag.Name),
 	})
 	return s.services.RegisterService(v)
 }
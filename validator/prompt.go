@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// showMnemonic prints the generated mnemonic once the user confirmed on the terminal
+// that no one else can see it, as it backs up every key derived from it.
+func showMnemonic(mnemonic string) error {
+	if !terminal.IsTerminal(int(os.Stdin.Fd())) {
+		return errors.New("the generated mnemonic can only be shown on an interactive terminal")
+	}
+	fmt.Print("The mnemonic backing up the keys of the new accounts is shown only once, " +
+		"make sure no one else can see your screen. Show the mnemonic? [y/N]: ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("could not read confirmation: %v", err)
+	}
+	if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+		return errors.New("mnemonic not shown, no account was created")
+	}
+	fmt.Println("Write down the mnemonic below and keep it safe, it is needed to recover the keys " +
+		"of the validator accounts with `validator accounts recover`:")
+	fmt.Println(mnemonic)
+	return nil
+}
+
+// readMnemonic reads the mnemonic of recovered accounts from the mnemonic file if one is
+// given. Otherwise it is read from a prompt which does not echo it on a terminal, or from
+// the first line of stdin.
+func readMnemonic(mnemonicFile string) (string, error) {
+	if mnemonicFile != "" {
+		return accounts.ReadMnemonicFile(mnemonicFile)
+	}
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		fmt.Print("Enter the mnemonic: ")
+		mnemonic, err := terminal.ReadPassword(fd)
+		fmt.Println()
+		if err != nil {
+			return "", fmt.Errorf("could not read mnemonic: %v", err)
+		}
+		return string(mnemonic), nil
+	}
+	mnemonic, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("could not read mnemonic from stdin: %v", err)
+	}
+	return strings.TrimSpace(mnemonic), nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/urfave/cli"
 )
 
@@ -26,4 +27,57 @@ var (
 		Name:  "password",
 		Usage: "password to your validator private keys",
 	}
+	// NewPasswordFlag defines the password the keys of the keystore are encrypted with after changing it.
+	NewPasswordFlag = cli.StringFlag{
+		Name:  "new-password",
+		Usage: "new password to your validator private keys",
+	}
+	// ScryptNFlag defines the scrypt CPU and memory cost the keys of the keystore are encrypted with.
+	ScryptNFlag = cli.IntFlag{
+		Name:  "scrypt-n",
+		Usage: "scrypt CPU and memory cost parameter the validator private keys are encrypted with, a power of 2",
+		Value: keystore.StandardScryptN,
+	}
+	// ScryptPFlag defines the scrypt parallelization the keys of the keystore are encrypted with.
+	ScryptPFlag = cli.IntFlag{
+		Name:  "scrypt-p",
+		Usage: "scrypt parallelization parameter the validator private keys are encrypted with",
+		Value: keystore.StandardScryptP,
+	}
+	// AccountFlag defines the key file path of the validator account to use.
+	AccountFlag = cli.StringFlag{
+		Name:  "account",
+		Usage: "key file path of the account to use, as listed by `validator accounts list`, required when the keystore holds several accounts",
+	}
+	// KeyFileFlag defines the file a validator private key is imported from or exported to.
+	KeyFileFlag = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "path to the file holding the hex encoded or encrypted validator private key",
+	}
+	// KeyFilePasswordFlag defines the password the key file of an imported or exported key is encrypted with.
+	KeyFilePasswordFlag = cli.StringFlag{
+		Name:  "key-file-password",
+		Usage: "password the key file is encrypted with, defaults to the keystore password",
+	}
+	// RawKeyFlag defines whether an exported validator private key is written hex encoded instead of encrypted.
+	RawKeyFlag = cli.BoolFlag{
+		Name:  "raw",
+		Usage: "export the validator private key hex encoded instead of encrypted",
+	}
+	// MnemonicFlag defines whether the keys of new accounts are derived from a newly generated mnemonic.
+	MnemonicFlag = cli.BoolFlag{
+		Name:  "mnemonic",
+		Usage: "derive the validator private keys from a newly generated mnemonic, which backs up all of them",
+	}
+	// MnemonicFileFlag defines the file holding the mnemonic the keys of recovered accounts are derived from.
+	MnemonicFileFlag = cli.StringFlag{
+		Name:  "mnemonic-file",
+		Usage: "path to a file only accessible by its owner holding the mnemonic the validator private keys were derived from, which is read from stdin otherwise",
+	}
+	// NumAccountsFlag defines the number of accounts whose keys are derived from a mnemonic.
+	NumAccountsFlag = cli.UintFlag{
+		Name:  "num-accounts",
+		Usage: "number of accounts derived from the mnemonic",
+		Value: 1,
+	}
 )